@@ -5,3 +5,12 @@ package annotations
 // The value is a string representing the limit, e.g "64Ki".
 // The limit is applied to all listeners in the gateway.
 const PerConnectionBufferLimit = "kgateway.dev/per-connection-buffer-limit"
+
+// MergeIntoGateway is the annotation key used on a Gateway to serve its listeners from the proxy of
+// another Gateway in the same namespace, named by the value, instead of deploying a proxy for it.
+// The other Gateway must use the same GatewayClass and must not be merged into a Gateway itself,
+// otherwise the annotation is ignored. On a listener conflict, the listeners of the other Gateway
+// win, followed by those of the earliest merged Gateway, and the losing listener is rejected on the
+// status of the Gateway that defines it. Policies attached to the
+// other Gateway apply to all the listeners of its proxy.
+const MergeIntoGateway = "kgateway.dev/merge-into-gateway"
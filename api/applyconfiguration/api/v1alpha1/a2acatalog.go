@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// A2ACatalogApplyConfiguration represents a declarative configuration of the A2ACatalog type for use
+// with apply.
+type A2ACatalogApplyConfiguration struct {
+	NamespaceSelector *v1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+	Selector          *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	PathPrefix        *string                             `json:"pathPrefix,omitempty"`
+}
+
+// A2ACatalogApplyConfiguration constructs a declarative configuration of the A2ACatalog type for use with
+// apply.
+func A2ACatalog() *A2ACatalogApplyConfiguration {
+	return &A2ACatalogApplyConfiguration{}
+}
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *A2ACatalogApplyConfiguration) WithNamespaceSelector(value *v1.LabelSelectorApplyConfiguration) *A2ACatalogApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *A2ACatalogApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *A2ACatalogApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithPathPrefix sets the PathPrefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PathPrefix field is set to the value of the last call.
+func (b *A2ACatalogApplyConfiguration) WithPathPrefix(value string) *A2ACatalogApplyConfiguration {
+	b.PathPrefix = &value
+	return b
+}
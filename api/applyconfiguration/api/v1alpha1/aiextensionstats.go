@@ -2,10 +2,16 @@
 
 package v1alpha1
 
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
 // AiExtensionStatsApplyConfiguration represents a declarative configuration of the AiExtensionStats type for use
 // with apply.
 type AiExtensionStatsApplyConfiguration struct {
 	CustomLabels []CustomLabelApplyConfiguration `json:"customLabels,omitempty"`
+	ClientHeader *v1.HeaderName                  `json:"clientHeader,omitempty"`
+	Clients      []string                        `json:"clients,omitempty"`
 }
 
 // AiExtensionStatsApplyConfiguration constructs a declarative configuration of the AiExtensionStats type for use with
@@ -26,3 +32,21 @@ func (b *AiExtensionStatsApplyConfiguration) WithCustomLabels(values ...*CustomL
 	}
 	return b
 }
+
+// WithClientHeader sets the ClientHeader field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClientHeader field is set to the value of the last call.
+func (b *AiExtensionStatsApplyConfiguration) WithClientHeader(value v1.HeaderName) *AiExtensionStatsApplyConfiguration {
+	b.ClientHeader = &value
+	return b
+}
+
+// WithClients adds the given value to the Clients field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Clients field.
+func (b *AiExtensionStatsApplyConfiguration) WithClients(values ...string) *AiExtensionStatsApplyConfiguration {
+	for i := range values {
+		b.Clients = append(b.Clients, values[i])
+	}
+	return b
+}
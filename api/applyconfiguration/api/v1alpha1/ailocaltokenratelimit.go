@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// AILocalTokenRateLimitApplyConfiguration represents a declarative configuration of the AILocalTokenRateLimit type for use
+// with apply.
+type AILocalTokenRateLimitApplyConfiguration struct {
+	TokenBucket *TokenBucketApplyConfiguration `json:"tokenBucket,omitempty"`
+	Header      *v1.HeaderName                 `json:"header,omitempty"`
+}
+
+// AILocalTokenRateLimitApplyConfiguration constructs a declarative configuration of the AILocalTokenRateLimit type for use with
+// apply.
+func AILocalTokenRateLimit() *AILocalTokenRateLimitApplyConfiguration {
+	return &AILocalTokenRateLimitApplyConfiguration{}
+}
+
+// WithTokenBucket sets the TokenBucket field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenBucket field is set to the value of the last call.
+func (b *AILocalTokenRateLimitApplyConfiguration) WithTokenBucket(value *TokenBucketApplyConfiguration) *AILocalTokenRateLimitApplyConfiguration {
+	b.TokenBucket = value
+	return b
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *AILocalTokenRateLimitApplyConfiguration) WithHeader(value v1.HeaderName) *AILocalTokenRateLimitApplyConfiguration {
+	b.Header = &value
+	return b
+}
@@ -0,0 +1,23 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AIMemoryVectorStoreApplyConfiguration represents a declarative configuration of the AIMemoryVectorStore type for use
+// with apply.
+type AIMemoryVectorStoreApplyConfiguration struct {
+	MaxEntries *int32 `json:"maxEntries,omitempty"`
+}
+
+// AIMemoryVectorStoreApplyConfiguration constructs a declarative configuration of the AIMemoryVectorStore type for use with
+// apply.
+func AIMemoryVectorStore() *AIMemoryVectorStoreApplyConfiguration {
+	return &AIMemoryVectorStoreApplyConfiguration{}
+}
+
+// WithMaxEntries sets the MaxEntries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxEntries field is set to the value of the last call.
+func (b *AIMemoryVectorStoreApplyConfiguration) WithMaxEntries(value int32) *AIMemoryVectorStoreApplyConfiguration {
+	b.MaxEntries = &value
+	return b
+}
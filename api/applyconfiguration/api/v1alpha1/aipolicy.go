@@ -13,6 +13,8 @@ type AIPolicyApplyConfiguration struct {
 	PromptGuard      *AIPromptGuardApplyConfiguration      `json:"promptGuard,omitempty"`
 	Defaults         []FieldDefaultApplyConfiguration      `json:"defaults,omitempty"`
 	RouteType        *apiv1alpha1.RouteType                `json:"routeType,omitempty"`
+	TokenRateLimit   *AITokenRateLimitApplyConfiguration   `json:"tokenRateLimit,omitempty"`
+	SemanticCache    *AISemanticCacheApplyConfiguration    `json:"semanticCache,omitempty"`
 }
 
 // AIPolicyApplyConfiguration constructs a declarative configuration of the AIPolicy type for use with
@@ -57,3 +59,19 @@ func (b *AIPolicyApplyConfiguration) WithRouteType(value apiv1alpha1.RouteType)
 	b.RouteType = &value
 	return b
 }
+
+// WithTokenRateLimit sets the TokenRateLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenRateLimit field is set to the value of the last call.
+func (b *AIPolicyApplyConfiguration) WithTokenRateLimit(value *AITokenRateLimitApplyConfiguration) *AIPolicyApplyConfiguration {
+	b.TokenRateLimit = value
+	return b
+}
+
+// WithSemanticCache sets the SemanticCache field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SemanticCache field is set to the value of the last call.
+func (b *AIPolicyApplyConfiguration) WithSemanticCache(value *AISemanticCacheApplyConfiguration) *AIPolicyApplyConfiguration {
+	b.SemanticCache = value
+	return b
+}
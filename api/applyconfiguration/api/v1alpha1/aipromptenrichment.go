@@ -5,8 +5,9 @@ package v1alpha1
 // AIPromptEnrichmentApplyConfiguration represents a declarative configuration of the AIPromptEnrichment type for use
 // with apply.
 type AIPromptEnrichmentApplyConfiguration struct {
-	Prepend []MessageApplyConfiguration `json:"prepend,omitempty"`
-	Append  []MessageApplyConfiguration `json:"append,omitempty"`
+	Prepend   []MessageApplyConfiguration        `json:"prepend,omitempty"`
+	Append    []MessageApplyConfiguration        `json:"append,omitempty"`
+	Templates []PromptTemplateApplyConfiguration `json:"templates,omitempty"`
 }
 
 // AIPromptEnrichmentApplyConfiguration constructs a declarative configuration of the AIPromptEnrichment type for use with
@@ -40,3 +41,16 @@ func (b *AIPromptEnrichmentApplyConfiguration) WithAppend(values ...*MessageAppl
 	}
 	return b
 }
+
+// WithTemplates adds the given value to the Templates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Templates field.
+func (b *AIPromptEnrichmentApplyConfiguration) WithTemplates(values ...*PromptTemplateApplyConfiguration) *AIPromptEnrichmentApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTemplates")
+		}
+		b.Templates = append(b.Templates, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AISemanticCacheApplyConfiguration represents a declarative configuration of the AISemanticCache type for use
+// with apply.
+type AISemanticCacheApplyConfiguration struct {
+	Embedding           *AISemanticCacheEmbeddingApplyConfiguration `json:"embedding,omitempty"`
+	Store               *AISemanticCacheStoreApplyConfiguration     `json:"store,omitempty"`
+	SimilarityThreshold *string                                     `json:"similarityThreshold,omitempty"`
+	TTL                 *v1.Duration                                `json:"ttl,omitempty"`
+}
+
+// AISemanticCacheApplyConfiguration constructs a declarative configuration of the AISemanticCache type for use with
+// apply.
+func AISemanticCache() *AISemanticCacheApplyConfiguration {
+	return &AISemanticCacheApplyConfiguration{}
+}
+
+// WithEmbedding sets the Embedding field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Embedding field is set to the value of the last call.
+func (b *AISemanticCacheApplyConfiguration) WithEmbedding(value *AISemanticCacheEmbeddingApplyConfiguration) *AISemanticCacheApplyConfiguration {
+	b.Embedding = value
+	return b
+}
+
+// WithStore sets the Store field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Store field is set to the value of the last call.
+func (b *AISemanticCacheApplyConfiguration) WithStore(value *AISemanticCacheStoreApplyConfiguration) *AISemanticCacheApplyConfiguration {
+	b.Store = value
+	return b
+}
+
+// WithSimilarityThreshold sets the SimilarityThreshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SimilarityThreshold field is set to the value of the last call.
+func (b *AISemanticCacheApplyConfiguration) WithSimilarityThreshold(value string) *AISemanticCacheApplyConfiguration {
+	b.SimilarityThreshold = &value
+	return b
+}
+
+// WithTTL sets the TTL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TTL field is set to the value of the last call.
+func (b *AISemanticCacheApplyConfiguration) WithTTL(value v1.Duration) *AISemanticCacheApplyConfiguration {
+	b.TTL = &value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// AISemanticCacheEmbeddingApplyConfiguration represents a declarative configuration of the AISemanticCacheEmbedding type for use
+// with apply.
+type AISemanticCacheEmbeddingApplyConfiguration struct {
+	BackendRef *v1.LocalObjectReference `json:"backendRef,omitempty"`
+	Model      *string                  `json:"model,omitempty"`
+}
+
+// AISemanticCacheEmbeddingApplyConfiguration constructs a declarative configuration of the AISemanticCacheEmbedding type for use with
+// apply.
+func AISemanticCacheEmbedding() *AISemanticCacheEmbeddingApplyConfiguration {
+	return &AISemanticCacheEmbeddingApplyConfiguration{}
+}
+
+// WithBackendRef sets the BackendRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackendRef field is set to the value of the last call.
+func (b *AISemanticCacheEmbeddingApplyConfiguration) WithBackendRef(value v1.LocalObjectReference) *AISemanticCacheEmbeddingApplyConfiguration {
+	b.BackendRef = &value
+	return b
+}
+
+// WithModel sets the Model field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Model field is set to the value of the last call.
+func (b *AISemanticCacheEmbeddingApplyConfiguration) WithModel(value string) *AISemanticCacheEmbeddingApplyConfiguration {
+	b.Model = &value
+	return b
+}
@@ -0,0 +1,23 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AISemanticCacheStoreApplyConfiguration represents a declarative configuration of the AISemanticCacheStore type for use
+// with apply.
+type AISemanticCacheStoreApplyConfiguration struct {
+	Memory *AIMemoryVectorStoreApplyConfiguration `json:"memory,omitempty"`
+}
+
+// AISemanticCacheStoreApplyConfiguration constructs a declarative configuration of the AISemanticCacheStore type for use with
+// apply.
+func AISemanticCacheStore() *AISemanticCacheStoreApplyConfiguration {
+	return &AISemanticCacheStoreApplyConfiguration{}
+}
+
+// WithMemory sets the Memory field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Memory field is set to the value of the last call.
+func (b *AISemanticCacheStoreApplyConfiguration) WithMemory(value *AIMemoryVectorStoreApplyConfiguration) *AISemanticCacheStoreApplyConfiguration {
+	b.Memory = value
+	return b
+}
@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AITokenRateLimitApplyConfiguration represents a declarative configuration of the AITokenRateLimit type for use
+// with apply.
+type AITokenRateLimitApplyConfiguration struct {
+	Local  *AILocalTokenRateLimitApplyConfiguration `json:"local,omitempty"`
+	Global *RateLimitPolicyApplyConfiguration       `json:"global,omitempty"`
+}
+
+// AITokenRateLimitApplyConfiguration constructs a declarative configuration of the AITokenRateLimit type for use with
+// apply.
+func AITokenRateLimit() *AITokenRateLimitApplyConfiguration {
+	return &AITokenRateLimitApplyConfiguration{}
+}
+
+// WithLocal sets the Local field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Local field is set to the value of the last call.
+func (b *AITokenRateLimitApplyConfiguration) WithLocal(value *AILocalTokenRateLimitApplyConfiguration) *AITokenRateLimitApplyConfiguration {
+	b.Local = value
+	return b
+}
+
+// WithGlobal sets the Global field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Global field is set to the value of the last call.
+func (b *AITokenRateLimitApplyConfiguration) WithGlobal(value *RateLimitPolicyApplyConfiguration) *AITokenRateLimitApplyConfiguration {
+	b.Global = value
+	return b
+}
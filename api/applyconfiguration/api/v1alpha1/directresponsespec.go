@@ -5,8 +5,9 @@ package v1alpha1
 // DirectResponseSpecApplyConfiguration represents a declarative configuration of the DirectResponseSpec type for use
 // with apply.
 type DirectResponseSpecApplyConfiguration struct {
-	StatusCode *uint32 `json:"status,omitempty"`
-	Body       *string `json:"body,omitempty"`
+	StatusCode *uint32                       `json:"status,omitempty"`
+	Body       *string                       `json:"body,omitempty"`
+	A2ACatalog *A2ACatalogApplyConfiguration `json:"a2aCatalog,omitempty"`
 }
 
 // DirectResponseSpecApplyConfiguration constructs a declarative configuration of the DirectResponseSpec type for use with
@@ -30,3 +31,11 @@ func (b *DirectResponseSpecApplyConfiguration) WithBody(value string) *DirectRes
 	b.Body = &value
 	return b
 }
+
+// WithA2ACatalog sets the A2ACatalog field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the A2ACatalog field is set to the value of the last call.
+func (b *DirectResponseSpecApplyConfiguration) WithA2ACatalog(value *A2ACatalogApplyConfiguration) *DirectResponseSpecApplyConfiguration {
+	b.A2ACatalog = value
+	return b
+}
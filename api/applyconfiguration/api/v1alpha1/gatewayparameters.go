@@ -17,8 +17,8 @@ import (
 type GatewayParametersApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *GatewayParametersSpecApplyConfiguration `json:"spec,omitempty"`
-	Status                           *apiv1alpha1.GatewayParametersStatus     `json:"status,omitempty"`
+	Spec                             *GatewayParametersSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *GatewayParametersStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // GatewayParameters constructs a declarative configuration of the GatewayParameters type for use with
@@ -237,8 +237,8 @@ func (b *GatewayParametersApplyConfiguration) WithSpec(value *GatewayParametersS
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
-func (b *GatewayParametersApplyConfiguration) WithStatus(value apiv1alpha1.GatewayParametersStatus) *GatewayParametersApplyConfiguration {
-	b.Status = &value
+func (b *GatewayParametersApplyConfiguration) WithStatus(value *GatewayParametersStatusApplyConfiguration) *GatewayParametersApplyConfiguration {
+	b.Status = value
 	return b
 }
 
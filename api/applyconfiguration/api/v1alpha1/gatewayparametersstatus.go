@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// GatewayParametersStatusApplyConfiguration represents a declarative configuration of the GatewayParametersStatus type for use
+// with apply.
+type GatewayParametersStatusApplyConfiguration struct {
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// GatewayParametersStatusApplyConfiguration constructs a declarative configuration of the GatewayParametersStatus type for use with
+// apply.
+func GatewayParametersStatus() *GatewayParametersStatusApplyConfiguration {
+	return &GatewayParametersStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *GatewayParametersStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *GatewayParametersStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -2,20 +2,30 @@
 
 package v1alpha1
 
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
 // KubernetesProxyConfigApplyConfiguration represents a declarative configuration of the KubernetesProxyConfig type for use
 // with apply.
 type KubernetesProxyConfigApplyConfiguration struct {
-	Deployment     *ProxyDeploymentApplyConfiguration  `json:"deployment,omitempty"`
-	EnvoyContainer *EnvoyContainerApplyConfiguration   `json:"envoyContainer,omitempty"`
-	SdsContainer   *SdsContainerApplyConfiguration     `json:"sdsContainer,omitempty"`
-	PodTemplate    *PodApplyConfiguration              `json:"podTemplate,omitempty"`
-	Service        *ServiceApplyConfiguration          `json:"service,omitempty"`
-	ServiceAccount *ServiceAccountApplyConfiguration   `json:"serviceAccount,omitempty"`
-	Istio          *IstioIntegrationApplyConfiguration `json:"istio,omitempty"`
-	Stats          *StatsConfigApplyConfiguration      `json:"stats,omitempty"`
-	AiExtension    *AiExtensionApplyConfiguration      `json:"aiExtension,omitempty"`
-	AgentGateway   *AgentGatewayApplyConfiguration     `json:"agentGateway,omitempty"`
-	FloatingUserId *bool                               `json:"floatingUserId,omitempty"`
+	WorkloadKind            *apiv1alpha1.WorkloadKind                  `json:"workloadKind,omitempty"`
+	Deployment              *ProxyDeploymentApplyConfiguration         `json:"deployment,omitempty"`
+	DaemonSet               *ProxyDaemonSetApplyConfiguration          `json:"daemonSet,omitempty"`
+	EnvoyContainer          *EnvoyContainerApplyConfiguration          `json:"envoyContainer,omitempty"`
+	SdsContainer            *SdsContainerApplyConfiguration            `json:"sdsContainer,omitempty"`
+	PodTemplate             *PodApplyConfiguration                     `json:"podTemplate,omitempty"`
+	Service                 *ServiceApplyConfiguration                 `json:"service,omitempty"`
+	ServiceAccount          *ServiceAccountApplyConfiguration          `json:"serviceAccount,omitempty"`
+	PodDisruptionBudget     *PodDisruptionBudgetApplyConfiguration     `json:"podDisruptionBudget,omitempty"`
+	HorizontalPodAutoscaler *HorizontalPodAutoscalerApplyConfiguration `json:"horizontalPodAutoscaler,omitempty"`
+	NetworkPolicy           *NetworkPolicyApplyConfiguration           `json:"networkPolicy,omitempty"`
+	Istio                   *IstioIntegrationApplyConfiguration        `json:"istio,omitempty"`
+	Stats                   *StatsConfigApplyConfiguration             `json:"stats,omitempty"`
+	AiExtension             *AiExtensionApplyConfiguration             `json:"aiExtension,omitempty"`
+	AgentGateway            *AgentGatewayApplyConfiguration            `json:"agentGateway,omitempty"`
+	FloatingUserId          *bool                                      `json:"floatingUserId,omitempty"`
+	Overlays                []ObjectOverlayApplyConfiguration          `json:"overlays,omitempty"`
 }
 
 // KubernetesProxyConfigApplyConfiguration constructs a declarative configuration of the KubernetesProxyConfig type for use with
@@ -24,6 +34,14 @@ func KubernetesProxyConfig() *KubernetesProxyConfigApplyConfiguration {
 	return &KubernetesProxyConfigApplyConfiguration{}
 }
 
+// WithWorkloadKind sets the WorkloadKind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkloadKind field is set to the value of the last call.
+func (b *KubernetesProxyConfigApplyConfiguration) WithWorkloadKind(value apiv1alpha1.WorkloadKind) *KubernetesProxyConfigApplyConfiguration {
+	b.WorkloadKind = &value
+	return b
+}
+
 // WithDeployment sets the Deployment field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Deployment field is set to the value of the last call.
@@ -32,6 +50,14 @@ func (b *KubernetesProxyConfigApplyConfiguration) WithDeployment(value *ProxyDep
 	return b
 }
 
+// WithDaemonSet sets the DaemonSet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DaemonSet field is set to the value of the last call.
+func (b *KubernetesProxyConfigApplyConfiguration) WithDaemonSet(value *ProxyDaemonSetApplyConfiguration) *KubernetesProxyConfigApplyConfiguration {
+	b.DaemonSet = value
+	return b
+}
+
 // WithEnvoyContainer sets the EnvoyContainer field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the EnvoyContainer field is set to the value of the last call.
@@ -72,6 +98,30 @@ func (b *KubernetesProxyConfigApplyConfiguration) WithServiceAccount(value *Serv
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *KubernetesProxyConfigApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetApplyConfiguration) *KubernetesProxyConfigApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
+// WithHorizontalPodAutoscaler sets the HorizontalPodAutoscaler field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HorizontalPodAutoscaler field is set to the value of the last call.
+func (b *KubernetesProxyConfigApplyConfiguration) WithHorizontalPodAutoscaler(value *HorizontalPodAutoscalerApplyConfiguration) *KubernetesProxyConfigApplyConfiguration {
+	b.HorizontalPodAutoscaler = value
+	return b
+}
+
+// WithNetworkPolicy sets the NetworkPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkPolicy field is set to the value of the last call.
+func (b *KubernetesProxyConfigApplyConfiguration) WithNetworkPolicy(value *NetworkPolicyApplyConfiguration) *KubernetesProxyConfigApplyConfiguration {
+	b.NetworkPolicy = value
+	return b
+}
+
 // WithIstio sets the Istio field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Istio field is set to the value of the last call.
@@ -111,3 +161,16 @@ func (b *KubernetesProxyConfigApplyConfiguration) WithFloatingUserId(value bool)
 	b.FloatingUserId = &value
 	return b
 }
+
+// WithOverlays adds the given value to the Overlays field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Overlays field.
+func (b *KubernetesProxyConfigApplyConfiguration) WithOverlays(values ...*ObjectOverlayApplyConfiguration) *KubernetesProxyConfigApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOverlays")
+		}
+		b.Overlays = append(b.Overlays, *values[i])
+	}
+	return b
+}
@@ -2,6 +2,10 @@
 
 package v1alpha1
 
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
 // LLMProviderApplyConfiguration represents a declarative configuration of the LLMProvider type for use
 // with apply.
 type LLMProviderApplyConfiguration struct {
@@ -9,6 +13,7 @@ type LLMProviderApplyConfiguration struct {
 	HostOverride       *HostApplyConfiguration                 `json:"hostOverride,omitempty"`
 	PathOverride       *PathOverrideApplyConfiguration         `json:"pathOverride,omitempty"`
 	AuthHeaderOverride *AuthHeaderOverrideApplyConfiguration   `json:"authHeaderOverride,omitempty"`
+	APIFormat          *apiv1alpha1.APIFormat                  `json:"apiFormat,omitempty"`
 }
 
 // LLMProviderApplyConfiguration constructs a declarative configuration of the LLMProvider type for use with
@@ -48,3 +53,11 @@ func (b *LLMProviderApplyConfiguration) WithAuthHeaderOverride(value *AuthHeader
 	b.AuthHeaderOverride = value
 	return b
 }
+
+// WithAPIFormat sets the APIFormat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIFormat field is set to the value of the last call.
+func (b *LLMProviderApplyConfiguration) WithAPIFormat(value apiv1alpha1.APIFormat) *LLMProviderApplyConfiguration {
+	b.APIFormat = &value
+	return b
+}
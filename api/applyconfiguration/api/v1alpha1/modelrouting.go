@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// ModelRoutingApplyConfiguration represents a declarative configuration of the ModelRouting type for use
+// with apply.
+type ModelRoutingApplyConfiguration struct {
+	Header *v1.HeaderName `json:"header,omitempty"`
+	Field  *string        `json:"field,omitempty"`
+}
+
+// ModelRoutingApplyConfiguration constructs a declarative configuration of the ModelRouting type for use with
+// apply.
+func ModelRouting() *ModelRoutingApplyConfiguration {
+	return &ModelRoutingApplyConfiguration{}
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *ModelRoutingApplyConfiguration) WithHeader(value v1.HeaderName) *ModelRoutingApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithField sets the Field field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Field field is set to the value of the last call.
+func (b *ModelRoutingApplyConfiguration) WithField(value string) *ModelRoutingApplyConfiguration {
+	b.Field = &value
+	return b
+}
@@ -5,7 +5,8 @@ package v1alpha1
 // MultiPoolConfigApplyConfiguration represents a declarative configuration of the MultiPoolConfig type for use
 // with apply.
 type MultiPoolConfigApplyConfiguration struct {
-	Priorities []PriorityApplyConfiguration `json:"priorities,omitempty"`
+	Priorities []PriorityApplyConfiguration         `json:"priorities,omitempty"`
+	Failover   *MultiPoolFailoverApplyConfiguration `json:"failover,omitempty"`
 }
 
 // MultiPoolConfigApplyConfiguration constructs a declarative configuration of the MultiPoolConfig type for use with
@@ -26,3 +27,11 @@ func (b *MultiPoolConfigApplyConfiguration) WithPriorities(values ...*PriorityAp
 	}
 	return b
 }
+
+// WithFailover sets the Failover field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Failover field is set to the value of the last call.
+func (b *MultiPoolConfigApplyConfiguration) WithFailover(value *MultiPoolFailoverApplyConfiguration) *MultiPoolConfigApplyConfiguration {
+	b.Failover = value
+	return b
+}
@@ -0,0 +1,65 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MultiPoolFailoverApplyConfiguration represents a declarative configuration of the MultiPoolFailover type for use
+// with apply.
+type MultiPoolFailoverApplyConfiguration struct {
+	StatusCodes         []int32      `json:"statusCodes,omitempty"`
+	MaxRetries          *int32       `json:"maxRetries,omitempty"`
+	MaxRetryAfter       *v1.Duration `json:"maxRetryAfter,omitempty"`
+	ConsecutiveFailures *int32       `json:"consecutiveFailures,omitempty"`
+	Cooldown            *v1.Duration `json:"cooldown,omitempty"`
+}
+
+// MultiPoolFailoverApplyConfiguration constructs a declarative configuration of the MultiPoolFailover type for use with
+// apply.
+func MultiPoolFailover() *MultiPoolFailoverApplyConfiguration {
+	return &MultiPoolFailoverApplyConfiguration{}
+}
+
+// WithStatusCodes adds the given value to the StatusCodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the StatusCodes field.
+func (b *MultiPoolFailoverApplyConfiguration) WithStatusCodes(values ...int32) *MultiPoolFailoverApplyConfiguration {
+	for i := range values {
+		b.StatusCodes = append(b.StatusCodes, values[i])
+	}
+	return b
+}
+
+// WithMaxRetries sets the MaxRetries field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRetries field is set to the value of the last call.
+func (b *MultiPoolFailoverApplyConfiguration) WithMaxRetries(value int32) *MultiPoolFailoverApplyConfiguration {
+	b.MaxRetries = &value
+	return b
+}
+
+// WithMaxRetryAfter sets the MaxRetryAfter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRetryAfter field is set to the value of the last call.
+func (b *MultiPoolFailoverApplyConfiguration) WithMaxRetryAfter(value v1.Duration) *MultiPoolFailoverApplyConfiguration {
+	b.MaxRetryAfter = &value
+	return b
+}
+
+// WithConsecutiveFailures sets the ConsecutiveFailures field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConsecutiveFailures field is set to the value of the last call.
+func (b *MultiPoolFailoverApplyConfiguration) WithConsecutiveFailures(value int32) *MultiPoolFailoverApplyConfiguration {
+	b.ConsecutiveFailures = &value
+	return b
+}
+
+// WithCooldown sets the Cooldown field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Cooldown field is set to the value of the last call.
+func (b *MultiPoolFailoverApplyConfiguration) WithCooldown(value v1.Duration) *MultiPoolFailoverApplyConfiguration {
+	b.Cooldown = &value
+	return b
+}
@@ -0,0 +1,66 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// NetworkPolicyApplyConfiguration represents a declarative configuration of the NetworkPolicy type for use
+// with apply.
+type NetworkPolicyApplyConfiguration struct {
+	ExtraEgressNamespaces []string          `json:"extraEgressNamespaces,omitempty"`
+	ExtraEgressCIDRs      []string          `json:"extraEgressCIDRs,omitempty"`
+	ExtraLabels           map[string]string `json:"extraLabels,omitempty"`
+	ExtraAnnotations      map[string]string `json:"extraAnnotations,omitempty"`
+}
+
+// NetworkPolicyApplyConfiguration constructs a declarative configuration of the NetworkPolicy type for use with
+// apply.
+func NetworkPolicy() *NetworkPolicyApplyConfiguration {
+	return &NetworkPolicyApplyConfiguration{}
+}
+
+// WithExtraEgressNamespaces adds the given value to the ExtraEgressNamespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExtraEgressNamespaces field.
+func (b *NetworkPolicyApplyConfiguration) WithExtraEgressNamespaces(values ...string) *NetworkPolicyApplyConfiguration {
+	for i := range values {
+		b.ExtraEgressNamespaces = append(b.ExtraEgressNamespaces, values[i])
+	}
+	return b
+}
+
+// WithExtraEgressCIDRs adds the given value to the ExtraEgressCIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExtraEgressCIDRs field.
+func (b *NetworkPolicyApplyConfiguration) WithExtraEgressCIDRs(values ...string) *NetworkPolicyApplyConfiguration {
+	for i := range values {
+		b.ExtraEgressCIDRs = append(b.ExtraEgressCIDRs, values[i])
+	}
+	return b
+}
+
+// WithExtraLabels puts the entries into the ExtraLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ExtraLabels field,
+// overwriting an existing map entries in ExtraLabels field with the same key.
+func (b *NetworkPolicyApplyConfiguration) WithExtraLabels(entries map[string]string) *NetworkPolicyApplyConfiguration {
+	if b.ExtraLabels == nil && len(entries) > 0 {
+		b.ExtraLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ExtraLabels[k] = v
+	}
+	return b
+}
+
+// WithExtraAnnotations puts the entries into the ExtraAnnotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ExtraAnnotations field,
+// overwriting an existing map entries in ExtraAnnotations field with the same key.
+func (b *NetworkPolicyApplyConfiguration) WithExtraAnnotations(entries map[string]string) *NetworkPolicyApplyConfiguration {
+	if b.ExtraAnnotations == nil && len(entries) > 0 {
+		b.ExtraAnnotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ExtraAnnotations[k] = v
+	}
+	return b
+}
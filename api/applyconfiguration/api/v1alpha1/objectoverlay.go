@@ -0,0 +1,46 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ObjectOverlayApplyConfiguration represents a declarative configuration of the ObjectOverlay type for use
+// with apply.
+type ObjectOverlayApplyConfiguration struct {
+	Kind    *string                          `json:"kind,omitempty"`
+	Name    *string                          `json:"name,omitempty"`
+	Patches []OverlayPatchApplyConfiguration `json:"patches,omitempty"`
+}
+
+// ObjectOverlayApplyConfiguration constructs a declarative configuration of the ObjectOverlay type for use with
+// apply.
+func ObjectOverlay() *ObjectOverlayApplyConfiguration {
+	return &ObjectOverlayApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *ObjectOverlayApplyConfiguration) WithKind(value string) *ObjectOverlayApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ObjectOverlayApplyConfiguration) WithName(value string) *ObjectOverlayApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPatches adds the given value to the Patches field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Patches field.
+func (b *ObjectOverlayApplyConfiguration) WithPatches(values ...*OverlayPatchApplyConfiguration) *ObjectOverlayApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPatches")
+		}
+		b.Patches = append(b.Patches, *values[i])
+	}
+	return b
+}
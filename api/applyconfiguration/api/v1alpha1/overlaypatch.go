@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// OverlayPatchApplyConfiguration represents a declarative configuration of the OverlayPatch type for use
+// with apply.
+type OverlayPatchApplyConfiguration struct {
+	Type  *apiv1alpha1.OverlayPatchType `json:"type,omitempty"`
+	Patch *string                       `json:"patch,omitempty"`
+}
+
+// OverlayPatchApplyConfiguration constructs a declarative configuration of the OverlayPatch type for use with
+// apply.
+func OverlayPatch() *OverlayPatchApplyConfiguration {
+	return &OverlayPatchApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *OverlayPatchApplyConfiguration) WithType(value apiv1alpha1.OverlayPatchType) *OverlayPatchApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithPatch sets the Patch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Patch field is set to the value of the last call.
+func (b *OverlayPatchApplyConfiguration) WithPatch(value string) *OverlayPatchApplyConfiguration {
+	b.Patch = &value
+	return b
+}
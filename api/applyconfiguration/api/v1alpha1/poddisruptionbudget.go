@@ -0,0 +1,66 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// PodDisruptionBudgetApplyConfiguration represents a declarative configuration of the PodDisruptionBudget type for use
+// with apply.
+type PodDisruptionBudgetApplyConfiguration struct {
+	MinAvailable     *intstr.IntOrString `json:"minAvailable,omitempty"`
+	MaxUnavailable   *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	ExtraLabels      map[string]string   `json:"extraLabels,omitempty"`
+	ExtraAnnotations map[string]string   `json:"extraAnnotations,omitempty"`
+}
+
+// PodDisruptionBudgetApplyConfiguration constructs a declarative configuration of the PodDisruptionBudget type for use with
+// apply.
+func PodDisruptionBudget() *PodDisruptionBudgetApplyConfiguration {
+	return &PodDisruptionBudgetApplyConfiguration{}
+}
+
+// WithMinAvailable sets the MinAvailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinAvailable field is set to the value of the last call.
+func (b *PodDisruptionBudgetApplyConfiguration) WithMinAvailable(value intstr.IntOrString) *PodDisruptionBudgetApplyConfiguration {
+	b.MinAvailable = &value
+	return b
+}
+
+// WithMaxUnavailable sets the MaxUnavailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnavailable field is set to the value of the last call.
+func (b *PodDisruptionBudgetApplyConfiguration) WithMaxUnavailable(value intstr.IntOrString) *PodDisruptionBudgetApplyConfiguration {
+	b.MaxUnavailable = &value
+	return b
+}
+
+// WithExtraLabels puts the entries into the ExtraLabels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ExtraLabels field,
+// overwriting an existing map entries in ExtraLabels field with the same key.
+func (b *PodDisruptionBudgetApplyConfiguration) WithExtraLabels(entries map[string]string) *PodDisruptionBudgetApplyConfiguration {
+	if b.ExtraLabels == nil && len(entries) > 0 {
+		b.ExtraLabels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ExtraLabels[k] = v
+	}
+	return b
+}
+
+// WithExtraAnnotations puts the entries into the ExtraAnnotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ExtraAnnotations field,
+// overwriting an existing map entries in ExtraAnnotations field with the same key.
+func (b *PodDisruptionBudgetApplyConfiguration) WithExtraAnnotations(entries map[string]string) *PodDisruptionBudgetApplyConfiguration {
+	if b.ExtraAnnotations == nil && len(entries) > 0 {
+		b.ExtraAnnotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ExtraAnnotations[k] = v
+	}
+	return b
+}
@@ -5,8 +5,9 @@ package v1alpha1
 // PromptguardResponseApplyConfiguration represents a declarative configuration of the PromptguardResponse type for use
 // with apply.
 type PromptguardResponseApplyConfiguration struct {
-	Regex   *RegexApplyConfiguration   `json:"regex,omitempty"`
-	Webhook *WebhookApplyConfiguration `json:"webhook,omitempty"`
+	Regex     *RegexApplyConfiguration                `json:"regex,omitempty"`
+	Webhook   *WebhookApplyConfiguration              `json:"webhook,omitempty"`
+	Streaming *StreamingPromptguardApplyConfiguration `json:"streaming,omitempty"`
 }
 
 // PromptguardResponseApplyConfiguration constructs a declarative configuration of the PromptguardResponse type for use with
@@ -30,3 +31,11 @@ func (b *PromptguardResponseApplyConfiguration) WithWebhook(value *WebhookApplyC
 	b.Webhook = value
 	return b
 }
+
+// WithStreaming sets the Streaming field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Streaming field is set to the value of the last call.
+func (b *PromptguardResponseApplyConfiguration) WithStreaming(value *StreamingPromptguardApplyConfiguration) *PromptguardResponseApplyConfiguration {
+	b.Streaming = value
+	return b
+}
@@ -0,0 +1,70 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// PromptTemplateApplyConfiguration represents a declarative configuration of the PromptTemplate type for use
+// with apply.
+type PromptTemplateApplyConfiguration struct {
+	ConfigMapRef *v1.LocalObjectReference                   `json:"configMapRef,omitempty"`
+	Version      *string                                    `json:"version,omitempty"`
+	Role         *apiv1alpha1.PromptTemplateRole            `json:"role,omitempty"`
+	Position     *apiv1alpha1.PromptTemplatePosition        `json:"position,omitempty"`
+	Variables    []PromptTemplateVariableApplyConfiguration `json:"variables,omitempty"`
+}
+
+// PromptTemplateApplyConfiguration constructs a declarative configuration of the PromptTemplate type for use with
+// apply.
+func PromptTemplate() *PromptTemplateApplyConfiguration {
+	return &PromptTemplateApplyConfiguration{}
+}
+
+// WithConfigMapRef sets the ConfigMapRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapRef field is set to the value of the last call.
+func (b *PromptTemplateApplyConfiguration) WithConfigMapRef(value v1.LocalObjectReference) *PromptTemplateApplyConfiguration {
+	b.ConfigMapRef = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *PromptTemplateApplyConfiguration) WithVersion(value string) *PromptTemplateApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithRole sets the Role field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Role field is set to the value of the last call.
+func (b *PromptTemplateApplyConfiguration) WithRole(value apiv1alpha1.PromptTemplateRole) *PromptTemplateApplyConfiguration {
+	b.Role = &value
+	return b
+}
+
+// WithPosition sets the Position field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Position field is set to the value of the last call.
+func (b *PromptTemplateApplyConfiguration) WithPosition(value apiv1alpha1.PromptTemplatePosition) *PromptTemplateApplyConfiguration {
+	b.Position = &value
+	return b
+}
+
+// WithVariables adds the given value to the Variables field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Variables field.
+func (b *PromptTemplateApplyConfiguration) WithVariables(values ...*PromptTemplateVariableApplyConfiguration) *PromptTemplateApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVariables")
+		}
+		b.Variables = append(b.Variables, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// PromptTemplateVariableApplyConfiguration represents a declarative configuration of the PromptTemplateVariable type for use
+// with apply.
+type PromptTemplateVariableApplyConfiguration struct {
+	Name    *string        `json:"name,omitempty"`
+	Header  *v1.HeaderName `json:"header,omitempty"`
+	Claim   *string        `json:"claim,omitempty"`
+	Default *string        `json:"default,omitempty"`
+}
+
+// PromptTemplateVariableApplyConfiguration constructs a declarative configuration of the PromptTemplateVariable type for use with
+// apply.
+func PromptTemplateVariable() *PromptTemplateVariableApplyConfiguration {
+	return &PromptTemplateVariableApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *PromptTemplateVariableApplyConfiguration) WithName(value string) *PromptTemplateVariableApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithHeader sets the Header field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Header field is set to the value of the last call.
+func (b *PromptTemplateVariableApplyConfiguration) WithHeader(value v1.HeaderName) *PromptTemplateVariableApplyConfiguration {
+	b.Header = &value
+	return b
+}
+
+// WithClaim sets the Claim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Claim field is set to the value of the last call.
+func (b *PromptTemplateVariableApplyConfiguration) WithClaim(value string) *PromptTemplateVariableApplyConfiguration {
+	b.Claim = &value
+	return b
+}
+
+// WithDefault sets the Default field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Default field is set to the value of the last call.
+func (b *PromptTemplateVariableApplyConfiguration) WithDefault(value string) *PromptTemplateVariableApplyConfiguration {
+	b.Default = &value
+	return b
+}
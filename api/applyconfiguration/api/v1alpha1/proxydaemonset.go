@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ProxyDaemonSetApplyConfiguration represents a declarative configuration of the ProxyDaemonSet type for use
+// with apply.
+type ProxyDaemonSetApplyConfiguration struct {
+	HostNetwork *bool `json:"hostNetwork,omitempty"`
+	HostPort    *bool `json:"hostPort,omitempty"`
+}
+
+// ProxyDaemonSetApplyConfiguration constructs a declarative configuration of the ProxyDaemonSet type for use with
+// apply.
+func ProxyDaemonSet() *ProxyDaemonSetApplyConfiguration {
+	return &ProxyDaemonSetApplyConfiguration{}
+}
+
+// WithHostNetwork sets the HostNetwork field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HostNetwork field is set to the value of the last call.
+func (b *ProxyDaemonSetApplyConfiguration) WithHostNetwork(value bool) *ProxyDaemonSetApplyConfiguration {
+	b.HostNetwork = &value
+	return b
+}
+
+// WithHostPort sets the HostPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HostPort field is set to the value of the last call.
+func (b *ProxyDaemonSetApplyConfiguration) WithHostPort(value bool) *ProxyDaemonSetApplyConfiguration {
+	b.HostPort = &value
+	return b
+}
@@ -12,6 +12,7 @@ type RegexApplyConfiguration struct {
 	Matches  []RegexMatchApplyConfiguration `json:"matches,omitempty"`
 	Builtins []apiv1alpha1.BuiltIn          `json:"builtins,omitempty"`
 	Action   *apiv1alpha1.Action            `json:"action,omitempty"`
+	Mask     *RegexMaskApplyConfiguration   `json:"mask,omitempty"`
 }
 
 // RegexApplyConfiguration constructs a declarative configuration of the Regex type for use with
@@ -50,3 +51,11 @@ func (b *RegexApplyConfiguration) WithAction(value apiv1alpha1.Action) *RegexApp
 	b.Action = &value
 	return b
 }
+
+// WithMask sets the Mask field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mask field is set to the value of the last call.
+func (b *RegexApplyConfiguration) WithMask(value *RegexMaskApplyConfiguration) *RegexApplyConfiguration {
+	b.Mask = value
+	return b
+}
@@ -0,0 +1,47 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// RegexMaskApplyConfiguration represents a declarative configuration of the RegexMask type for use
+// with apply.
+type RegexMaskApplyConfiguration struct {
+	Strategy         *apiv1alpha1.MaskStrategy `json:"strategy,omitempty"`
+	Replacement      *string                   `json:"replacement,omitempty"`
+	HashKeySecretRef *v1.LocalObjectReference  `json:"hashKeySecretRef,omitempty"`
+}
+
+// RegexMaskApplyConfiguration constructs a declarative configuration of the RegexMask type for use with
+// apply.
+func RegexMask() *RegexMaskApplyConfiguration {
+	return &RegexMaskApplyConfiguration{}
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *RegexMaskApplyConfiguration) WithStrategy(value apiv1alpha1.MaskStrategy) *RegexMaskApplyConfiguration {
+	b.Strategy = &value
+	return b
+}
+
+// WithReplacement sets the Replacement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replacement field is set to the value of the last call.
+func (b *RegexMaskApplyConfiguration) WithReplacement(value string) *RegexMaskApplyConfiguration {
+	b.Replacement = &value
+	return b
+}
+
+// WithHashKeySecretRef sets the HashKeySecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HashKeySecretRef field is set to the value of the last call.
+func (b *RegexMaskApplyConfiguration) WithHashKeySecretRef(value v1.LocalObjectReference) *RegexMaskApplyConfiguration {
+	b.HashKeySecretRef = &value
+	return b
+}
@@ -0,0 +1,54 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// SelfHostedConfigApplyConfiguration represents a declarative configuration of the SelfHostedConfig type for use
+// with apply.
+type SelfHostedConfigApplyConfiguration struct {
+	Service   *SelfHostedServiceApplyConfiguration `json:"service,omitempty"`
+	API       *apiv1alpha1.SelfHostedAPI           `json:"api,omitempty"`
+	AuthToken *SingleAuthTokenApplyConfiguration   `json:"authToken,omitempty"`
+	Model     *string                              `json:"model,omitempty"`
+}
+
+// SelfHostedConfigApplyConfiguration constructs a declarative configuration of the SelfHostedConfig type for use with
+// apply.
+func SelfHostedConfig() *SelfHostedConfigApplyConfiguration {
+	return &SelfHostedConfigApplyConfiguration{}
+}
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *SelfHostedConfigApplyConfiguration) WithService(value *SelfHostedServiceApplyConfiguration) *SelfHostedConfigApplyConfiguration {
+	b.Service = value
+	return b
+}
+
+// WithAPI sets the API field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the API field is set to the value of the last call.
+func (b *SelfHostedConfigApplyConfiguration) WithAPI(value apiv1alpha1.SelfHostedAPI) *SelfHostedConfigApplyConfiguration {
+	b.API = &value
+	return b
+}
+
+// WithAuthToken sets the AuthToken field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AuthToken field is set to the value of the last call.
+func (b *SelfHostedConfigApplyConfiguration) WithAuthToken(value *SingleAuthTokenApplyConfiguration) *SelfHostedConfigApplyConfiguration {
+	b.AuthToken = value
+	return b
+}
+
+// WithModel sets the Model field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Model field is set to the value of the last call.
+func (b *SelfHostedConfigApplyConfiguration) WithModel(value string) *SelfHostedConfigApplyConfiguration {
+	b.Model = &value
+	return b
+}
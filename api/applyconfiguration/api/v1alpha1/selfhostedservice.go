@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// SelfHostedServiceApplyConfiguration represents a declarative configuration of the SelfHostedService type for use
+// with apply.
+type SelfHostedServiceApplyConfiguration struct {
+	Name *string `json:"name,omitempty"`
+	Port *int32  `json:"port,omitempty"`
+}
+
+// SelfHostedServiceApplyConfiguration constructs a declarative configuration of the SelfHostedService type for use with
+// apply.
+func SelfHostedService() *SelfHostedServiceApplyConfiguration {
+	return &SelfHostedServiceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SelfHostedServiceApplyConfiguration) WithName(value string) *SelfHostedServiceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *SelfHostedServiceApplyConfiguration) WithPort(value int32) *SelfHostedServiceApplyConfiguration {
+	b.Port = &value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// StreamingPromptguardApplyConfiguration represents a declarative configuration of the StreamingPromptguard type for use
+// with apply.
+type StreamingPromptguardApplyConfiguration struct {
+	WindowSize *int32              `json:"windowSize,omitempty"`
+	Action     *apiv1alpha1.Action `json:"action,omitempty"`
+}
+
+// StreamingPromptguardApplyConfiguration constructs a declarative configuration of the StreamingPromptguard type for use with
+// apply.
+func StreamingPromptguard() *StreamingPromptguardApplyConfiguration {
+	return &StreamingPromptguardApplyConfiguration{}
+}
+
+// WithWindowSize sets the WindowSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WindowSize field is set to the value of the last call.
+func (b *StreamingPromptguardApplyConfiguration) WithWindowSize(value int32) *StreamingPromptguardApplyConfiguration {
+	b.WindowSize = &value
+	return b
+}
+
+// WithAction sets the Action field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Action field is set to the value of the last call.
+func (b *StreamingPromptguardApplyConfiguration) WithAction(value apiv1alpha1.Action) *StreamingPromptguardApplyConfiguration {
+	b.Action = &value
+	return b
+}
@@ -11,6 +11,7 @@ type SupportedLLMProviderApplyConfiguration struct {
 	Gemini      *GeminiConfigApplyConfiguration      `json:"gemini,omitempty"`
 	VertexAI    *VertexAIConfigApplyConfiguration    `json:"vertexai,omitempty"`
 	Bedrock     *BedrockConfigApplyConfiguration     `json:"bedrock,omitempty"`
+	SelfHosted  *SelfHostedConfigApplyConfiguration  `json:"selfhosted,omitempty"`
 }
 
 // SupportedLLMProviderApplyConfiguration constructs a declarative configuration of the SupportedLLMProvider type for use with
@@ -66,3 +67,11 @@ func (b *SupportedLLMProviderApplyConfiguration) WithBedrock(value *BedrockConfi
 	b.Bedrock = value
 	return b
 }
+
+// WithSelfHosted sets the SelfHosted field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SelfHosted field is set to the value of the last call.
+func (b *SupportedLLMProviderApplyConfiguration) WithSelfHosted(value *SelfHostedConfigApplyConfiguration) *SupportedLLMProviderApplyConfiguration {
+	b.SelfHosted = value
+	return b
+}
@@ -19,6 +19,7 @@ type TrafficPolicySpecApplyConfiguration struct {
 	Buffer          *BufferApplyConfiguration                                     `json:"buffer,omitempty"`
 	Timeouts        *TimeoutsApplyConfiguration                                   `json:"timeouts,omitempty"`
 	Retry           *RetryApplyConfiguration                                      `json:"retry,omitempty"`
+	ModelRouting    *ModelRoutingApplyConfiguration                               `json:"modelRouting,omitempty"`
 }
 
 // TrafficPolicySpecApplyConfiguration constructs a declarative configuration of the TrafficPolicySpec type for use with
@@ -148,3 +149,11 @@ func (b *TrafficPolicySpecApplyConfiguration) WithRetry(value *RetryApplyConfigu
 	b.Retry = value
 	return b
 }
+
+// WithModelRouting sets the ModelRouting field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ModelRouting field is set to the value of the last call.
+func (b *TrafficPolicySpecApplyConfiguration) WithModelRouting(value *ModelRoutingApplyConfiguration) *TrafficPolicySpecApplyConfiguration {
+	b.ModelRouting = value
+	return b
+}
@@ -23,6 +23,18 @@ func Parser() *typed.Parser {
 var parserOnce sync.Once
 var parser *typed.Parser
 var schemaYAML = typed.YAMLObject(`types:
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.A2ACatalog
+  map:
+    fields:
+    - name: namespaceSelector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+    - name: pathPrefix
+      type:
+        scalar: string
+    - name: selector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIBackend
   map:
     fields:
@@ -32,6 +44,22 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: multipool
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MultiPoolConfig
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AILocalTokenRateLimit
+  map:
+    fields:
+    - name: header
+      type:
+        scalar: string
+    - name: tokenBucket
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.TokenBucket
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIMemoryVectorStore
+  map:
+    fields:
+    - name: maxEntries
+      type:
+        scalar: numeric
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIPolicy
   map:
     fields:
@@ -50,6 +78,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: routeType
       type:
         scalar: string
+    - name: semanticCache
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCache
+    - name: tokenRateLimit
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AITokenRateLimit
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIPromptEnrichment
   map:
     fields:
@@ -65,6 +99,12 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Message
           elementRelationship: atomic
+    - name: templates
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PromptTemplate
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIPromptGuard
   map:
     fields:
@@ -74,6 +114,47 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: response
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PromptguardResponse
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCache
+  map:
+    fields:
+    - name: embedding
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCacheEmbedding
+      default: {}
+    - name: similarityThreshold
+      type:
+        scalar: string
+    - name: store
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCacheStore
+    - name: ttl
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCacheEmbedding
+  map:
+    fields:
+    - name: backendRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+      default: {}
+    - name: model
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AISemanticCacheStore
+  map:
+    fields:
+    - name: memory
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AIMemoryVectorStore
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AITokenRateLimit
+  map:
+    fields:
+    - name: global
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimitPolicy
+    - name: local
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AILocalTokenRateLimit
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AWSGuardrailConfig
   map:
     fields:
@@ -251,6 +332,15 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AiExtensionStats
   map:
     fields:
+    - name: clientHeader
+      type:
+        scalar: string
+    - name: clients
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: customLabels
       type:
         list:
@@ -845,6 +935,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.DirectResponseSpec
   map:
     fields:
+    - name: a2aCatalog
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.A2ACatalog
     - name: body
       type:
         scalar: string
@@ -1116,16 +1209,15 @@ var schemaYAML = typed.YAMLObject(`types:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SelfManagedGateway
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GatewayParametersStatus
   map:
-    elementType:
-      scalar: untyped
-      list:
-        elementType:
-          namedType: __untyped_atomic_
-        elementRelationship: atomic
-      map:
-        elementType:
-          namedType: __untyped_deduced_
-        elementRelationship: separable
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.GeminiConfig
   map:
     fields:
@@ -1334,6 +1426,32 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HorizontalPodAutoscaler
+  map:
+    fields:
+    - name: extraAnnotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: extraLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: maxReplicas
+      type:
+        scalar: numeric
+      default: 0
+    - name: minReplicas
+      type:
+        scalar: numeric
+    - name: targetCPUUtilizationPercentage
+      type:
+        scalar: numeric
+    - name: targetMemoryUtilizationPercentage
+      type:
+        scalar: numeric
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Host
   map:
     fields:
@@ -1455,6 +1573,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: aiExtension
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AiExtension
+    - name: daemonSet
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ProxyDaemonSet
     - name: deployment
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ProxyDeployment
@@ -1464,9 +1585,24 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: floatingUserId
       type:
         scalar: boolean
+    - name: horizontalPodAutoscaler
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HorizontalPodAutoscaler
     - name: istio
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.IstioIntegration
+    - name: networkPolicy
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.NetworkPolicy
+    - name: overlays
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ObjectOverlay
+          elementRelationship: atomic
+    - name: podDisruptionBudget
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PodDisruptionBudget
     - name: podTemplate
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Pod
@@ -1482,9 +1618,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: stats
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StatsConfig
+    - name: workloadKind
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.LLMProvider
   map:
     fields:
+    - name: apiFormat
+      type:
+        scalar: string
     - name: authHeaderOverride
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.AuthHeaderOverride
@@ -1740,6 +1882,15 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ModelRouting
+  map:
+    fields:
+    - name: field
+      type:
+        scalar: string
+    - name: header
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Moderation
   map:
     fields:
@@ -1749,12 +1900,36 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MultiPoolConfig
   map:
     fields:
+    - name: failover
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MultiPoolFailover
     - name: priorities
       type:
         list:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Priority
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.MultiPoolFailover
+  map:
+    fields:
+    - name: consecutiveFailures
+      type:
+        scalar: numeric
+    - name: cooldown
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: maxRetries
+      type:
+        scalar: numeric
+    - name: maxRetryAfter
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: statusCodes
+      type:
+        list:
+          elementType:
+            scalar: numeric
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.NamespacedObjectReference
   map:
     fields:
@@ -1765,6 +1940,31 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: namespace
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.NetworkPolicy
+  map:
+    fields:
+    - name: extraAnnotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: extraEgressCIDRs
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: extraEgressNamespaces
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: extraLabels
+      type:
+        map:
+          elementType:
+            scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OTelTracesSampler
   map:
     fields:
@@ -1774,6 +1974,22 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: type
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ObjectOverlay
+  map:
+    fields:
+    - name: kind
+      type:
+        scalar: string
+      default: ""
+    - name: name
+      type:
+        scalar: string
+    - name: patches
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OverlayPatch
+          elementRelationship: atomic
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OpenAIConfig
   map:
     fields:
@@ -1837,6 +2053,16 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: maxEjectionPercent
       type:
         scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OverlayPatch
+  map:
+    fields:
+    - name: patch
+      type:
+        scalar: string
+      default: ""
+    - name: type
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Parameters
   map:
     fields:
@@ -1918,6 +2144,25 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.api.core.v1.TopologySpreadConstraint
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PodDisruptionBudget
+  map:
+    fields:
+    - name: extraAnnotations
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: extraLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: maxUnavailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
+    - name: minAvailable
+      type:
+        namedType: io.k8s.apimachinery.pkg.util.intstr.IntOrString
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PolicyDisable
   map:
     elementType:
@@ -1970,6 +2215,44 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: responseTrailerMode
       type:
         scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PromptTemplate
+  map:
+    fields:
+    - name: configMapRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+      default: {}
+    - name: position
+      type:
+        scalar: string
+    - name: role
+      type:
+        scalar: string
+    - name: variables
+      type:
+        list:
+          elementType:
+            namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PromptTemplateVariable
+          elementRelationship: atomic
+    - name: version
+      type:
+        scalar: string
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PromptTemplateVariable
+  map:
+    fields:
+    - name: claim
+      type:
+        scalar: string
+    - name: default
+      type:
+        scalar: string
+    - name: header
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.PromptguardRequest
   map:
     fields:
@@ -1991,9 +2274,21 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: regex
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Regex
+    - name: streaming
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StreamingPromptguard
     - name: webhook
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.Webhook
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ProxyDaemonSet
+  map:
+    fields:
+    - name: hostNetwork
+      type:
+        scalar: boolean
+    - name: hostPort
+      type:
+        scalar: boolean
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ProxyDeployment
   map:
     fields:
@@ -2086,12 +2381,27 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+    - name: mask
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RegexMask
     - name: matches
       type:
         list:
           elementType:
             namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RegexMatch
           elementRelationship: atomic
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RegexMask
+  map:
+    fields:
+    - name: hashKeySecretRef
+      type:
+        namedType: io.k8s.api.core.v1.LocalObjectReference
+    - name: replacement
+      type:
+        scalar: string
+    - name: strategy
+      type:
+        scalar: string
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RegexMatch
   map:
     fields:
@@ -2176,6 +2486,33 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: securityContext
       type:
         namedType: io.k8s.api.core.v1.SecurityContext
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SelfHostedConfig
+  map:
+    fields:
+    - name: api
+      type:
+        scalar: string
+    - name: authToken
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SingleAuthToken
+    - name: model
+      type:
+        scalar: string
+    - name: service
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SelfHostedService
+      default: {}
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SelfHostedService
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: port
+      type:
+        scalar: numeric
+      default: 0
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SelfManagedGateway
   map:
     elementType:
@@ -2299,6 +2636,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: value
       type:
         scalar: numeric
+- name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StreamingPromptguard
+  map:
+    fields:
+    - name: action
+      type:
+        scalar: string
+    - name: windowSize
+      type:
+        scalar: numeric
 - name: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.StringMatcher
   map:
     fields:
@@ -2339,6 +2685,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: openai
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.OpenAIConfig
+    - name: selfhosted
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.SelfHostedConfig
     - name: vertexai
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.VertexAIConfig
@@ -2509,6 +2858,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: headerModifiers
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.HeaderModifiers
+    - name: modelRouting
+      type:
+        namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.ModelRouting
     - name: rateLimit
       type:
         namedType: com.github.kgateway-dev.kgateway.v2.api.v1alpha1.RateLimit
@@ -17,6 +17,8 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=gateway.kgateway.dev, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("A2ACatalog"):
+		return &apiv1alpha1.A2ACatalogApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AccessLog"):
 		return &apiv1alpha1.AccessLogApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AccessLogFilter"):
@@ -33,12 +35,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.AiExtensionStatsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AiExtensionTrace"):
 		return &apiv1alpha1.AiExtensionTraceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AILocalTokenRateLimit"):
+		return &apiv1alpha1.AILocalTokenRateLimitApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AIMemoryVectorStore"):
+		return &apiv1alpha1.AIMemoryVectorStoreApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIPolicy"):
 		return &apiv1alpha1.AIPolicyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIPromptEnrichment"):
 		return &apiv1alpha1.AIPromptEnrichmentApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AIPromptGuard"):
 		return &apiv1alpha1.AIPromptGuardApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AISemanticCache"):
+		return &apiv1alpha1.AISemanticCacheApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AISemanticCacheEmbedding"):
+		return &apiv1alpha1.AISemanticCacheEmbeddingApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AISemanticCacheStore"):
+		return &apiv1alpha1.AISemanticCacheStoreApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AITokenRateLimit"):
+		return &apiv1alpha1.AITokenRateLimitApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AnthropicConfig"):
 		return &apiv1alpha1.AnthropicConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("AnyValue"):
@@ -143,6 +157,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.GatewayParametersApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GatewayParametersSpec"):
 		return &apiv1alpha1.GatewayParametersSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GatewayParametersStatus"):
+		return &apiv1alpha1.GatewayParametersStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GeminiConfig"):
 		return &apiv1alpha1.GeminiConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("GracefulShutdownSpec"):
@@ -167,6 +183,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.HealthCheckGrpcApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HealthCheckHttp"):
 		return &apiv1alpha1.HealthCheckHttpApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler"):
+		return &apiv1alpha1.HorizontalPodAutoscalerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Host"):
 		return &apiv1alpha1.HostApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Http1ProtocolOptions"):
@@ -225,12 +243,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.MetadataKeyApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MetadataPathSegment"):
 		return &apiv1alpha1.MetadataPathSegmentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ModelRouting"):
+		return &apiv1alpha1.ModelRoutingApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Moderation"):
 		return &apiv1alpha1.ModerationApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MultiPoolConfig"):
 		return &apiv1alpha1.MultiPoolConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MultiPoolFailover"):
+		return &apiv1alpha1.MultiPoolFailoverApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NamespacedObjectReference"):
 		return &apiv1alpha1.NamespacedObjectReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkPolicy"):
+		return &apiv1alpha1.NetworkPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ObjectOverlay"):
+		return &apiv1alpha1.ObjectOverlayApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenAIConfig"):
 		return &apiv1alpha1.OpenAIConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenTelemetryAccessLogService"):
@@ -241,12 +267,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.OTelTracesSamplerApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OutlierDetection"):
 		return &apiv1alpha1.OutlierDetectionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OverlayPatch"):
+		return &apiv1alpha1.OverlayPatchApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Parameters"):
 		return &apiv1alpha1.ParametersApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PathOverride"):
 		return &apiv1alpha1.PathOverrideApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Pod"):
 		return &apiv1alpha1.PodApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PodDisruptionBudget"):
+		return &apiv1alpha1.PodDisruptionBudgetApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Port"):
 		return &apiv1alpha1.PortApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Priority"):
@@ -257,6 +287,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.PromptguardRequestApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PromptguardResponse"):
 		return &apiv1alpha1.PromptguardResponseApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PromptTemplate"):
+		return &apiv1alpha1.PromptTemplateApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PromptTemplateVariable"):
+		return &apiv1alpha1.PromptTemplateVariableApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ProxyDaemonSet"):
+		return &apiv1alpha1.ProxyDaemonSetApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ProxyDeployment"):
 		return &apiv1alpha1.ProxyDeploymentApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RateLimit"):
@@ -273,6 +309,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.RateLimitProviderApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Regex"):
 		return &apiv1alpha1.RegexApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RegexMask"):
+		return &apiv1alpha1.RegexMaskApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RegexMatch"):
 		return &apiv1alpha1.RegexMatchApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResourceDetector"):
@@ -289,6 +327,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.SdsBootstrapApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SdsContainer"):
 		return &apiv1alpha1.SdsContainerApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SelfHostedConfig"):
+		return &apiv1alpha1.SelfHostedConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SelfHostedService"):
+		return &apiv1alpha1.SelfHostedServiceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Service"):
 		return &apiv1alpha1.ServiceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ServiceAccount"):
@@ -303,6 +345,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.StatsConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("StatusCodeFilter"):
 		return &apiv1alpha1.StatusCodeFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("StreamingPromptguard"):
+		return &apiv1alpha1.StreamingPromptguardApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("StringMatcher"):
 		return &apiv1alpha1.StringMatcherApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SupportedLLMProvider"):
@@ -2,8 +2,20 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// AIBackend routes requests to LLM providers.
+//
+// Requests are sent to the chat completions API of the provider, except for requests whose path
+// ends with `/embeddings`, `/images/generations` or `/audio/transcriptions`, which are sent to the
+// embeddings, image generation and audio transcription APIs in the OpenAI format:
+//
+//   - OpenAI, Azure OpenAI and self-hosted OpenAI-compatible providers serve all of them.
+//   - Gemini serves embeddings and image generation with its OpenAI-compatible API.
+//   - Self-hosted Ollama providers serve embeddings with the `/api/embed` API.
+//
+// Requests to endpoints that the provider does not serve are rejected with a 404 status.
 // +kubebuilder:validation:XValidation:message="There must one and only one LLM or MultiPool can be set",rule="(has(self.llm) && !has(self.multipool)) || (!has(self.llm) && has(self.multipool))"
 // +kubebuilder:validation:MaxProperties=1
 // +kubebuilder:validation:MinProperties=1
@@ -35,8 +47,30 @@ type LLMProvider struct {
 	// For example, OpenAI uses header: "Authorization" and prefix: "Bearer" But Azure OpenAI uses header: "api-key"
 	// and no Bearer.
 	AuthHeaderOverride *AuthHeaderOverride `json:"authHeaderOverride,omitempty"`
+
+	// The API format that clients use to send requests to the LLM provider.
+	// If not specified, clients must use the API format of the LLM provider.
+	// Set to `OpenAI` to accept OpenAI chat completions requests, which are translated
+	// to the API format of the LLM provider, along with their responses, streaming
+	// responses and tool calls. This allows clients to use the same OpenAI SDK for
+	// every provider.
+	// Translation is only supported for the Anthropic, Gemini and Vertex AI providers, and
+	// the Backend is rejected if it is set for another provider. Agentgateway ignores this
+	// field, as it accepts OpenAI chat completions requests for every provider, including Bedrock.
+	// All the providers of a MultiPool must use the same API format.
+	// +optional
+	APIFormat *APIFormat `json:"apiFormat,omitempty"`
 }
 
+// APIFormat is the API format that clients use to send requests to an LLM provider.
+// +kubebuilder:validation:Enum=OpenAI
+type APIFormat string
+
+const (
+	// APIFormatOpenAI is the OpenAI chat completions API format.
+	APIFormatOpenAI APIFormat = "OpenAI"
+)
+
 // PathOverride configures the AI gateway to use a custom path for LLM provider chat-completion API requests.
 // It allows overriding the default API path with a custom one.
 // This is useful when you need to route requests to a different API endpoint while maintaining
@@ -66,6 +100,7 @@ type SupportedLLMProvider struct {
 	Gemini      *GeminiConfig      `json:"gemini,omitempty"`
 	VertexAI    *VertexAIConfig    `json:"vertexai,omitempty"`
 	Bedrock     *BedrockConfig     `json:"bedrock,omitempty"`
+	SelfHosted  *SelfHostedConfig  `json:"selfhosted,omitempty"`
 }
 
 type SingleAuthTokenKind string
@@ -225,6 +260,63 @@ type AnthropicConfig struct {
 	Model *string `json:"model,omitempty"`
 }
 
+// SelfHostedConfig settings for a self-hosted LLM server that runs in the cluster, such as
+// [vLLM](https://docs.vllm.ai), [Ollama](https://ollama.com) or
+// [TGI](https://huggingface.co/docs/text-generation-inference).
+type SelfHostedConfig struct {
+	// The Service of the LLM server, in the same namespace as the Backend.
+	// Requests are sent over plaintext HTTP, unless the port is 443.
+	// +required
+	Service SelfHostedService `json:"service"`
+
+	// The API that the LLM server serves. Defaults to `OpenAI`.
+	// `OpenAI` is the OpenAI-compatible chat completions API of vLLM, TGI and Ollama,
+	// which requests are sent to at `/v1/chat/completions`.
+	// `Ollama` is the native chat and generate API of Ollama. Requests keep their
+	// `/api/chat` or `/api/generate` path, and the token usage is read from the
+	// Ollama responses.
+	// +optional
+	API *SelfHostedAPI `json:"api,omitempty"`
+
+	// Optional: The authorization token that the AI gateway uses to access the LLM server.
+	// This token is automatically sent in the `Authorization` header of the
+	// request and prefixed with `Bearer`.
+	// If unset, requests are sent without an authorization header.
+	// +optional
+	AuthToken *SingleAuthToken `json:"authToken,omitempty"`
+
+	// Optional: Override the model name, such as `llama3.1`.
+	// If unset, the model name is taken from the request.
+	// +optional
+	Model *string `json:"model,omitempty"`
+}
+
+// SelfHostedService is a Service that a self-hosted LLM server listens on.
+type SelfHostedService struct {
+	// The name of the Service.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// The port of the Service.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// SelfHostedAPI is the API that a self-hosted LLM server serves.
+// +kubebuilder:validation:Enum=OpenAI;Ollama
+type SelfHostedAPI string
+
+const (
+	// SelfHostedAPIOpenAI is the OpenAI-compatible chat completions API.
+	SelfHostedAPIOpenAI SelfHostedAPI = "OpenAI"
+	// SelfHostedAPIOllama is the native chat and generate API of Ollama.
+	SelfHostedAPIOllama SelfHostedAPI = "Ollama"
+)
+
 type BedrockConfig struct {
 	// Auth specifies an explicit AWS authentication method for the backend.
 	// When omitted, the following credential providers are tried in order, stopping when one
@@ -315,4 +407,56 @@ type MultiPoolConfig struct {
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=20
 	Priorities []Priority `json:"priorities,omitempty"`
+
+	// Failover configures how requests fail over to the next priority when a provider
+	// is rate limited or unhealthy. If not specified, requests only fail over when the
+	// connection to a provider fails.
+	// +optional
+	Failover *MultiPoolFailover `json:"failover,omitempty"`
+}
+
+// MultiPoolFailover configures the failover of requests across the priorities of a MultiPool.
+//
+// A request that fails with one of the failover status codes is retried on the next priority.
+// Providers that fail repeatedly are ejected from the pool for the cooldown duration, so that
+// subsequent requests go straight to the next priority until the provider recovers.
+// Routes to a MultiPool with failover use the failover retries in place of their own retry policy.
+//
+// The provider that served a request is returned in the `x-kgateway-ai-provider` response header,
+// which can be added to access logs with `%RESP(x-kgateway-ai-provider)%`.
+type MultiPoolFailover struct {
+	// StatusCodes are the HTTP status codes of provider responses that trigger a failover.
+	// Defaults to 429, 500, 502, 503 and 504.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Minimum=400
+	// +kubebuilder:validation:items:Maximum=599
+	StatusCodes []int32 `json:"statusCodes,omitempty"`
+
+	// MaxRetries is the maximum number of times a request fails over to another priority.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=19
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// MaxRetryAfter is the longest `Retry-After` of a failed response that is honored before
+	// failing over. A `Retry-After` longer than this, or a response without it, fails over
+	// without waiting. If not specified, `Retry-After` is ignored.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	MaxRetryAfter *metav1.Duration `json:"maxRetryAfter,omitempty"`
+
+	// ConsecutiveFailures is the number of consecutive server errors after which a provider
+	// is ejected from the pool.
+	// +optional
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	ConsecutiveFailures *int32 `json:"consecutiveFailures,omitempty"`
+
+	// Cooldown is how long an ejected provider is kept out of the pool.
+	// +optional
+	// +kubebuilder:default="30s"
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
 }
@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -26,6 +28,141 @@ type AIPolicy struct {
 	// +kubebuilder:validation:Enum=CHAT;CHAT_STREAMING
 	// +kubebuilder:default=CHAT
 	RouteType *RouteType `json:"routeType,omitempty"`
+
+	// Rate limit requests to the LLM provider by the number of tokens they consume
+	// instead of the number of requests. Prompt and completion tokens reported by the
+	// provider response are debited from the budget.
+	TokenRateLimit *AITokenRateLimit `json:"tokenRateLimit,omitempty"`
+
+	// Serve cached responses for prompts that are semantically similar to prior prompts,
+	// instead of sending them to the LLM provider.
+	// Only non-streaming responses are cached.
+	SemanticCache *AISemanticCache `json:"semanticCache,omitempty"`
+}
+
+// AISemanticCache configures the semantic caching of LLM responses.
+//
+// Prompts are converted to embeddings by an embedding model. If the embedding of a prompt
+// is similar enough to the embedding of a prior prompt to the same model, the response
+// to the prior prompt is returned without calling the LLM provider. Otherwise, the
+// response of the LLM provider is stored in the cache.
+//
+// Cached responses are only returned to requests with the same `Authorization` header
+// as the request they were cached for. Requests without an `Authorization` header share
+// their cached responses with each other.
+//
+// The following example caches the responses of the `openai` HTTPRoute, using the
+// embedding model of the `openai-embeddings` Backend.
+// ```yaml
+//
+//	ai:
+//	  semanticCache:
+//	    embedding:
+//	      backendRef:
+//	        name: openai-embeddings
+//	      model: text-embedding-3-small
+//	    similarityThreshold: "0.95"
+//	    ttl: 1h
+//
+// ```
+type AISemanticCache struct {
+	// Embedding configures the model used to embed prompts.
+	// +required
+	Embedding AISemanticCacheEmbedding `json:"embedding"`
+
+	// Store configures the vector store in which embeddings and responses are cached.
+	// If not specified, an in-memory store is used.
+	// +optional
+	Store *AISemanticCacheStore `json:"store,omitempty"`
+
+	// SimilarityThreshold is the minimum cosine similarity, between 0 and 1, of the
+	// embeddings of two prompts for the cached response of one to be returned for the other.
+	// +optional
+	// +kubebuilder:default="0.95"
+	// +kubebuilder:validation:Pattern="^(0(\\.[0-9]+)?|1(\\.0+)?)$"
+	SimilarityThreshold *string `json:"similarityThreshold,omitempty"`
+
+	// TTL is how long a response is cached. If not specified, responses are cached
+	// until they are evicted by the store.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// AISemanticCacheEmbedding configures the embedding model of a semantic cache.
+type AISemanticCacheEmbedding struct {
+	// BackendRef references the AI Backend, in the same namespace as the policy,
+	// that serves the embedding model. Only the `openai` and `azureopenai` providers
+	// are supported.
+	//
+	// The auth token of the Backend is resolved by the AI extension of the Gateway.
+	// A `SecretRef` token is read from the `Authorization` key of the Secret mounted at
+	// `/var/run/secrets/kgateway/ai/<secret name>` in the AI extension container, e.g.
+	// with a GatewayParameters overlay. If it is not mounted, the `OPENAI_API_KEY` or
+	// `AZURE_OPENAI_API_KEY` environment variable of the AI extension is used.
+	// +required
+	BackendRef corev1.LocalObjectReference `json:"backendRef"`
+
+	// Model is the name of the embedding model, such as `text-embedding-3-large`.
+	// If not specified, the `openai` provider uses `text-embedding-3-small`, rather than
+	// the model configured in the Backend, which usually is a chat model, and the
+	// `azureopenai` provider uses the model of its deployment.
+	// +optional
+	Model *string `json:"model,omitempty"`
+}
+
+// AISemanticCacheStore configures the vector store of a semantic cache.
+// +kubebuilder:validation:ExactlyOneOf=memory
+type AISemanticCacheStore struct {
+	// Memory stores the cache in the memory of each proxy replica.
+	// +optional
+	Memory *AIMemoryVectorStore `json:"memory,omitempty"`
+}
+
+// AIMemoryVectorStore configures an in-memory vector store.
+type AIMemoryVectorStore struct {
+	// MaxEntries is the maximum number of cached responses, per policy.
+	// The oldest entries are evicted first.
+	// +optional
+	// +kubebuilder:default=1000
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100000
+	MaxEntries *int32 `json:"maxEntries,omitempty"`
+}
+
+// AITokenRateLimit configures a token budget for requests sent to the LLM provider.
+//
+// Prompt tokens are debited when the request is sent and completion tokens are debited
+// once the response, or the response stream, completes. A request is rejected with a
+// 429 status code once the budget is exhausted.
+//
+// +kubebuilder:validation:ExactlyOneOf=local;global
+type AITokenRateLimit struct {
+	// Local enforces the token budget in each proxy replica.
+	// +optional
+	Local *AILocalTokenRateLimit `json:"local,omitempty"`
+
+	// Global enforces the token budget through an external rate limit service.
+	// The hits of each descriptor are the number of tokens consumed by the request.
+	// The descriptors are prefixed with the entry `generic_key: ai_tokens`, so the
+	// token budgets don't share counters with the request limits of rateLimit.global;
+	// the rate limit service must define the token budgets under that entry.
+	// +optional
+	Global *RateLimitPolicy `json:"global,omitempty"`
+}
+
+// AILocalTokenRateLimit configures a token budget enforced by each proxy replica.
+type AILocalTokenRateLimit struct {
+	// TokenBucket represents the token budget. The maximum number of tokens
+	// of the bucket is the largest number of tokens that can be consumed in a burst.
+	// +required
+	TokenBucket TokenBucket `json:"tokenBucket"`
+
+	// Header is the name of the request header whose value identifies the caller,
+	// such as a user or tenant id. Each distinct value gets its own budget.
+	// If not specified, all requests share a single budget.
+	// +optional
+	Header *gwv1.HeaderName `json:"header,omitempty"`
 }
 
 // AIPromptEnrichment defines the config to enrich requests sent to the LLM provider by appending and prepending system prompts.
@@ -66,6 +203,118 @@ type AIPromptEnrichment struct {
 	Prepend []Message `json:"prepend,omitempty"`
 	// A list of messages to be appended to the prompt sent by the client.
 	Append []Message `json:"append,omitempty"`
+
+	// Prompt templates that are read from ConfigMaps in the namespace of the policy and added
+	// to the prompt sent by the client. Many policies can share the same templates, so that
+	// system prompts are rotated by updating the ConfigMaps instead of each policy.
+	// Template messages are added closest to the prompt of the client: the messages in
+	// `prepend` come before the prepended templates, and the messages in `append` come
+	// after the appended templates.
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Templates []PromptTemplate `json:"templates,omitempty"`
+}
+
+// PromptTemplate references a versioned prompt template that is stored in a ConfigMap.
+// Each key of the ConfigMap holds one version of the template, and the optional `version`
+// key names the version that is used when the policy does not pin one.
+//
+// Templates reference variables as `{{ name }}`, which are substituted with the values of
+// request headers or verified JWT claims when the request is processed. Values are
+// substituted as JSON string literals, such as `"acme"`, so that a value cannot end its
+// quotes or start a new line to pose as instructions of the template.
+//
+// ```yaml
+// apiVersion: v1
+// kind: ConfigMap
+// metadata:
+//
+//	name: support-prompt
+//
+// data:
+//
+//	version: v2
+//	v1: "You are a support assistant."
+//	v2: "You are a support assistant for the tenant {{ tenant }}. Address the user as {{ user }}."
+//
+// ```
+type PromptTemplate struct {
+	// Reference to the ConfigMap that holds the versions of the template.
+	// The ConfigMap must be in the same namespace as the policy.
+	ConfigMapRef corev1.LocalObjectReference `json:"configMapRef"`
+
+	// The version of the template, which is the key of the ConfigMap that holds the template.
+	// If unset, the version is read from the `version` key of the ConfigMap.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// Role of the message that is built from the template. Defaults to `SYSTEM`.
+	// +optional
+	Role *PromptTemplateRole `json:"role,omitempty"`
+
+	// Whether the message is prepended or appended to the prompt sent by the client.
+	// Defaults to `Prepend`.
+	// +optional
+	Position *PromptTemplatePosition `json:"position,omitempty"`
+
+	// Variables that are substituted in the template.
+	// Variables that are referenced by the template but not defined here, or that have
+	// no value and no default, are replaced with an empty string.
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	Variables []PromptTemplateVariable `json:"variables,omitempty"`
+}
+
+// PromptTemplateRole is the role of a templated message.
+// +kubebuilder:validation:Enum=SYSTEM;USER;ASSISTANT
+type PromptTemplateRole string
+
+const (
+	// The message is a system message, which instructs the model.
+	PromptTemplateRoleSystem PromptTemplateRole = "SYSTEM"
+	// The message is a user message.
+	PromptTemplateRoleUser PromptTemplateRole = "USER"
+	// The message is an assistant message, such as an example answer.
+	PromptTemplateRoleAssistant PromptTemplateRole = "ASSISTANT"
+)
+
+// PromptTemplatePosition is where a templated message is added to the prompt.
+// +kubebuilder:validation:Enum=Prepend;Append
+type PromptTemplatePosition string
+
+const (
+	// Add the message before the messages sent by the client.
+	PromptTemplatePrepend PromptTemplatePosition = "Prepend"
+	// Add the message after the messages sent by the client.
+	PromptTemplateAppend PromptTemplatePosition = "Append"
+)
+
+// PromptTemplateVariable defines where the value of a template variable is read from.
+// +kubebuilder:validation:ExactlyOneOf=header;claim
+type PromptTemplateVariable struct {
+	// Name of the variable, as referenced by the template.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	// +kubebuilder:validation:MaxLength=64
+	Name string `json:"name"`
+
+	// Read the value from a request header.
+	// +optional
+	Header *gwv1.HeaderName `json:"header,omitempty"`
+
+	// Read the value from a claim of a JWT verified by the Envoy JWT filter, which stores
+	// the payloads of the tokens it verified in the `envoy.filters.http.jwt_authn` dynamic
+	// metadata, under the `payload_in_metadata` key of their provider. The claim is the path
+	// of the value in this metadata, separated by dots, such as `principal.org.name`.
+	// The variable has no value if the request has no verified token.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Claim *string `json:"claim,omitempty"`
+
+	// Value that is used when the header or claim is not present in the request.
+	// +optional
+	Default *string `json:"default,omitempty"`
 }
 
 // RouteType is the type of route to the LLM provider API.
@@ -90,7 +339,7 @@ type Message struct {
 // BuiltIn regex patterns for specific types of strings in prompts.
 // For example, if you specify `CREDIT_CARD`, any credit card numbers
 // in the request or response are matched.
-// +kubebuilder:validation:Enum=SSN;CREDIT_CARD;PHONE_NUMBER;EMAIL
+// +kubebuilder:validation:Enum=SSN;CREDIT_CARD;PHONE_NUMBER;EMAIL;IBAN;US_PASSPORT
 type BuiltIn string
 
 const (
@@ -102,6 +351,11 @@ const (
 	PHONE_NUMBER BuiltIn = "PHONE_NUMBER"
 	// Default regex matching for email addresses.
 	EMAIL BuiltIn = "EMAIL"
+	// Default regex matching for International Bank Account Numbers (IBAN).
+	IBAN BuiltIn = "IBAN"
+	// Default regex matching for US passport numbers. Passport numbers of other
+	// countries are not matched.
+	US_PASSPORT BuiltIn = "US_PASSPORT"
 )
 
 // RegexMatch configures the regular expression (regex) matching for prompt guards and data masking.
@@ -113,7 +367,8 @@ type RegexMatch struct {
 }
 
 // Action to take if a regex pattern is matched in a request or response.
-// This setting applies only to request matches. PromptguardResponse matches are always masked by default.
+// This setting applies only to request matches. PromptguardResponse matches are always masked by default,
+// streaming responses can terminate the stream instead with StreamingPromptguard.
 type Action string
 
 const (
@@ -136,6 +391,64 @@ type Regex struct {
 	// Defaults to `MASK`.
 	// +kubebuilder:default=MASK
 	Action *Action `json:"action,omitempty"`
+	// How the matched data is replaced when the action is `MASK`.
+	// Defaults to redacting the matches with their type, such as `<EMAIL_ADDRESS>`.
+	// +optional
+	Mask *RegexMask `json:"mask,omitempty"`
+}
+
+// MaskStrategy is the replacement strategy for the data matched by a regex prompt guard.
+// +kubebuilder:validation:Enum=Redact;Hash;Tokenize
+type MaskStrategy string
+
+const (
+	// Replace the matched data with a fixed replacement text.
+	MaskStrategyRedact MaskStrategy = "Redact"
+	// Replace the matched data with its HMAC-SHA256, keyed by the Secret of the mask,
+	// so that the same values can still be correlated by the LLM without revealing them.
+	MaskStrategyHash MaskStrategy = "Hash"
+	// Replace the matched data in the request with a token, such as `<EMAIL_ADDRESS_1>`,
+	// and restore the original data wherever the LLM returns the token in the response.
+	MaskStrategyTokenize MaskStrategy = "Tokenize"
+)
+
+// RegexMask configures the replacement of the data matched by a regex prompt guard.
+//
+// This example masks email addresses in requests with tokens, which are
+// replaced back with the email addresses in the response to the client.
+// ```yaml
+// regex:
+//
+//	builtins:
+//	- EMAIL
+//	action: MASK
+//	mask:
+//	  strategy: Tokenize
+//
+// ```
+//
+// +kubebuilder:validation:XValidation:message="hashKeySecretRef must be set when the strategy is Hash",rule="!has(self.strategy) || self.strategy != 'Hash' || has(self.hashKeySecretRef)"
+type RegexMask struct {
+	// The replacement strategy for the matched data.
+	// Defaults to `Redact`.
+	// +kubebuilder:default=Redact
+	// +optional
+	Strategy *MaskStrategy `json:"strategy,omitempty"`
+
+	// The text that replaces the matched data with the `Redact` strategy.
+	// If not specified, the matches are replaced with their type, such as `<EMAIL_ADDRESS>`.
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	Replacement *string `json:"replacement,omitempty"`
+
+	// The Secret that holds, in its `key` entry, the HMAC key of the `Hash` strategy.
+	// Use a different key for each policy, so that the hashes of a policy cannot be
+	// correlated with the hashes of another one.
+	// The Secret is read by the AI extension of the Gateway, and must be mounted at
+	// `/var/run/secrets/kgateway/ai/<secret name>` in the AI extension container, e.g.
+	// with a GatewayParameters overlay. The matches are redacted while it is not mounted.
+	// +optional
+	HashKeySecretRef *corev1.LocalObjectReference `json:"hashKeySecretRef,omitempty"`
 }
 
 // Webhook configures a webhook to forward requests or responses to for prompt guarding.
@@ -211,12 +524,38 @@ type PromptguardRequest struct {
 // PromptguardResponse configures the response that the prompt guard applies to responses returned by the LLM provider.
 // Both webhook and regex can be set, they will be executed in the following order: webhook → regex, where each step
 // can reject the request and stop further processing.
+// +kubebuilder:validation:XValidation:message="the Tokenize mask strategy only applies to requests",rule="!has(self.regex) || !has(self.regex.mask) || !has(self.regex.mask.strategy) || self.regex.mask.strategy != 'Tokenize'"
 type PromptguardResponse struct {
 	// Regular expression (regex) matching for prompt guards and data masking.
 	Regex *Regex `json:"regex,omitempty"`
 
 	// Configure a webhook to forward responses to for prompt guarding.
 	Webhook *Webhook `json:"webhook,omitempty"`
+
+	// Streaming configures how the regex and webhook prompt guards inspect streaming (SSE) responses.
+	// +optional
+	Streaming *StreamingPromptguard `json:"streaming,omitempty"`
+}
+
+// StreamingPromptguard configures the incremental inspection of streaming responses.
+// The content of the streamed chunks is held back until the window is full and ends on a sentence
+// boundary, then it is inspected by the response prompt guards before the chunks are sent to the client.
+type StreamingPromptguard struct {
+	// WindowSize is the minimum number of characters of content to hold back before it is inspected.
+	// Larger windows give the prompt guards more context, smaller windows send the tokens to the client sooner.
+	// Defaults to 50.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65536
+	WindowSize *int32 `json:"windowSize,omitempty"`
+
+	// The action to take if a regex pattern is matched in a streaming response.
+	// `MASK` masks the matched data in flight, `REJECT` terminates the stream with an error event
+	// and drops the rest of the response.
+	// Defaults to `MASK`.
+	// +optional
+	// +kubebuilder:validation:Enum=MASK;REJECT
+	Action *Action `json:"action,omitempty"`
 }
 
 // AIPromptGuard configures a prompt guards to block unwanted requests to the LLM provider and mask sensitive data.
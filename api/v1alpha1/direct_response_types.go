@@ -30,6 +30,7 @@ type DirectResponseList struct {
 }
 
 // DirectResponseSpec describes the desired state of a DirectResponse.
+// +kubebuilder:validation:XValidation:message="at most one of body or a2aCatalog may be set",rule="!(has(self.body) && has(self.a2aCatalog))"
 type DirectResponseSpec struct {
 	// StatusCode defines the HTTP status code to return for this route.
 	//
@@ -45,6 +46,44 @@ type DirectResponseSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=4096
 	Body *string `json:"body,omitempty"`
+
+	// A2ACatalog responds with a JSON catalog of the A2A agents discovered by the
+	// gateway, i.e. Services with a port using the `kgateway.dev/a2a` appProtocol.
+	// Only supported by agentgateway.
+	//
+	// +optional
+	A2ACatalog *A2ACatalog `json:"a2aCatalog,omitempty"`
+}
+
+// A2ACatalog defines which A2A agents are listed in a catalog and how they are reached.
+type A2ACatalog struct {
+	// NamespaceSelector selects the namespaces whose agents are listed.
+	// When unset, only the agents in the namespace of the DirectResponse are listed.
+	// The agents in other namespaces are only listed if a ReferenceGrant allows the
+	// HTTPRoutes of the namespace of the DirectResponse to reference their Service.
+	//
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Selector selects the Services of the agents that are listed by their labels.
+	// When unset, all the agents of the selected namespaces are listed.
+	//
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// PathPrefix is the path, relative to the gateway, under which agents are routed.
+	// Each agent is listed with the URL `<pathPrefix>/<namespace>/<service>`, and its
+	// agent card with the URL `<pathPrefix>/<namespace>/<service>/.well-known/agent.json`.
+	// The HTTPRoute rule using the DirectResponse gets a route to each listed agent
+	// matching its URL, on the hostnames of the HTTPRoute. The URL is stripped from the
+	// requests forwarded to the agent.
+	// Defaults to `/agents`.
+	//
+	// +optional
+	// +kubebuilder:default="/agents"
+	// +kubebuilder:validation:Pattern="^/[a-zA-Z0-9/._~-]*$"
+	// +kubebuilder:validation:MaxLength=256
+	PathPrefix *string `json:"pathPrefix,omitempty"`
 }
 
 // DirectResponseStatus defines the observed state of a DirectResponse.
@@ -58,6 +97,14 @@ func (in *DirectResponse) GetStatusCode() uint32 {
 	return in.Spec.StatusCode
 }
 
+// GetA2ACatalog returns the A2A catalog to respond with.
+func (in *DirectResponse) GetA2ACatalog() *A2ACatalog {
+	if in == nil {
+		return nil
+	}
+	return in.Spec.A2ACatalog
+}
+
 // GetBody returns the content to be returned in the HTTP response body.
 func (in *DirectResponse) GetBody() *string {
 	if in == nil {
@@ -64,20 +64,56 @@ func (in *GatewayParametersSpec) GetSelfManaged() *SelfManagedGateway {
 	return in.SelfManaged
 }
 
-// The current conditions of the GatewayParameters. This is not currently implemented.
-type GatewayParametersStatus struct{}
+// The current conditions of the GatewayParameters.
+type GatewayParametersStatus struct {
+	// Conditions describe the current conditions of the GatewayParameters,
+	// such as whether its overlays could be applied.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// GatewayParametersConditionOverlaysApplied reports whether the overlays of the
+	// GatewayParameters could be applied to the objects generated for all the Gateways
+	// using it, directly or through their GatewayClass.
+	GatewayParametersConditionOverlaysApplied = "OverlaysApplied"
+
+	// GatewayParametersReasonOverlaysApplied is used when all the overlays were applied for all the Gateways.
+	GatewayParametersReasonOverlaysApplied = "Applied"
+
+	// GatewayParametersReasonInvalidOverlay is used when an overlay could not be applied for at least one Gateway.
+	GatewayParametersReasonInvalidOverlay = "InvalidOverlay"
+)
 
 type SelfManagedGateway struct{}
 
 // KubernetesProxyConfig configures the set of Kubernetes resources that will be provisioned
 // for a given Gateway.
+//
+// +kubebuilder:validation:XValidation:message="daemonSet can only be set when workloadKind is DaemonSet",rule="!has(self.daemonSet) || (has(self.workloadKind) && self.workloadKind == 'DaemonSet')"
+// +kubebuilder:validation:XValidation:message="horizontalPodAutoscaler cannot be set when workloadKind is DaemonSet",rule="!has(self.horizontalPodAutoscaler) || !has(self.workloadKind) || self.workloadKind != 'DaemonSet'"
 type KubernetesProxyConfig struct {
-	// Use a Kubernetes deployment as the proxy workload type. Currently, this is the only
-	// supported workload type.
+	// The kind of Kubernetes workload that runs the proxy pods. Defaults to Deployment.
+	// A DaemonSet runs one proxy pod on each node selected by the pod template, such as
+	// the ingress nodes of a bare-metal cluster without a cloud load balancer.
+	//
+	// +optional
+	WorkloadKind *WorkloadKind `json:"workloadKind,omitempty"`
+
+	// Configuration for the proxy deployment, used when the workload kind is Deployment.
 	//
 	// +optional
 	Deployment *ProxyDeployment `json:"deployment,omitempty"`
 
+	// Configuration for the proxy daemonset, used when the workload kind is DaemonSet.
+	//
+	// +optional
+	DaemonSet *ProxyDaemonSet `json:"daemonSet,omitempty"`
+
 	// Configuration for the container running Envoy.
 	// If AgentGateway is enabled, the EnvoyContainer values will be ignored.
 	//
@@ -105,6 +141,31 @@ type KubernetesProxyConfig struct {
 	// +optional
 	ServiceAccount *ServiceAccount `json:"serviceAccount,omitempty"`
 
+	// Configuration for a PodDisruptionBudget that limits the number of proxy
+	// pods that are evicted at the same time, such as during node drains.
+	// If unset, no PodDisruptionBudget is created.
+	//
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+
+	// Configuration for a HorizontalPodAutoscaler that scales the proxy
+	// deployment. When set, the replicas of the deployment are left to the
+	// autoscaler. If unset, no HorizontalPodAutoscaler is created.
+	//
+	// +optional
+	HorizontalPodAutoscaler *HorizontalPodAutoscaler `json:"horizontalPodAutoscaler,omitempty"`
+
+	// Configuration for a NetworkPolicy that restricts the traffic of the proxy
+	// pods to the traffic they need: ingress on the listener ports, and egress to
+	// the xDS server, DNS and the namespaces of the Service backends of the routes
+	// attached to the Gateway. The other destinations, such as the Services of
+	// GatewayExtensions and the hosts of Backends outside of the cluster, are not
+	// derived from the routes and must be allowed with extraEgressNamespaces or
+	// extraEgressCIDRs. If unset, no NetworkPolicy is created.
+	//
+	// +optional
+	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
+
 	// Configuration for the Istio integration.
 	//
 	// +optional
@@ -128,6 +189,22 @@ type KubernetesProxyConfig struct {
 
 	// Used to unset the `runAsUser` values in security contexts.
 	FloatingUserId *bool `json:"floatingUserId,omitempty"`
+
+	// Patches applied to the objects generated for the Gateway before they are
+	// deployed, for settings that are not exposed by the fields above. The
+	// overlays of the GatewayParameters of the GatewayClass are applied before
+	// those of the GatewayParameters of the Gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	Overlays []ObjectOverlay `json:"overlays,omitempty"`
+}
+
+func (in *KubernetesProxyConfig) GetWorkloadKind() *WorkloadKind {
+	if in == nil {
+		return nil
+	}
+	return in.WorkloadKind
 }
 
 func (in *KubernetesProxyConfig) GetDeployment() *ProxyDeployment {
@@ -137,6 +214,13 @@ func (in *KubernetesProxyConfig) GetDeployment() *ProxyDeployment {
 	return in.Deployment
 }
 
+func (in *KubernetesProxyConfig) GetDaemonSet() *ProxyDaemonSet {
+	if in == nil {
+		return nil
+	}
+	return in.DaemonSet
+}
+
 func (in *KubernetesProxyConfig) GetEnvoyContainer() *EnvoyContainer {
 	if in == nil {
 		return nil
@@ -172,6 +256,27 @@ func (in *KubernetesProxyConfig) GetServiceAccount() *ServiceAccount {
 	return in.ServiceAccount
 }
 
+func (in *KubernetesProxyConfig) GetPodDisruptionBudget() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	return in.PodDisruptionBudget
+}
+
+func (in *KubernetesProxyConfig) GetHorizontalPodAutoscaler() *HorizontalPodAutoscaler {
+	if in == nil {
+		return nil
+	}
+	return in.HorizontalPodAutoscaler
+}
+
+func (in *KubernetesProxyConfig) GetNetworkPolicy() *NetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	return in.NetworkPolicy
+}
+
 func (in *KubernetesProxyConfig) GetIstio() *IstioIntegration {
 	if in == nil {
 		return nil
@@ -207,6 +312,92 @@ func (in *KubernetesProxyConfig) GetFloatingUserId() *bool {
 	return in.FloatingUserId
 }
 
+func (in *KubernetesProxyConfig) GetOverlays() []ObjectOverlay {
+	if in == nil {
+		return nil
+	}
+	return in.Overlays
+}
+
+// ObjectOverlay patches the objects of a kind generated for a Gateway, such as
+// its Deployment or Service.
+type ObjectOverlay struct {
+	// The kind of the objects to patch, such as Deployment, Service or ConfigMap.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// The name of the object to patch. If unset, all the objects of the kind are patched.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Name *string `json:"name,omitempty"`
+
+	// The patches to apply to the objects, in order.
+	//
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Patches []OverlayPatch `json:"patches"`
+}
+
+func (in *ObjectOverlay) GetName() *string {
+	if in == nil {
+		return nil
+	}
+	return in.Name
+}
+
+// OverlayPatchType is the type of an overlay patch.
+//
+// +kubebuilder:validation:Enum=StrategicMerge;JSONPatch
+type OverlayPatchType string
+
+const (
+	// OverlayPatchTypeStrategicMerge is a Kubernetes strategic merge patch. Objects of
+	// kinds unknown to the controller are patched with a JSON merge patch instead.
+	OverlayPatchTypeStrategicMerge OverlayPatchType = "StrategicMerge"
+	// OverlayPatchTypeJSONPatch is a JSON patch (RFC 6902).
+	OverlayPatchTypeJSONPatch OverlayPatchType = "JSONPatch"
+)
+
+// OverlayPatch is a patch applied to the objects selected by an overlay.
+type OverlayPatch struct {
+	// The type of the patch. Defaults to StrategicMerge.
+	//
+	// +optional
+	// +kubebuilder:default=StrategicMerge
+	Type *OverlayPatchType `json:"type,omitempty"`
+
+	// The patch, in YAML or JSON: a partial object for a strategic merge patch,
+	// or a list of operations for a JSON patch.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=65536
+	Patch string `json:"patch"`
+}
+
+func (in *OverlayPatch) GetType() OverlayPatchType {
+	if in == nil || in.Type == nil {
+		return OverlayPatchTypeStrategicMerge
+	}
+	return *in.Type
+}
+
+// WorkloadKind is the kind of Kubernetes workload that runs the proxy pods.
+//
+// +kubebuilder:validation:Enum=Deployment;DaemonSet
+type WorkloadKind string
+
+const (
+	// WorkloadKindDeployment runs the proxy pods in a Deployment.
+	WorkloadKindDeployment WorkloadKind = "Deployment"
+	// WorkloadKindDaemonSet runs one proxy pod on each selected node in a DaemonSet.
+	WorkloadKindDaemonSet WorkloadKind = "DaemonSet"
+)
+
 // ProxyDeployment configures the Proxy deployment in Kubernetes.
 // +kubebuilder:validation:AtMostOneOf=replicas;omitReplicas
 type ProxyDeployment struct {
@@ -234,6 +425,39 @@ func (in *ProxyDeployment) GetOmitReplicas() *bool {
 	return in.OmitReplicas
 }
 
+// ProxyDaemonSet configures the Proxy daemonset in Kubernetes.
+type ProxyDaemonSet struct {
+	// If true, the proxy pods use the network namespace of their node, so the
+	// listeners bind directly to the addresses of the node.
+	// If the Gateway has listeners on ports below 1024, the proxy container runs as
+	// root, with the NET_BIND_SERVICE capability only, as the sysctl that allows
+	// unprivileged users to bind these ports cannot be set with the host network.
+	//
+	// +optional
+	HostNetwork *bool `json:"hostNetwork,omitempty"`
+
+	// If true, the listener ports of the proxy pods are exposed on their node
+	// with host ports. This is not needed when hostNetwork is enabled, as the
+	// listeners already bind to the addresses of the node.
+	//
+	// +optional
+	HostPort *bool `json:"hostPort,omitempty"`
+}
+
+func (in *ProxyDaemonSet) GetHostNetwork() *bool {
+	if in == nil {
+		return nil
+	}
+	return in.HostNetwork
+}
+
+func (in *ProxyDaemonSet) GetHostPort() *bool {
+	if in == nil {
+		return nil
+	}
+	return in.HostPort
+}
+
 // EnvoyContainer configures the container running Envoy.
 type EnvoyContainer struct {
 	// Initial envoy configuration.
@@ -653,6 +877,7 @@ type AiExtension struct {
 	// Example:
 	// ```yaml
 	// stats:
+	//   clientHeader: x-client-id
 	//   customLabels:
 	//     - name: "subject"
 	//       metadataNamespace: "envoy.filters.http.jwt_authn"
@@ -725,11 +950,37 @@ func (in *AiExtension) GetTracing() *AiExtensionTrace {
 	return in.Tracing
 }
 
+// AiExtensionStats configures the usage metrics of the AI Extension.
+//
+// The AI Extension reports the prompt and completion tokens, the time to first token and the
+// streaming duration of each LLM request, labeled by provider (`llm`), model (`model`),
+// route (`route`) and client (`client`), in addition to the custom labels.
+// The same values are added to the `ai.kgateway.io` dynamic metadata, so that they can be
+// added to access logs, e.g. `%DYNAMIC_METADATA(ai.kgateway.io:prompt_tokens)%`.
+//
+// +kubebuilder:validation:XValidation:message="clients must be set when clientHeader is set",rule="!has(self.clientHeader) || has(self.clients)"
 type AiExtensionStats struct {
 	// Set of custom labels to be added to the request metrics.
 	// These will be added on each request which goes through the AI Extension.
 	// +optional
 	CustomLabels []CustomLabel `json:"customLabels,omitempty"`
+
+	// ClientHeader is the request header that identifies the client of a request,
+	// such as an application or a team. Its value is used as the `client` label of the
+	// request metrics if it is one of the Clients, and `other` otherwise, so that
+	// clients cannot create arbitrary metric series. If not specified, or if the request
+	// does not have the header, the `client` label is `unknown`.
+	// +optional
+	ClientHeader *gwv1.HeaderName `json:"clientHeader,omitempty"`
+
+	// Clients are the values of the ClientHeader reported as the `client` label of the
+	// request metrics. Required when ClientHeader is set.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=64
+	Clients []string `json:"clients,omitempty"`
 }
 
 func (in *AiExtensionStats) GetCustomLabels() []CustomLabel {
@@ -739,6 +990,20 @@ func (in *AiExtensionStats) GetCustomLabels() []CustomLabel {
 	return in.CustomLabels
 }
 
+func (in *AiExtensionStats) GetClientHeader() *gwv1.HeaderName {
+	if in == nil {
+		return nil
+	}
+	return in.ClientHeader
+}
+
+func (in *AiExtensionStats) GetClients() []string {
+	if in == nil {
+		return nil
+	}
+	return in.Clients
+}
+
 type CustomLabel struct {
 	// Name of the label to use in the prometheus metrics
 	//
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // A container image. See https://kubernetes.io/docs/concepts/containers/images
@@ -167,6 +168,202 @@ func (in *Service) GetExtraAnnotations() map[string]string {
 	return in.ExtraAnnotations
 }
 
+// Configuration for a Kubernetes PodDisruptionBudget.
+// If neither minAvailable nor maxUnavailable is set, at most one pod is unavailable at a time.
+//
+// +kubebuilder:validation:AtMostOneOf=minAvailable;maxUnavailable
+type PodDisruptionBudget struct {
+	// The number or percentage of pods that must remain available during an eviction.
+	//
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// The number or percentage of pods that can be unavailable during an eviction.
+	//
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// Additional labels to add to the PodDisruptionBudget object metadata.
+	//
+	// +optional
+	ExtraLabels map[string]string `json:"extraLabels,omitempty"`
+
+	// Additional annotations to add to the PodDisruptionBudget object metadata.
+	//
+	// +optional
+	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
+}
+
+func (in *PodDisruptionBudget) GetMinAvailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MinAvailable
+}
+
+func (in *PodDisruptionBudget) GetMaxUnavailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MaxUnavailable
+}
+
+func (in *PodDisruptionBudget) GetExtraLabels() map[string]string {
+	if in == nil {
+		return nil
+	}
+	return in.ExtraLabels
+}
+
+func (in *PodDisruptionBudget) GetExtraAnnotations() map[string]string {
+	if in == nil {
+		return nil
+	}
+	return in.ExtraAnnotations
+}
+
+// Configuration for a Kubernetes HorizontalPodAutoscaler.
+// If neither target utilization is set, the pods are scaled on a CPU utilization of 80%.
+//
+// +kubebuilder:validation:XValidation:message="minReplicas must be less than or equal to maxReplicas",rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas"
+type HorizontalPodAutoscaler struct {
+	// The lower limit for the number of replicas. Defaults to 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// The upper limit for the number of replicas.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// The target average CPU utilization of the pods, as a percentage of the requested CPU.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// The target average memory utilization of the pods, as a percentage of the requested memory.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Additional labels to add to the HorizontalPodAutoscaler object metadata.
+	//
+	// +optional
+	ExtraLabels map[string]string `json:"extraLabels,omitempty"`
+
+	// Additional annotations to add to the HorizontalPodAutoscaler object metadata.
+	//
+	// +optional
+	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
+}
+
+func (in *HorizontalPodAutoscaler) GetMinReplicas() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.MinReplicas
+}
+
+func (in *HorizontalPodAutoscaler) GetMaxReplicas() int32 {
+	if in == nil {
+		return 0
+	}
+	return in.MaxReplicas
+}
+
+func (in *HorizontalPodAutoscaler) GetTargetCPUUtilizationPercentage() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetCPUUtilizationPercentage
+}
+
+func (in *HorizontalPodAutoscaler) GetTargetMemoryUtilizationPercentage() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetMemoryUtilizationPercentage
+}
+
+func (in *HorizontalPodAutoscaler) GetExtraLabels() map[string]string {
+	if in == nil {
+		return nil
+	}
+	return in.ExtraLabels
+}
+
+func (in *HorizontalPodAutoscaler) GetExtraAnnotations() map[string]string {
+	if in == nil {
+		return nil
+	}
+	return in.ExtraAnnotations
+}
+
+// Configuration for a Kubernetes NetworkPolicy.
+type NetworkPolicy struct {
+	// Additional namespaces the proxy pods can send traffic to, such as the
+	// namespaces of external auth, rate limit or tracing services.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	ExtraEgressNamespaces []string `json:"extraEgressNamespaces,omitempty"`
+
+	// Additional IP blocks, in CIDR notation, the proxy pods can send traffic to.
+	// The destinations outside of the cluster, such as the hosts of the static,
+	// AI and Lambda Backends, are not derived from the routes, so they must be
+	// allowed here, e.g. with `0.0.0.0/0` and `::/0` to allow all of them.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:Pattern=`^[0-9a-fA-F:.]+/[0-9]{1,3}$`
+	ExtraEgressCIDRs []string `json:"extraEgressCIDRs,omitempty"`
+
+	// Additional labels to add to the NetworkPolicy object metadata.
+	//
+	// +optional
+	ExtraLabels map[string]string `json:"extraLabels,omitempty"`
+
+	// Additional annotations to add to the NetworkPolicy object metadata.
+	//
+	// +optional
+	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
+}
+
+func (in *NetworkPolicy) GetExtraEgressNamespaces() []string {
+	if in == nil {
+		return nil
+	}
+	return in.ExtraEgressNamespaces
+}
+
+func (in *NetworkPolicy) GetExtraEgressCIDRs() []string {
+	if in == nil {
+		return nil
+	}
+	return in.ExtraEgressCIDRs
+}
+
+func (in *NetworkPolicy) GetExtraLabels() map[string]string {
+	if in == nil {
+		return nil
+	}
+	return in.ExtraLabels
+}
+
+func (in *NetworkPolicy) GetExtraAnnotations() map[string]string {
+	if in == nil {
+		return nil
+	}
+	return in.ExtraAnnotations
+}
+
 type ServiceAccount struct {
 	// Additional labels to add to the ServiceAccount object metadata.
 	//
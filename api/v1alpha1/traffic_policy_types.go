@@ -109,6 +109,11 @@ type TrafficPolicySpec struct {
 	// It is applicable to HTTPRoutes, Gateway listeners and XListenerSets, and ignored for other targeted kinds.
 	// +optional
 	Retry *Retry `json:"retry,omitempty"`
+
+	// ModelRouting routes LLM requests based on the model set in their JSON request body.
+	// It is applicable to HTTPRoutes, Gateway listeners and XListenerSets, and ignored for other targeted kinds.
+	// +optional
+	ModelRouting *ModelRouting `json:"modelRouting,omitempty"`
 }
 
 // TransformationPolicy config is used to modify envoy behavior at a route level.
@@ -429,6 +434,29 @@ type Buffer struct {
 	Disable *PolicyDisable `json:"disable,omitempty"`
 }
 
+// ModelRouting copies the model of a JSON request body to a request header and selects the route
+// of the request again, so that HTTPRoute rules matching the header can send each model to its own
+// backend behind a single URL, e.g. `gpt-4o` to an AI Backend and `llama-3` to an InferencePool.
+//
+// The policy must apply to the route that matches the request before the header is set, typically
+// a rule without a header match or the Gateway listener. The header sent by the client is always
+// replaced, and set to an empty value when the body has no model or is not JSON.
+// The request body is buffered to read the model.
+type ModelRouting struct {
+	// Header is the request header the model is copied to.
+	// Defaults to `x-gateway-model-name`, the header used by the body based routing of the
+	// Gateway API Inference Extension.
+	// +optional
+	Header *gwv1.HeaderName `json:"header,omitempty"`
+
+	// Field is the field of the JSON request body that holds the model. Nested fields are
+	// separated by dots. Defaults to `model`.
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`
+	Field *string `json:"field,omitempty"`
+}
+
 // RetryOnCondition specifies the condition under which retry takes place.
 //
 // +kubebuilder:validation:Enum={"5xx",gateway-error,reset,reset-before-request,connect-failure,envoy-ratelimited,retriable-4xx,refused-stream,retriable-status-codes,http3-post-connect-failure,cancelled,deadline-exceeded,internal,resource-exhausted,unavailable}
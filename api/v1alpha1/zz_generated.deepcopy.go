@@ -8,9 +8,40 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *A2ACatalog) DeepCopyInto(out *A2ACatalog) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PathPrefix != nil {
+		in, out := &in.PathPrefix, &out.PathPrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new A2ACatalog.
+func (in *A2ACatalog) DeepCopy() *A2ACatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(A2ACatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIBackend) DeepCopyInto(out *AIBackend) {
 	*out = *in
@@ -36,6 +67,47 @@ func (in *AIBackend) DeepCopy() *AIBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AILocalTokenRateLimit) DeepCopyInto(out *AILocalTokenRateLimit) {
+	*out = *in
+	in.TokenBucket.DeepCopyInto(&out.TokenBucket)
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(apisv1.HeaderName)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AILocalTokenRateLimit.
+func (in *AILocalTokenRateLimit) DeepCopy() *AILocalTokenRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(AILocalTokenRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AIMemoryVectorStore) DeepCopyInto(out *AIMemoryVectorStore) {
+	*out = *in
+	if in.MaxEntries != nil {
+		in, out := &in.MaxEntries, &out.MaxEntries
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIMemoryVectorStore.
+func (in *AIMemoryVectorStore) DeepCopy() *AIMemoryVectorStore {
+	if in == nil {
+		return nil
+	}
+	out := new(AIMemoryVectorStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AIPolicy) DeepCopyInto(out *AIPolicy) {
 	*out = *in
@@ -61,6 +133,16 @@ func (in *AIPolicy) DeepCopyInto(out *AIPolicy) {
 		*out = new(RouteType)
 		**out = **in
 	}
+	if in.TokenRateLimit != nil {
+		in, out := &in.TokenRateLimit, &out.TokenRateLimit
+		*out = new(AITokenRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SemanticCache != nil {
+		in, out := &in.SemanticCache, &out.SemanticCache
+		*out = new(AISemanticCache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIPolicy.
@@ -86,6 +168,13 @@ func (in *AIPromptEnrichment) DeepCopyInto(out *AIPromptEnrichment) {
 		*out = make([]Message, len(*in))
 		copy(*out, *in)
 	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]PromptTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AIPromptEnrichment.
@@ -123,6 +212,103 @@ func (in *AIPromptGuard) DeepCopy() *AIPromptGuard {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AISemanticCache) DeepCopyInto(out *AISemanticCache) {
+	*out = *in
+	in.Embedding.DeepCopyInto(&out.Embedding)
+	if in.Store != nil {
+		in, out := &in.Store, &out.Store
+		*out = new(AISemanticCacheStore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SimilarityThreshold != nil {
+		in, out := &in.SimilarityThreshold, &out.SimilarityThreshold
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AISemanticCache.
+func (in *AISemanticCache) DeepCopy() *AISemanticCache {
+	if in == nil {
+		return nil
+	}
+	out := new(AISemanticCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AISemanticCacheEmbedding) DeepCopyInto(out *AISemanticCacheEmbedding) {
+	*out = *in
+	out.BackendRef = in.BackendRef
+	if in.Model != nil {
+		in, out := &in.Model, &out.Model
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AISemanticCacheEmbedding.
+func (in *AISemanticCacheEmbedding) DeepCopy() *AISemanticCacheEmbedding {
+	if in == nil {
+		return nil
+	}
+	out := new(AISemanticCacheEmbedding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AISemanticCacheStore) DeepCopyInto(out *AISemanticCacheStore) {
+	*out = *in
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(AIMemoryVectorStore)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AISemanticCacheStore.
+func (in *AISemanticCacheStore) DeepCopy() *AISemanticCacheStore {
+	if in == nil {
+		return nil
+	}
+	out := new(AISemanticCacheStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AITokenRateLimit) DeepCopyInto(out *AITokenRateLimit) {
+	*out = *in
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(AILocalTokenRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(RateLimitPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AITokenRateLimit.
+func (in *AITokenRateLimit) DeepCopy() *AITokenRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(AITokenRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSGuardrailConfig) DeepCopyInto(out *AWSGuardrailConfig) {
 	*out = *in
@@ -357,6 +543,16 @@ func (in *AiExtensionStats) DeepCopyInto(out *AiExtensionStats) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ClientHeader != nil {
+		in, out := &in.ClientHeader, &out.ClientHeader
+		*out = new(apisv1.HeaderName)
+		**out = **in
+	}
+	if in.Clients != nil {
+		in, out := &in.Clients, &out.Clients
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiExtensionStats.
@@ -1405,6 +1601,11 @@ func (in *DirectResponseSpec) DeepCopyInto(out *DirectResponseSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.A2ACatalog != nil {
+		in, out := &in.A2ACatalog, &out.A2ACatalog
+		*out = new(A2ACatalog)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseSpec.
@@ -1892,7 +2093,7 @@ func (in *GatewayParameters) DeepCopyInto(out *GatewayParameters) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayParameters.
@@ -1973,6 +2174,13 @@ func (in *GatewayParametersSpec) DeepCopy() *GatewayParametersSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayParametersStatus) DeepCopyInto(out *GatewayParametersStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayParametersStatus.
@@ -2410,6 +2618,50 @@ func (in *HealthCheckHttp) DeepCopy() *HealthCheckHttp {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalPodAutoscaler) DeepCopyInto(out *HorizontalPodAutoscaler) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExtraLabels != nil {
+		in, out := &in.ExtraLabels, &out.ExtraLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraAnnotations != nil {
+		in, out := &in.ExtraAnnotations, &out.ExtraAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscaler.
+func (in *HorizontalPodAutoscaler) DeepCopy() *HorizontalPodAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(HorizontalPodAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Host) DeepCopyInto(out *Host) {
 	*out = *in
@@ -2648,11 +2900,21 @@ func (in *KeyAnyValueList) DeepCopy() *KeyAnyValueList {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesProxyConfig) DeepCopyInto(out *KubernetesProxyConfig) {
 	*out = *in
+	if in.WorkloadKind != nil {
+		in, out := &in.WorkloadKind, &out.WorkloadKind
+		*out = new(WorkloadKind)
+		**out = **in
+	}
 	if in.Deployment != nil {
 		in, out := &in.Deployment, &out.Deployment
 		*out = new(ProxyDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DaemonSet != nil {
+		in, out := &in.DaemonSet, &out.DaemonSet
+		*out = new(ProxyDaemonSet)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnvoyContainer != nil {
 		in, out := &in.EnvoyContainer, &out.EnvoyContainer
 		*out = new(EnvoyContainer)
@@ -2678,6 +2940,21 @@ func (in *KubernetesProxyConfig) DeepCopyInto(out *KubernetesProxyConfig) {
 		*out = new(ServiceAccount)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HorizontalPodAutoscaler != nil {
+		in, out := &in.HorizontalPodAutoscaler, &out.HorizontalPodAutoscaler
+		*out = new(HorizontalPodAutoscaler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Istio != nil {
 		in, out := &in.Istio, &out.Istio
 		*out = new(IstioIntegration)
@@ -2703,6 +2980,13 @@ func (in *KubernetesProxyConfig) DeepCopyInto(out *KubernetesProxyConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Overlays != nil {
+		in, out := &in.Overlays, &out.Overlays
+		*out = make([]ObjectOverlay, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesProxyConfig.
@@ -2734,6 +3018,11 @@ func (in *LLMProvider) DeepCopyInto(out *LLMProvider) {
 		*out = new(AuthHeaderOverride)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.APIFormat != nil {
+		in, out := &in.APIFormat, &out.APIFormat
+		*out = new(APIFormat)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LLMProvider.
@@ -3169,6 +3458,31 @@ func (in *MetadataPathSegment) DeepCopy() *MetadataPathSegment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRouting) DeepCopyInto(out *ModelRouting) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(apisv1.HeaderName)
+		**out = **in
+	}
+	if in.Field != nil {
+		in, out := &in.Field, &out.Field
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRouting.
+func (in *ModelRouting) DeepCopy() *ModelRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Moderation) DeepCopyInto(out *Moderation) {
 	*out = *in
@@ -3199,6 +3513,11 @@ func (in *MultiPoolConfig) DeepCopyInto(out *MultiPoolConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Failover != nil {
+		in, out := &in.Failover, &out.Failover
+		*out = new(MultiPoolFailover)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiPoolConfig.
@@ -3211,6 +3530,46 @@ func (in *MultiPoolConfig) DeepCopy() *MultiPoolConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiPoolFailover) DeepCopyInto(out *MultiPoolFailover) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRetryAfter != nil {
+		in, out := &in.MaxRetryAfter, &out.MaxRetryAfter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ConsecutiveFailures != nil {
+		in, out := &in.ConsecutiveFailures, &out.ConsecutiveFailures
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiPoolFailover.
+func (in *MultiPoolFailover) DeepCopy() *MultiPoolFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiPoolFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedObjectReference) DeepCopyInto(out *NamespacedObjectReference) {
 	*out = *in
@@ -3231,6 +3590,45 @@ func (in *NamespacedObjectReference) DeepCopy() *NamespacedObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
+	*out = *in
+	if in.ExtraEgressNamespaces != nil {
+		in, out := &in.ExtraEgressNamespaces, &out.ExtraEgressNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraEgressCIDRs != nil {
+		in, out := &in.ExtraEgressCIDRs, &out.ExtraEgressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraLabels != nil {
+		in, out := &in.ExtraLabels, &out.ExtraLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraAnnotations != nil {
+		in, out := &in.ExtraAnnotations, &out.ExtraAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicy.
+func (in *NetworkPolicy) DeepCopy() *NetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OTelTracesSampler) DeepCopyInto(out *OTelTracesSampler) {
 	*out = *in
@@ -3256,6 +3654,33 @@ func (in *OTelTracesSampler) DeepCopy() *OTelTracesSampler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectOverlay) DeepCopyInto(out *ObjectOverlay) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]OverlayPatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectOverlay.
+func (in *ObjectOverlay) DeepCopy() *ObjectOverlay {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectOverlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenAIConfig) DeepCopyInto(out *OpenAIConfig) {
 	*out = *in
@@ -3381,6 +3806,26 @@ func (in *OutlierDetection) DeepCopy() *OutlierDetection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverlayPatch) DeepCopyInto(out *OverlayPatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(OverlayPatchType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverlayPatch.
+func (in *OverlayPatch) DeepCopy() *OverlayPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(OverlayPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Parameters) DeepCopyInto(out *Parameters) {
 	*out = *in
@@ -3521,6 +3966,45 @@ func (in *Pod) DeepCopy() *Pod {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudget) DeepCopyInto(out *PodDisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.ExtraLabels != nil {
+		in, out := &in.ExtraLabels, &out.ExtraLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExtraAnnotations != nil {
+		in, out := &in.ExtraAnnotations, &out.ExtraAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudget.
+func (in *PodDisruptionBudget) DeepCopy() *PodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyAncestorStatus) DeepCopyInto(out *PolicyAncestorStatus) {
 	*out = *in
@@ -3675,6 +4159,74 @@ func (in *ProcessingMode) DeepCopy() *ProcessingMode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromptTemplate) DeepCopyInto(out *PromptTemplate) {
+	*out = *in
+	out.ConfigMapRef = in.ConfigMapRef
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(PromptTemplateRole)
+		**out = **in
+	}
+	if in.Position != nil {
+		in, out := &in.Position, &out.Position
+		*out = new(PromptTemplatePosition)
+		**out = **in
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]PromptTemplateVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromptTemplate.
+func (in *PromptTemplate) DeepCopy() *PromptTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PromptTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromptTemplateVariable) DeepCopyInto(out *PromptTemplateVariable) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(apisv1.HeaderName)
+		**out = **in
+	}
+	if in.Claim != nil {
+		in, out := &in.Claim, &out.Claim
+		*out = new(string)
+		**out = **in
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromptTemplateVariable.
+func (in *PromptTemplateVariable) DeepCopy() *PromptTemplateVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(PromptTemplateVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromptguardRequest) DeepCopyInto(out *PromptguardRequest) {
 	*out = *in
@@ -3723,6 +4275,11 @@ func (in *PromptguardResponse) DeepCopyInto(out *PromptguardResponse) {
 		*out = new(Webhook)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(StreamingPromptguard)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromptguardResponse.
@@ -3735,6 +4292,31 @@ func (in *PromptguardResponse) DeepCopy() *PromptguardResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyDaemonSet) DeepCopyInto(out *ProxyDaemonSet) {
+	*out = *in
+	if in.HostNetwork != nil {
+		in, out := &in.HostNetwork, &out.HostNetwork
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HostPort != nil {
+		in, out := &in.HostPort, &out.HostPort
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyDaemonSet.
+func (in *ProxyDaemonSet) DeepCopy() *ProxyDaemonSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyDaemonSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyDeployment) DeepCopyInto(out *ProxyDeployment) {
 	*out = *in
@@ -3911,6 +4493,11 @@ func (in *Regex) DeepCopyInto(out *Regex) {
 		*out = new(Action)
 		**out = **in
 	}
+	if in.Mask != nil {
+		in, out := &in.Mask, &out.Mask
+		*out = new(RegexMask)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Regex.
@@ -3923,6 +4510,36 @@ func (in *Regex) DeepCopy() *Regex {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegexMask) DeepCopyInto(out *RegexMask) {
+	*out = *in
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(MaskStrategy)
+		**out = **in
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(string)
+		**out = **in
+	}
+	if in.HashKeySecretRef != nil {
+		in, out := &in.HashKeySecretRef, &out.HashKeySecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegexMask.
+func (in *RegexMask) DeepCopy() *RegexMask {
+	if in == nil {
+		return nil
+	}
+	out := new(RegexMask)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegexMatch) DeepCopyInto(out *RegexMatch) {
 	*out = *in
@@ -4123,6 +4740,52 @@ func (in *SdsContainer) DeepCopy() *SdsContainer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfHostedConfig) DeepCopyInto(out *SelfHostedConfig) {
+	*out = *in
+	out.Service = in.Service
+	if in.API != nil {
+		in, out := &in.API, &out.API
+		*out = new(SelfHostedAPI)
+		**out = **in
+	}
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(SingleAuthToken)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Model != nil {
+		in, out := &in.Model, &out.Model
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfHostedConfig.
+func (in *SelfHostedConfig) DeepCopy() *SelfHostedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfHostedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfHostedService) DeepCopyInto(out *SelfHostedService) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfHostedService.
+func (in *SelfHostedService) DeepCopy() *SelfHostedService {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfHostedService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelfManagedGateway) DeepCopyInto(out *SelfManagedGateway) {
 	*out = *in
@@ -4358,6 +5021,31 @@ func (in *StatusCodeFilter) DeepCopy() *StatusCodeFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamingPromptguard) DeepCopyInto(out *StreamingPromptguard) {
+	*out = *in
+	if in.WindowSize != nil {
+		in, out := &in.WindowSize, &out.WindowSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(Action)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamingPromptguard.
+func (in *StreamingPromptguard) DeepCopy() *StreamingPromptguard {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamingPromptguard)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StringMatcher) DeepCopyInto(out *StringMatcher) {
 	*out = *in
@@ -4431,6 +5119,11 @@ func (in *SupportedLLMProvider) DeepCopyInto(out *SupportedLLMProvider) {
 		*out = new(BedrockConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SelfHosted != nil {
+		in, out := &in.SelfHosted, &out.SelfHosted
+		*out = new(SelfHostedConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupportedLLMProvider.
@@ -4818,6 +5511,11 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(Retry)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ModelRouting != nil {
+		in, out := &in.ModelRouting, &out.ModelRouting
+		*out = new(ModelRouting)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPolicySpec.
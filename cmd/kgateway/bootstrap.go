@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/xds/bootstrap"
+)
+
+type bootstrapOptions struct {
+	proxy  bootstrap.ProxyOptions
+	xdsTLS bootstrap.XdsTLSOptions
+	output string
+}
+
+func newBootstrapCmd() *cobra.Command {
+	opts := &bootstrapOptions{}
+	cmd := &cobra.Command{
+		Use:   "bootstrap",
+		Short: "Prints the Envoy bootstrap config of a self-managed proxy for a Gateway",
+		Long: `Prints the Envoy bootstrap config of a self-managed proxy for a Gateway, such as an Envoy
+running on a VM outside Kubernetes. The proxy gets the config of the Gateway from the kgateway
+xDS server, which must be reachable from the proxy.
+
+Proxies outside Kubernetes don't have a pod, so the control plane must run with
+DISABLE_POD_LOCALITY_XDS=true to serve them.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBootstrap(cmd, opts)
+		},
+	}
+
+	hostname, _ := os.Hostname()
+	flags := cmd.Flags()
+	flags.StringVar(&opts.proxy.GatewayName, "gateway", "", "Name of the Gateway served by the proxy")
+	flags.StringVarP(&opts.proxy.GatewayNamespace, "namespace", "n", "default", "Namespace of the Gateway served by the proxy")
+	flags.StringVar(&opts.proxy.NodeName, "node", hostname, "Name of the proxy among the proxies of the Gateway")
+	flags.StringVar(&opts.proxy.XdsHost, "xds-host", "", "Host of the kgateway xDS server")
+	flags.Uint32Var(&opts.proxy.XdsPort, "xds-port", wellknown.DefaultXdsPort, "Port of the kgateway xDS server")
+	flags.StringVar(&opts.xdsTLS.CACertFile, "xds-ca-cert", "", "Path of the CA certificate validating the xDS server on the proxy host, enables TLS to the xDS server")
+	flags.StringVar(&opts.xdsTLS.CertFile, "xds-client-cert", "", "Path of the client certificate presented to the xDS server on the proxy host")
+	flags.StringVar(&opts.xdsTLS.KeyFile, "xds-client-key", "", "Path of the key of the client certificate on the proxy host")
+	flags.BoolVar(&opts.proxy.XdsDelta, "xds-delta", false, "Use the incremental (delta) xDS protocol")
+	flags.StringVar(&opts.xdsTLS.SNI, "xds-sni", "", "Server name sent to the xDS server, defaults to the xDS host")
+	flags.StringVar(&opts.proxy.AdminAddress, "admin-address", "127.0.0.1", "Address of the Envoy admin interface")
+	flags.Uint32Var(&opts.proxy.AdminPort, "admin-port", wellknown.EnvoyAdminPort, "Port of the Envoy admin interface")
+	flags.Uint32Var(&opts.proxy.ReadinessPort, "readiness-port", bootstrap.DefaultReadinessPort, "Port of the listener serving the readiness check on /ready, 0 to disable it")
+	flags.StringVarP(&opts.output, "output", "o", "yaml", "Output format, one of yaml or json")
+	_ = cmd.MarkFlagRequired("gateway")
+	_ = cmd.MarkFlagRequired("xds-host")
+
+	return cmd
+}
+
+func runBootstrap(cmd *cobra.Command, opts *bootstrapOptions) error {
+	if opts.output != "yaml" && opts.output != "json" {
+		return fmt.Errorf("invalid output format %q, must be yaml or json", opts.output)
+	}
+
+	proxyOpts := opts.proxy
+	if opts.xdsTLS != (bootstrap.XdsTLSOptions{}) {
+		proxyOpts.XdsTLS = &opts.xdsTLS
+	}
+	bs, err := bootstrap.BuildProxy(proxyOpts)
+	if err != nil {
+		return fmt.Errorf("failed to build bootstrap: %w", err)
+	}
+
+	out, err := protojson.MarshalOptions{UseProtoNames: true, Multiline: true}.Marshal(bs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bootstrap: %w", err)
+	}
+	if opts.output == "yaml" {
+		out, err = yaml.JSONToYAML(out)
+		if err != nil {
+			return fmt.Errorf("failed to convert bootstrap to yaml: %w", err)
+		}
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), strings.TrimSpace(string(out)))
+	return err
+}
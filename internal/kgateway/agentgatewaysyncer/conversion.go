@@ -40,6 +40,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	agwir "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/plugins"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
 )
@@ -1370,6 +1371,24 @@ func createADPExtensionRefFilter(
 			filter.GetDirectResponse().Body = []byte(*directResponse.Spec.Body)
 		}
 
+		// Respond with the catalog of discovered A2A agents
+		if catalog := directResponse.Spec.A2ACatalog; catalog != nil {
+			agents, err := a2aCatalogAgents(ctx, ns, catalog)
+			var body []byte
+			if err == nil {
+				body, err = plugins.BuildA2ACatalog(agents)
+			}
+			if err != nil {
+				return nil, &reporter.RouteCondition{
+					Type:    gwv1.RouteConditionAccepted,
+					Status:  metav1.ConditionFalse,
+					Reason:  gwv1.RouteReasonUnsupportedValue,
+					Message: fmt.Sprintf("DirectResponse %s/%s has an invalid A2A catalog: %v", ns, extensionRef.Name, err),
+				}
+			}
+			filter.GetDirectResponse().Body = body
+		}
+
 		return filter, nil
 	}
 
@@ -1383,6 +1402,79 @@ func createADPExtensionRefFilter(
 }
 
 // findDirectResponse looks up a DirectResponse resource by name and namespace
+// a2aCatalogAgents returns the agents listed by an A2A catalog of a DirectResponse in the given
+// namespace. The agents in other namespaces are only listed if a ReferenceGrant allows the routes
+// of the namespace to reference their Service, as the catalog routes requests to them.
+func a2aCatalogAgents(ctx RouteContext, ns string, catalog *v1alpha1.A2ACatalog) ([]plugins.A2ACatalogAgent, error) {
+	agents, err := plugins.SelectA2ACatalogAgents(ctx.Krt, ctx.Services, ctx.Namespaces, ns, catalog)
+	if err != nil {
+		return nil, err
+	}
+	return slices.FilterInPlace(agents, func(agent plugins.A2ACatalogAgent) bool {
+		return agent.Service.Namespace == ns || ctx.Grants.BackendAllowed(
+			ctx.Krt, wellknown.HTTPRouteGVK, gwv1.ObjectName(agent.Service.Name), gwv1.Namespace(agent.Service.Namespace), ns, wellknown.ServiceGVK)
+	}), nil
+}
+
+// convertA2ACatalogRoutes generates a route to each agent listed by the A2A catalog DirectResponse
+// of the rule, if any, so that the URLs advertised by the catalog reach the agents. The path prefix
+// of an agent is stripped from the requests forwarded to it.
+func convertA2ACatalogRoutes(ctx RouteContext, r gwv1.HTTPRouteRule, obj *gwv1.HTTPRoute, pos int) []*api.Route {
+	var catalog *v1alpha1.A2ACatalog
+	for _, filter := range r.Filters {
+		ref := filter.ExtensionRef
+		if filter.Type != gwv1.HTTPRouteFilterExtensionRef || ref == nil ||
+			string(ref.Group) != wellknown.DirectResponseGVK.Group || string(ref.Kind) != wellknown.DirectResponseGVK.Kind {
+			continue
+		}
+		catalog = findDirectResponse(ctx, string(ref.Name), obj.Namespace).GetA2ACatalog()
+	}
+	if catalog == nil {
+		return nil
+	}
+	// an invalid catalog is reported when converting its DirectResponse
+	agents, err := a2aCatalogAgents(ctx, obj.Namespace, catalog)
+	if err != nil {
+		return nil
+	}
+
+	routeKey := getRouteKeyPosition(obj.ObjectMeta, pos)
+	if r.Name != nil {
+		routeKey = getRouteKeySectionName(obj.ObjectMeta, string(*r.Name))
+	}
+	hostnames := slices.Map(obj.Spec.Hostnames, func(e gwv1.Hostname) string {
+		return string(e)
+	})
+	routes := make([]*api.Route, 0, len(agents))
+	for _, agent := range agents {
+		svc := agent.Service
+		routes = append(routes, &api.Route{
+			Key:       routeKey + ".a2a." + svc.Namespace + "." + svc.Name,
+			RouteName: obj.Namespace + "/" + obj.Name,
+			RuleName:  defaultString(r.Name, ""),
+			Hostnames: hostnames,
+			Matches: []*api.RouteMatch{{
+				Path: &api.PathMatch{Kind: &api.PathMatch_PathPrefix{PathPrefix: agent.Path}},
+			}},
+			Filters: []*api.RouteFilter{{
+				Kind: &api.RouteFilter_UrlRewrite{UrlRewrite: &api.UrlRewrite{
+					Path: &api.UrlRewrite_Prefix{Prefix: ""},
+				}},
+			}},
+			Backends: []*api.RouteBackend{{
+				Weight: 1,
+				Backend: &api.BackendReference{
+					Kind: &api.BackendReference_Service{
+						Service: svc.Namespace + "/" + kubeutils.GetServiceHostname(svc.Name, svc.Namespace),
+					},
+					Port: uint32(agent.Port),
+				},
+			}},
+		})
+	}
+	return routes
+}
+
 func findDirectResponse(ctx RouteContext, name, namespace string) *v1alpha1.DirectResponse {
 	if ctx.DirectResponses == nil {
 		return nil
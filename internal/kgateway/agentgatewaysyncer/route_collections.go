@@ -63,6 +63,11 @@ func ADPRouteCollection(
 							return
 						}
 					}
+					for _, res := range convertA2ACatalogRoutes(ctx, r, obj, n) {
+						if !yield(ADPRoute{Route: res}, nil) {
+							return
+						}
+					}
 				}
 			}
 		})
@@ -1790,6 +1790,55 @@ func TestADPRouteCollectionWithFilters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Route with A2A catalog DirectResponse ExtensionRef filter",
+			httpRoute: &gwv1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "a2a-catalog-route",
+					Namespace: "default",
+				},
+				Spec: gwv1.HTTPRouteSpec{
+					CommonRouteSpec: gwv1.CommonRouteSpec{
+						ParentRefs: []gwv1.ParentReference{
+							{
+								Name: "test-gateway",
+							},
+						},
+					},
+					Hostnames: []gwv1.Hostname{"example.com"},
+					Rules: []gwv1.HTTPRouteRule{
+						{
+							Matches: []gwv1.HTTPRouteMatch{
+								{
+									Path: &gwv1.HTTPPathMatch{
+										Type:  ptr.To(gwv1.PathMatchExact),
+										Value: ptr.To("/agents"),
+									},
+								},
+							},
+							Filters: []gwv1.HTTPRouteFilter{
+								{
+									Type: gwv1.HTTPRouteFilterExtensionRef,
+									ExtensionRef: &gwv1.LocalObjectReference{
+										Group: "gateway.kgateway.dev",
+										Kind:  "DirectResponse",
+										Name:  "a2a-catalog",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedFilter: &api.RouteFilter{
+				Kind: &api.RouteFilter_DirectResponse{
+					DirectResponse: &api.DirectResponse{
+						Status: 200,
+						Body:   []byte(`{"agents":[{"name":"weather-agent","namespace":"default","url":"/agents/default/weather-agent","agentCard":"/agents/default/weather-agent/.well-known/agent.json"}]}`),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1861,6 +1910,42 @@ func TestADPRouteCollectionWithFilters(t *testing.T) {
 				}
 				inputs = append(inputs, directResponse)
 			}
+			if tc.name == "Route with A2A catalog DirectResponse ExtensionRef filter" {
+				a2aService := func(name string, labels map[string]string) *corev1.Service {
+					return &corev1.Service{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+							Labels:    labels,
+						},
+						Spec: corev1.ServiceSpec{
+							Ports: []corev1.ServicePort{
+								{
+									Port:        8080,
+									AppProtocol: ptr.To("kgateway.dev/a2a"),
+								},
+							},
+						},
+					}
+				}
+				directResponse := &v1alpha1.DirectResponse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "a2a-catalog",
+						Namespace: "default",
+					},
+					Spec: v1alpha1.DirectResponseSpec{
+						StatusCode: 200,
+						A2ACatalog: &v1alpha1.A2ACatalog{
+							Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"catalog": "public"}},
+						},
+					},
+				}
+				inputs = append(inputs,
+					directResponse,
+					a2aService("weather-agent", map[string]string{"catalog": "public"}),
+					a2aService("internal-agent", nil),
+				)
+			}
 
 			// Create mock collections
 			mock := krttest.NewMock(t, inputs)
@@ -1984,6 +2069,119 @@ func TestADPRouteCollectionWithFilters(t *testing.T) {
 	}
 }
 
+func TestADPRouteCollectionA2ACatalogRoutes(t *testing.T) {
+	a2aService := func(name, namespace string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					{Port: 80},
+					{Port: 8080, AppProtocol: ptr.To("kgateway.dev/a2a")},
+				},
+			},
+		}
+	}
+	a2aNamespace := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"a2a": "true"}}}
+	}
+	httpRoute := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "a2a-catalog-route", Namespace: "default"},
+		Spec: gwv1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1.CommonRouteSpec{
+				ParentRefs: []gwv1.ParentReference{{Name: "test-gateway"}},
+			},
+			Hostnames: []gwv1.Hostname{"example.com"},
+			Rules: []gwv1.HTTPRouteRule{{
+				Matches: []gwv1.HTTPRouteMatch{{
+					Path: &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchExact), Value: ptr.To("/agents")},
+				}},
+				Filters: []gwv1.HTTPRouteFilter{{
+					Type: gwv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gwv1.LocalObjectReference{
+						Group: "gateway.kgateway.dev",
+						Kind:  "DirectResponse",
+						Name:  "a2a-catalog",
+					},
+				}},
+			}},
+		},
+	}
+	directResponse := &v1alpha1.DirectResponse{
+		ObjectMeta: metav1.ObjectMeta{Name: "a2a-catalog", Namespace: "default"},
+		Spec: v1alpha1.DirectResponseSpec{
+			StatusCode: 200,
+			A2ACatalog: &v1alpha1.A2ACatalog{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"a2a": "true"}},
+			},
+		},
+	}
+	gateway := GatewayListener{
+		Config: &Config{
+			Meta: Meta{Name: "test-gateway", Namespace: "default"},
+		},
+		parent: parentKey{
+			Kind:      wellknown.GatewayGVK,
+			Name:      "test-gateway",
+			Namespace: "default",
+		},
+		parentInfo: parentInfo{
+			InternalName: "default/test-gateway",
+			Protocol:     gwv1.HTTPProtocolType,
+			Port:         80,
+			SectionName:  "http",
+			AllowedKinds: []gwv1.RouteGroupKind{{Group: &groupName, Kind: gwv1.Kind(wellknown.HTTPRouteKind)}},
+		},
+		Valid: true,
+	}
+
+	// the agent of the team namespace is not listed nor routed, as no ReferenceGrant allows it
+	mock := krttest.NewMock(t, []any{
+		httpRoute, directResponse, gateway, ReferenceGrant{},
+		a2aNamespace("default"), a2aNamespace("team"),
+		a2aService("weather-agent", "default"), a2aService("team-agent", "team"),
+	})
+	gateways := krttest.GetMockCollection[GatewayListener](mock)
+	httpRoutes := krttest.GetMockCollection[*gwv1.HTTPRoute](mock)
+	services := krttest.GetMockCollection[*corev1.Service](mock)
+	namespaces := krttest.GetMockCollection[*corev1.Namespace](mock)
+	routeInputs := RouteContextInputs{
+		Grants:          BuildReferenceGrants(krttest.GetMockCollection[ReferenceGrant](mock)),
+		RouteParents:    BuildRouteParents(gateways),
+		Services:        services,
+		Namespaces:      namespaces,
+		ServiceEntries:  krttest.GetMockCollection[*networkingclient.ServiceEntry](mock),
+		InferencePools:  krttest.GetMockCollection[*inf.InferencePool](mock),
+		DirectResponses: krttest.GetMockCollection[*v1alpha1.DirectResponse](mock),
+	}
+
+	adpRoutes := ADPRouteCollection(httpRoutes,
+		krttest.GetMockCollection[*gwv1.GRPCRoute](mock),
+		krttest.GetMockCollection[*gwv1alpha2.TCPRoute](mock),
+		krttest.GetMockCollection[*gwv1alpha2.TLSRoute](mock),
+		routeInputs, krtinternal.KrtOptions{}, pluginsdk.Plugin{})
+	adpRoutes.WaitUntilSynced(context.Background().Done())
+
+	results := adpRoutes.List()
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Resources, 2)
+
+	catalog := results[0].Resources[0].GetRoute()
+	require.NotNil(t, catalog)
+	assert.Equal(t,
+		`{"agents":[{"name":"weather-agent","namespace":"default","url":"/agents/default/weather-agent","agentCard":"/agents/default/weather-agent/.well-known/agent.json"}]}`,
+		string(catalog.GetFilters()[0].GetDirectResponse().GetBody()))
+
+	agent := results[0].Resources[1].GetRoute()
+	require.NotNil(t, agent)
+	assert.Equal(t, "default.a2a-catalog-route.0.a2a.default.weather-agent.http", agent.GetKey())
+	assert.Equal(t, []string{"example.com"}, agent.GetHostnames())
+	assert.Equal(t, "/agents/default/weather-agent", agent.GetMatches()[0].GetPath().GetPathPrefix())
+	assert.Equal(t, "", agent.GetFilters()[0].GetUrlRewrite().GetPrefix())
+	require.Len(t, agent.GetBackends(), 1)
+	assert.Equal(t, "default/weather-agent.default.svc.cluster.local", agent.GetBackends()[0].GetBackend().GetService())
+	assert.Equal(t, uint32(8080), agent.GetBackends()[0].GetBackend().GetPort())
+}
+
 func TestADPRouteCollectionEquals(t *testing.T) {
 	// Test that ADPResourcesForGateway implements Equals correctly
 	route1 := &api.Route{
@@ -3,7 +3,10 @@ package controller
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
 
+	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/kube/kubetypes"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -27,8 +30,10 @@ import (
 	infextv1a2 "sigs.k8s.io/gateway-api-inference-extension/api/v1alpha2"
 	apiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	internaldeployer "github.com/kgateway-dev/kgateway/v2/internal/kgateway/deployer"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
 	common "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
@@ -298,12 +303,40 @@ func (c *controllerBuilder) watchGw(ctx context.Context) error {
 	)
 
 	// Trigger an event when the gateway changes. This can even be a change in listener sets attached to the gateway
+	backendNamespaces := newBackendNamespacesTracker()
 	c.cfg.CommonCollections.GatewayIndex.Gateways.Register(func(o krt.Event[ir.Gateway]) {
 		gw := o.Latest()
+		if o.Event == controllers.EventDelete {
+			backendNamespaces.forget(types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name})
+		}
 		c.reconciler.customEvents <- event.TypedGenericEvent[ir.Gateway]{
 			Object: gw,
 		}
 	})
+	// the egress of the NetworkPolicy of a Gateway depends on the backends of its routes,
+	// so trigger an event for the Gateways of the routes when their backend namespaces change
+	if routes := c.cfg.CommonCollections.Routes; routes != nil {
+		routes.Routes().Register(func(o krt.Event[krtcollections.RouteWrapper]) {
+			for _, rt := range o.Items() {
+				for _, gw := range routeGateways(c.cfg.CommonCollections, rt.Route) {
+					nns := types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}
+					if !backendNamespaces.update(nns, deployer.GetServiceBackendNamespaces(&gw, c.cfg.CommonCollections)) {
+						continue
+					}
+					// the Gateways without a NetworkPolicy do not render the backend namespaces
+					hasNetworkPolicy, err := gwParams.HasNetworkPolicy(ctx, gw.Obj)
+					if err != nil {
+						log.Error(err, "could not resolve the GatewayParameters of Gateway", "gatewayNamespace", gw.Namespace, "gatewayName", gw.Name)
+					} else if !hasNetworkPolicy {
+						continue
+					}
+					c.reconciler.customEvents <- event.TypedGenericEvent[ir.Gateway]{
+						Object: gw,
+					}
+				}
+			}
+		})
+	}
 	buildr.WatchesRawSource(
 		// Add channel source for custom events
 		source.Channel(
@@ -346,7 +379,133 @@ func (c *controllerBuilder) watchGw(ctx context.Context) error {
 	buildr.WithOptions(controller.TypedOptions[reconcile.Request]{
 		NeedLeaderElection: ptr.To(true),
 	})
-	return buildr.Complete(NewGatewayReconciler(ctx, c.cfg, d))
+	if err := buildr.Complete(NewGatewayReconciler(ctx, c.cfg, d)); err != nil {
+		return err
+	}
+
+	return c.watchGwParams(d)
+}
+
+// watchGwParams reports the status of the overlays of the GatewayParameters, which depends on
+// the Gateways that use them, directly or through their GatewayClass.
+func (c *controllerBuilder) watchGwParams(d *deployer.Deployer) error {
+	cli := c.cfg.Mgr.GetClient()
+	discoveryNamespaceFilterPredicate := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return c.cfg.DiscoveryNamespaceFilter.Filter(o)
+	})
+
+	return ctrl.NewControllerManagedBy(c.cfg.Mgr).
+		For(&v1alpha1.GatewayParameters{}, builder.WithPredicates(
+			discoveryNamespaceFilterPredicate,
+			predicate.GenerationChangedPredicate{},
+		)).
+		// the Gateways are only watched for the GatewayParameters they use, as changing them
+		// changes the objects the overlays are applied to
+		Watches(&apiv1.Gateway{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+			gw, ok := obj.(*apiv1.Gateway)
+			if !ok {
+				return nil
+			}
+			var reqs []reconcile.Request
+			if infra := gw.Spec.Infrastructure; infra != nil && infra.ParametersRef != nil {
+				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: gw.Namespace, Name: infra.ParametersRef.Name}})
+			}
+			var gwc apiv1.GatewayClass
+			if err := cli.Get(ctx, client.ObjectKey{Name: string(gw.Spec.GatewayClassName)}, &gwc); err != nil {
+				return reqs
+			}
+			return append(reqs, gatewayClassToParams(&gwc)...)
+		}), builder.WithPredicates(
+			discoveryNamespaceFilterPredicate,
+			predicate.GenerationChangedPredicate{},
+		)).
+		Watches(&apiv1.GatewayClass{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+			gwc, ok := obj.(*apiv1.GatewayClass)
+			if !ok {
+				return nil
+			}
+			return gatewayClassToParams(gwc)
+		}), builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(o client.Object) bool {
+				gc, ok := o.(*apiv1.GatewayClass)
+				return ok && gc.Spec.ControllerName == apiv1.GatewayController(c.cfg.ControllerName)
+			}),
+			predicate.GenerationChangedPredicate{},
+		)).
+		// the status of the GatewayParameters is written by the leader only, as the Gateways
+		// are deployed by the gatewayReconciler
+		WithOptions(controller.TypedOptions[reconcile.Request]{
+			NeedLeaderElection: ptr.To(true),
+		}).
+		Complete(NewGatewayParametersReconciler(c.cfg, d))
+}
+
+// gatewayClassToParams returns the request for the GatewayParameters of a GatewayClass, if any.
+func gatewayClassToParams(gwc *apiv1.GatewayClass) []reconcile.Request {
+	ref := gwc.Spec.ParametersRef
+	if ref == nil || ref.Namespace == nil ||
+		ref.Group != apiv1.Group(wellknown.GatewayParametersGVK.Group) || ref.Kind != apiv1.Kind(wellknown.GatewayParametersGVK.Kind) {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: string(*ref.Namespace), Name: ref.Name}}}
+}
+
+// backendNamespacesTracker records the Service backend namespaces of each Gateway, so that
+// route changes only reconcile the Gateways whose backend namespaces changed.
+type backendNamespacesTracker struct {
+	mu         sync.Mutex
+	namespaces map[types.NamespacedName][]string
+}
+
+func newBackendNamespacesTracker() *backendNamespacesTracker {
+	return &backendNamespacesTracker{namespaces: map[types.NamespacedName][]string{}}
+}
+
+// update records the backend namespaces of the Gateway and returns whether they changed.
+// The first namespaces recorded for a Gateway are considered a change.
+func (t *backendNamespacesTracker) update(gw types.NamespacedName, namespaces []string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if prev, ok := t.namespaces[gw]; ok && slices.Equal(prev, namespaces) {
+		return false
+	}
+	t.namespaces[gw] = namespaces
+	return true
+}
+
+func (t *backendNamespacesTracker) forget(gw types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.namespaces, gw)
+}
+
+// routeGateways returns the Gateways that serve a route: the Gateways it is attached to,
+// directly or through a ListenerSet, and the Gateways they are merged into.
+func routeGateways(commonCollections *common.CommonCollections, route ir.Route) []ir.Gateway {
+	parents := commonCollections.Routes.RouteParents(route)
+	if len(parents) == 0 {
+		return nil
+	}
+
+	var ret []ir.Gateway
+	for _, gw := range commonCollections.GatewayIndex.Gateways.List() {
+		if slices.ContainsFunc(parents, func(parent ir.ObjectSource) bool {
+			switch {
+			case parent.Group == wellknown.GatewayGVK.Group && parent.Kind == wellknown.GatewayKind:
+				return parent.Equals(gw.ObjectSource) || slices.ContainsFunc(gw.MergedGateways, func(merged *apiv1.Gateway) bool {
+					return parent.Namespace == merged.Namespace && parent.Name == merged.Name
+				})
+			case parent.Group == wellknown.XListenerSetGVK.Group && parent.Kind == wellknown.XListenerSetKind:
+				return slices.ContainsFunc(gw.AllowedListenerSets, func(ls ir.ListenerSet) bool {
+					return parent.Equals(ls.ObjectSource)
+				})
+			}
+			return false
+		}) {
+			ret = append(ret, gw)
+		}
+	}
+	return ret
 }
 
 func (c *controllerBuilder) addHTTPRouteIndexes(ctx context.Context) error {
@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	api "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
+)
+
+// gatewayParametersReconciler reports in the OverlaysApplied condition of the GatewayParameters
+// whether their overlays could be applied to the objects deployed for all the Gateways using them,
+// either directly or through their GatewayClass.
+type gatewayParametersReconciler struct {
+	cli           client.Client
+	autoProvision bool
+
+	controllerName string
+
+	deployer *deployer.Deployer
+}
+
+func NewGatewayParametersReconciler(cfg GatewayConfig, deployer *deployer.Deployer) *gatewayParametersReconciler {
+	return &gatewayParametersReconciler{
+		cli:            cfg.Mgr.GetClient(),
+		controllerName: cfg.ControllerName,
+		autoProvision:  cfg.AutoProvision,
+		deployer:       deployer,
+	}
+}
+
+func (r *gatewayParametersReconciler) Reconcile(ctx context.Context, req ctrl.Request) (res ctrl.Result, rErr error) {
+	log := log.FromContext(ctx).WithValues("gwp", req.NamespacedName)
+	log.V(1).Info("reconciling request", "req", req)
+
+	finishMetrics := collectReconciliationMetrics("gatewayparameters", req)
+	defer func() {
+		finishMetrics(rErr)
+	}()
+
+	var gwp v1alpha1.GatewayParameters
+	if err := r.cli.Get(ctx, req.NamespacedName, &gwp); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	original := gwp.DeepCopy()
+
+	var changed bool
+	if len(gwp.Spec.GetKube().GetOverlays()) == 0 {
+		// the overlays were removed, so is their condition
+		changed = meta.RemoveStatusCondition(&gwp.Status.Conditions, v1alpha1.GatewayParametersConditionOverlaysApplied)
+	} else {
+		gws, err := r.getGateways(ctx, &gwp)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		var total int
+		var failed []string
+		for _, gw := range gws {
+			results, err := r.deployer.CheckOverlays(ctx, gw)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			for _, result := range results {
+				if result.Source.GetNamespace() != gwp.Namespace || result.Source.GetName() != gwp.Name {
+					continue
+				}
+				total++
+				if result.Err != nil {
+					failed = append(failed, fmt.Sprintf("%s/%s: %v", gw.Namespace, gw.Name, result.Err))
+				}
+			}
+		}
+		changed = meta.SetStatusCondition(&gwp.Status.Conditions, overlaysAppliedCondition(gwp.Generation, total, failed))
+	}
+	if !changed {
+		return ctrl.Result{}, nil
+	}
+
+	log.Info("updating overlays status")
+	return ctrl.Result{}, r.cli.Status().Patch(ctx, &gwp, client.MergeFrom(original))
+}
+
+// overlaysAppliedCondition returns the OverlaysApplied condition of overlays applied to the
+// objects of total Gateways, failed holding the errors of the Gateways they couldn't be applied to.
+func overlaysAppliedCondition(generation int64, total int, failed []string) metav1.Condition {
+	if len(failed) > 0 {
+		slices.Sort(failed)
+		return metav1.Condition{
+			Type:               v1alpha1.GatewayParametersConditionOverlaysApplied,
+			Status:             metav1.ConditionFalse,
+			Reason:             v1alpha1.GatewayParametersReasonInvalidOverlay,
+			Message:            fmt.Sprintf("Overlays could not be applied for %d of %d Gateways: %s", len(failed), total, strings.Join(failed, "; ")),
+			ObservedGeneration: generation,
+		}
+	}
+	return metav1.Condition{
+		Type:               v1alpha1.GatewayParametersConditionOverlaysApplied,
+		Status:             metav1.ConditionTrue,
+		Reason:             v1alpha1.GatewayParametersReasonOverlaysApplied,
+		Message:            fmt.Sprintf("All overlays were applied for %d Gateways", total),
+		ObservedGeneration: generation,
+	}
+}
+
+// getGateways returns the Gateways deployed by this controller that use the GatewayParameters,
+// through their infrastructure parametersRef or through the parametersRef of their GatewayClass.
+func (r *gatewayParametersReconciler) getGateways(ctx context.Context, gwp *v1alpha1.GatewayParameters) ([]*api.Gateway, error) {
+	var gws []*api.Gateway
+
+	var gwList api.GatewayList
+	if err := r.cli.List(ctx, &gwList, client.InNamespace(gwp.Namespace), client.MatchingFieldsSelector{Selector: fields.OneTermEqualSelector(GatewayParamsField, gwp.Name)}); err != nil {
+		return nil, err
+	}
+	for i := range gwList.Items {
+		gws = append(gws, &gwList.Items[i])
+	}
+
+	var gwcList api.GatewayClassList
+	if err := r.cli.List(ctx, &gwcList); err != nil {
+		return nil, err
+	}
+	for _, gwc := range gwcList.Items {
+		if !gatewayClassUsesParameters(&gwc, gwp) {
+			continue
+		}
+		var gwList api.GatewayList
+		if err := r.cli.List(ctx, &gwList, client.MatchingFields{GatewayClassField: gwc.Name}); err != nil {
+			return nil, err
+		}
+		for i := range gwList.Items {
+			gws = append(gws, &gwList.Items[i])
+		}
+	}
+
+	var ret []*api.Gateway
+	for _, gw := range gws {
+		deployed, err := r.isDeployed(ctx, gw)
+		if err != nil {
+			return nil, err
+		}
+		if deployed && !slices.ContainsFunc(ret, func(o *api.Gateway) bool { return o.UID == gw.UID }) {
+			ret = append(ret, gw)
+		}
+	}
+	return ret, nil
+}
+
+// isDeployed returns whether the gatewayReconciler deploys a proxy for the Gateway.
+func (r *gatewayParametersReconciler) isDeployed(ctx context.Context, gw *api.Gateway) (bool, error) {
+	if gw.GetDeletionTimestamp() != nil {
+		return false, nil
+	}
+
+	var namespace corev1.Namespace
+	if err := r.cli.Get(ctx, client.ObjectKey{Name: gw.Namespace}, &namespace); err != nil {
+		return false, err
+	}
+	if !r.autoProvision && namespace.Annotations[GatewayAutoDeployAnnotationKey] != "true" {
+		return false, nil
+	}
+
+	var gwc api.GatewayClass
+	if err := r.cli.Get(ctx, client.ObjectKey{Name: string(gw.Spec.GatewayClassName)}, &gwc); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	if gwc.Spec.ControllerName != api.GatewayController(r.controllerName) {
+		return false, nil
+	}
+
+	if target := utils.MergeIntoGatewayName(gw); target != "" {
+		var targetGw api.Gateway
+		err := r.cli.Get(ctx, client.ObjectKey{Namespace: gw.Namespace, Name: target}, &targetGw)
+		if client.IgnoreNotFound(err) != nil {
+			return false, err
+		}
+		if err == nil && utils.CanMergeInto(gw, &targetGw) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// gatewayClassUsesParameters returns whether the parametersRef of the GatewayClass refers to the GatewayParameters.
+func gatewayClassUsesParameters(gwc *api.GatewayClass, gwp *v1alpha1.GatewayParameters) bool {
+	return slices.Contains(gatewayClassToParams(gwc), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(gwp)})
+}
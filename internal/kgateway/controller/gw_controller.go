@@ -2,12 +2,14 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilretry "k8s.io/client-go/util/retry"
@@ -16,6 +18,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	api "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
 )
 
@@ -93,9 +97,30 @@ func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		return ctrl.Result{}, nil
 	}
 
+	// the listeners of a gateway merged into another one are served by the proxy of
+	// the other gateway, so it doesn't get its own proxy
+	if target := utils.MergeIntoGatewayName(&gw); target != "" {
+		var targetGw api.Gateway
+		err := r.cli.Get(ctx, client.ObjectKey{Namespace: gw.Namespace, Name: target}, &targetGw)
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		if err == nil && utils.CanMergeInto(&gw, &targetGw) {
+			log.Info("reconciling gateway merged into another gateway", "target", target)
+			return r.reconcileMergedGateway(ctx, &gw, &targetGw)
+		}
+	}
+
 	log.Info("reconciling gateway")
 	objs, err := r.deployer.GetObjsToDeploy(ctx, &gw)
 	if err != nil {
+		var overlayErr *deployer.OverlayError
+		if errors.As(err, &overlayErr) {
+			// the error is reported in the GatewayParameters status, and the Gateway is
+			// reconciled again when the GatewayParameters is fixed, so don't retry
+			log.Error(err, "failed to apply overlays")
+			return ctrl.Result{}, nil
+		}
 		return ctrl.Result{}, err
 	}
 	objs = r.deployer.SetNamespaceAndOwner(&gw, objs)
@@ -118,36 +143,68 @@ func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		result.Requeue = true
 	}
 
+	// the gateways merged into this one are reachable through its service
+	err = updateMergedGatewaysStatus(ctx, r.cli, &gw, generatedSvc)
+	if err != nil {
+		log.Error(err, "failed to update status of merged gateways")
+		result.Requeue = true
+	}
+
 	err = r.deployer.DeployObjs(ctx, objs)
 	if err != nil {
 		return result, err
 	}
 
+	// the optional objects are only rendered when configured in the GatewayParameters,
+	// and the proxy workload changes kind when the workload kind changes, so remove
+	// the ones that are no longer rendered
+	err = r.deployer.DeleteStaleObjs(ctx, &gw, objs, optionalProxyGVKs)
+	if err != nil {
+		return result, err
+	}
+
 	return result, nil
 }
 
-func updateStatus(ctx context.Context, cli client.Client, gw *api.Gateway, svcmd *metav1.ObjectMeta) error {
-	var svc *corev1.Service
-	if svcmd != nil {
-		svcnns := client.ObjectKey{
-			Namespace: svcmd.Namespace,
-			Name:      svcmd.Name,
-		}
+var (
+	// optionalProxyGVKs are the kinds of the proxy objects that are not always rendered
+	optionalProxyGVKs = []schema.GroupVersionKind{
+		wellknown.DeploymentGVK,
+		wellknown.DaemonSetGVK,
+		wellknown.PodDisruptionBudgetGVK,
+		wellknown.HorizontalPodAutoscalerGVK,
+		wellknown.NetworkPolicyGVK,
+	}
+	// proxyGVKs are the kinds of all the proxy objects
+	proxyGVKs = append([]schema.GroupVersionKind{
+		wellknown.ServiceGVK,
+		wellknown.ServiceAccountGVK,
+		wellknown.ConfigMapGVK,
+	}, optionalProxyGVKs...)
+)
 
-		svc = &corev1.Service{}
-		if err := cli.Get(ctx, svcnns, svc); err != nil {
-			return client.IgnoreNotFound(err)
-		}
+// reconcileMergedGateway removes the proxy that gw had before it was merged into target,
+// and reports the addresses of the proxy of target in the status of gw.
+func (r *gatewayReconciler) reconcileMergedGateway(ctx context.Context, gw, target *api.Gateway) (ctrl.Result, error) {
+	if err := r.deployer.DeleteStaleObjs(ctx, gw, nil, proxyGVKs); err != nil {
+		return ctrl.Result{}, err
+	}
 
-		// make sure we own this service
-		controller := metav1.GetControllerOf(svc)
-		if controller == nil {
-			return nil
-		}
+	svc, err := getControlledService(ctx, r.cli, target)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := updateGatewayAddresses(ctx, r.cli, client.ObjectKeyFromObject(gw), getDesiredAddresses(gw, svc)); err != nil {
+		log.FromContext(ctx).Error(err, "failed to update status")
+		return ctrl.Result{Requeue: true}, nil
+	}
+	return ctrl.Result{}, nil
+}
 
-		if gw.UID != controller.UID {
-			return nil
-		}
+func updateStatus(ctx context.Context, cli client.Client, gw *api.Gateway, svcmd *metav1.ObjectMeta) error {
+	svc, ok, err := getOwnedService(ctx, cli, gw, svcmd)
+	if err != nil || !ok {
+		return err
 	}
 
 	// update gateway addresses in the status
@@ -156,6 +213,75 @@ func updateStatus(ctx context.Context, cli client.Client, gw *api.Gateway, svcmd
 	return updateGatewayAddresses(ctx, cli, client.ObjectKeyFromObject(gw), desiredAddresses)
 }
 
+// updateMergedGatewaysStatus updates the addresses of the gateways merged into gw
+// with the addresses of its service.
+func updateMergedGatewaysStatus(ctx context.Context, cli client.Client, gw *api.Gateway, svcmd *metav1.ObjectMeta) error {
+	svc, ok, err := getOwnedService(ctx, cli, gw, svcmd)
+	if err != nil || !ok {
+		return err
+	}
+
+	var gws api.GatewayList
+	if err := cli.List(ctx, &gws, client.InNamespace(gw.Namespace)); err != nil {
+		return err
+	}
+	var errs []error
+	for i := range gws.Items {
+		mergedGw := &gws.Items[i]
+		if !utils.CanMergeInto(mergedGw, gw) {
+			continue
+		}
+		desiredAddresses := getDesiredAddresses(mergedGw, svc)
+		if err := updateGatewayAddresses(ctx, cli, client.ObjectKeyFromObject(mergedGw), desiredAddresses); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getOwnedService returns the service described by svcmd, or nil if svcmd is nil, and
+// whether it may be used for the addresses of gw, i.e. it exists and is controlled by gw.
+func getOwnedService(ctx context.Context, cli client.Client, gw *api.Gateway, svcmd *metav1.ObjectMeta) (*corev1.Service, bool, error) {
+	if svcmd == nil {
+		return nil, true, nil
+	}
+
+	svcnns := client.ObjectKey{
+		Namespace: svcmd.Namespace,
+		Name:      svcmd.Name,
+	}
+
+	svc := &corev1.Service{}
+	if err := cli.Get(ctx, svcnns, svc); err != nil {
+		return nil, false, client.IgnoreNotFound(err)
+	}
+
+	// make sure we own this service
+	controller := metav1.GetControllerOf(svc)
+	if controller == nil {
+		return nil, false, nil
+	}
+
+	if gw.UID != controller.UID {
+		return nil, false, nil
+	}
+	return svc, true, nil
+}
+
+// getControlledService returns the service controlled by gw, or nil if there is none.
+func getControlledService(ctx context.Context, cli client.Client, gw *api.Gateway) (*corev1.Service, error) {
+	var svcs corev1.ServiceList
+	if err := cli.List(ctx, &svcs, client.InNamespace(gw.Namespace)); err != nil {
+		return nil, err
+	}
+	for i := range svcs.Items {
+		if metav1.IsControlledBy(&svcs.Items[i], gw) {
+			return &svcs.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
 func getDesiredAddresses(gw *api.Gateway, svc *corev1.Service) []api.GatewayStatusAddress {
 	var ret []api.GatewayStatusAddress
 	seen := sets.New[api.GatewayStatusAddress]()
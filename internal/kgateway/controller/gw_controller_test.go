@@ -7,11 +7,14 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	api "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/test/gomega/assertions"
 )
 
@@ -105,4 +108,63 @@ var _ = Describe("GwController", func() {
 		Entry("alternative gateway class", altGatewayClassName),
 		Entry("self managed gateway", selfManagedGatewayClassName),
 	)
+
+	It("should report the overlays of the GatewayParameters for all of its gateways", func() {
+		gwp := &v1alpha1.GatewayParameters{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gwp-overlays",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.GatewayParametersSpec{
+				Kube: &v1alpha1.KubernetesProxyConfig{
+					Overlays: []v1alpha1.ObjectOverlay{{
+						Kind: "Deployment",
+						Name: ptr.To("gw-overlays-b"),
+						Patches: []v1alpha1.OverlayPatch{{
+							Type:  ptr.To(v1alpha1.OverlayPatchTypeJSONPatch),
+							Patch: `[{"op": "remove", "path": "/spec/doesNotExist"}]`,
+						}},
+					}},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, gwp)).To(Succeed())
+
+		for _, name := range []string{"gw-overlays-a", "gw-overlays-b"} {
+			gw := &api.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "default",
+				},
+				Spec: api.GatewaySpec{
+					GatewayClassName: gatewayClassName,
+					Infrastructure: &api.GatewayInfrastructure{
+						ParametersRef: &api.LocalParametersReference{
+							Group: api.Group(wellknown.GatewayParametersGVK.Group),
+							Kind:  api.Kind(wellknown.GatewayParametersGVK.Kind),
+							Name:  gwp.Name,
+						},
+					},
+					Listeners: []api.Listener{{
+						Protocol: "HTTP",
+						Port:     80,
+						Name:     "listener",
+					}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, gw)).To(Succeed())
+		}
+
+		// the overlay only fails for one of the gateways, which doesn't flip the condition back
+		// when the other one is reconciled
+		Eventually(func(g Gomega) {
+			var latest v1alpha1.GatewayParameters
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(gwp), &latest)).To(Succeed())
+			cond := apimeta.FindStatusCondition(latest.Status.Conditions, v1alpha1.GatewayParametersConditionOverlaysApplied)
+			g.Expect(cond).NotTo(BeNil())
+			g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(cond.Reason).To(Equal(v1alpha1.GatewayParametersReasonInvalidOverlay))
+			g.Expect(cond.Message).To(ContainSubstring("for 1 of 2 Gateways: default/gw-overlays-b"))
+		}, timeout, interval).Should(Succeed())
+	})
 })
@@ -29,6 +29,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/metrics"
 	krtinternal "github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
 	agentgatewayplugins "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/plugins"
 	"github.com/kgateway-dev/kgateway/v2/pkg/deployer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
@@ -54,6 +55,10 @@ type SetupOpts struct {
 	// static set of global Settings
 	GlobalSettings *settings.Settings
 
+	// XdsCertProvider provides the certificate of the xDS server, nil when the xDS server
+	// is not served over TLS.
+	XdsCertProvider xds.CertProvider
+
 	PprofBindAddress       string
 	HealthProbeBindAddress string
 	MetricsBindAddress     string
@@ -82,6 +87,8 @@ type StartConfig struct {
 	CommonCollections *common.CommonCollections
 	AugmentedPods     krt.Collection[krtcollections.LocalityPod]
 	UniqueClients     krt.Collection[ir.UniqlyConnectedClient]
+	// XdsStreams are the config accepted and rejected by the proxies connected to this control plane.
+	XdsStreams krt.Collection[krtcollections.XdsStream]
 
 	KrtOptions krtinternal.KrtOptions
 }
@@ -155,6 +162,7 @@ func NewControllerBuilder(ctx context.Context, cfg StartConfig) (*ControllerBuil
 		cfg.Manager,
 		cfg.Client,
 		cfg.UniqueClients,
+		cfg.XdsStreams,
 		mergedPlugins,
 		cfg.CommonCollections,
 		cfg.SetupOpts.Cache,
@@ -277,16 +285,26 @@ func (c *ControllerBuilder) Build(ctx context.Context) error {
 	globalSettings := c.cfg.SetupOpts.GlobalSettings
 
 	xdsHost := globalSettings.XdsServiceHost
+	xdsNamespace := ""
 	if xdsHost == "" {
+		xdsNamespace = namespaces.GetPodNamespace()
 		xdsHost = kubeutils.ServiceFQDN(metav1.ObjectMeta{
 			Name:      globalSettings.XdsServiceName,
-			Namespace: namespaces.GetPodNamespace(),
+			Namespace: xdsNamespace,
 		})
 	}
 
 	xdsPort := globalSettings.XdsServicePort
 	slog.Info("got xds address for deployer", "xds_host", xdsHost, "xds_port", xdsPort)
 
+	var xdsTLS *deployer.XdsTLSInfo
+	if p := c.cfg.SetupOpts.XdsCertProvider; p != nil {
+		xdsTLS = &deployer.XdsTLSInfo{
+			CACert: p.CACert,
+			Auth:   globalSettings.EnableXdsAuth,
+		}
+	}
+
 	istioAutoMtlsEnabled := globalSettings.EnableIstioAutoMtls
 
 	gwCfg := GatewayConfig{
@@ -294,8 +312,11 @@ func (c *ControllerBuilder) Build(ctx context.Context) error {
 		ControllerName: c.cfg.ControllerName,
 		AutoProvision:  AutoProvision,
 		ControlPlane: deployer.ControlPlaneInfo{
-			XdsHost: xdsHost,
-			XdsPort: xdsPort,
+			XdsHost:      xdsHost,
+			XdsPort:      xdsPort,
+			XdsNamespace: xdsNamespace,
+			XdsTLS:       xdsTLS,
+			XdsDelta:     globalSettings.EnableDeltaXds,
 		},
 		IstioAutoMtlsEnabled: istioAutoMtlsEnabled,
 		ImageInfo: &deployer.ImageInfo{
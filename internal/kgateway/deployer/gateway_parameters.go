@@ -8,6 +8,7 @@ import (
 
 	"helm.sh/helm/v3/pkg/chart"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	api "sigs.k8s.io/gateway-api/apis/v1"
@@ -52,14 +53,38 @@ func LoadGatewayChart() (*chart.Chart, error) {
 }
 
 func GatewayGVKsToWatch(ctx context.Context, d *deployer.Deployer) ([]schema.GroupVersionKind, error) {
-	return d.GetGvksToWatch(ctx, map[string]any{
-		"gateway": map[string]any{
-			"istio": map[string]any{
-				"enabled": false,
+	// the proxy runs in either a Deployment or a DaemonSet, so render the chart with
+	// each workload kind to get the GVKs of both
+	var ret []schema.GroupVersionKind
+	for _, workloadKind := range []v1alpha1.WorkloadKind{v1alpha1.WorkloadKindDeployment, v1alpha1.WorkloadKindDaemonSet} {
+		gvks, err := d.GetGvksToWatch(ctx, map[string]any{
+			"gateway": map[string]any{
+				"istio": map[string]any{
+					"enabled": false,
+				},
+				"image":        map[string]any{},
+				"workloadKind": string(workloadKind),
+				// the PodDisruptionBudget, HorizontalPodAutoscaler and NetworkPolicy are
+				// optional, so they must be enabled for their GVKs to be rendered
+				"podDisruptionBudget": map[string]any{
+					"maxUnavailable": 1,
+				},
+				"horizontalPodAutoscaler": map[string]any{
+					"maxReplicas": 1,
+				},
+				"networkPolicy": map[string]any{},
 			},
-			"image": map[string]any{},
-		},
-	})
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, gvk := range gvks {
+			if !slices.Contains(ret, gvk) {
+				ret = append(ret, gvk)
+			}
+		}
+	}
+	return ret, nil
 }
 
 func (gp *GatewayParameters) AllKnownGatewayParameters() []client.Object {
@@ -90,6 +115,47 @@ func (gp *GatewayParameters) GetValues(ctx context.Context, obj client.Object) (
 	return newKGatewayParameters(gp.cli, gp.inputs).GetValues(ctx, gw)
 }
 
+// GetOverlays returns the overlays of the GatewayParameters of the GatewayClass and of the Gateway.
+// The overlays are only supported by the kgateway GatewayParameters.
+func (gp *GatewayParameters) GetOverlays(ctx context.Context, obj client.Object) ([]deployer.Overlays, error) {
+	gw, ok := obj.(*api.Gateway)
+	if !ok {
+		return nil, fmt.Errorf("expected a Gateway resource, got %s", obj.GetObjectKind().GroupVersionKind().String())
+	}
+
+	ref, err := gp.getGatewayParametersGK(ctx, gw)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := gp.extraHVGenerators[ref]; ok {
+		return nil, nil
+	}
+
+	return newKGatewayParameters(gp.cli, gp.inputs).getOverlays(ctx, gw)
+}
+
+// HasNetworkPolicy returns whether the resolved GatewayParameters of the Gateway deploy a
+// NetworkPolicy for its proxy. The values of extra GatewayParameters are opaque, so they
+// are assumed to deploy one.
+func (gp *GatewayParameters) HasNetworkPolicy(ctx context.Context, gw *api.Gateway) (bool, error) {
+	ref, err := gp.getGatewayParametersGK(ctx, gw)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := gp.extraHVGenerators[ref]; ok {
+		return true, nil
+	}
+
+	gwParam, err := newKGatewayParameters(gp.cli, gp.inputs).getGatewayParametersForGateway(ctx, gw)
+	if err != nil {
+		return false, err
+	}
+	if gwParam == nil || gwParam.Spec.SelfManaged != nil {
+		return false, nil
+	}
+	return gwParam.Spec.GetKube().GetNetworkPolicy() != nil, nil
+}
+
 func GatewayReleaseNameAndNamespace(obj client.Object) (string, string) {
 	return obj.GetName(), obj.GetNamespace()
 }
@@ -193,6 +259,48 @@ func (k *kGatewayParameters) getGatewayParametersForGateway(ctx context.Context,
 	return mergedGwp, nil
 }
 
+// getOverlays returns the overlays of the GatewayParameters of the GatewayClass of the
+// Gateway, followed by those of the GatewayParameters of the Gateway itself.
+func (k *kGatewayParameters) getOverlays(ctx context.Context, gw *api.Gateway) ([]deployer.Overlays, error) {
+	var ret []deployer.Overlays
+
+	gwc, err := getGatewayClassFromGateway(ctx, k.cli, gw)
+	if err != nil {
+		return nil, err
+	}
+	if ref := gwc.Spec.ParametersRef; ref != nil && ref.Name != "" {
+		gwpNamespace := ""
+		if ref.Namespace != nil {
+			gwpNamespace = string(*ref.Namespace)
+		}
+		gwp := &v1alpha1.GatewayParameters{}
+		if err := k.cli.Get(ctx, client.ObjectKey{Namespace: gwpNamespace, Name: ref.Name}, gwp); err != nil {
+			return nil, deployer.GetGatewayParametersError(err, gwpNamespace, ref.Name, gwc.GetNamespace(), gwc.GetName(), "GatewayClass")
+		}
+		ret = appendOverlays(ret, gwp)
+	}
+
+	if gw.Spec.Infrastructure != nil && gw.Spec.Infrastructure.ParametersRef != nil {
+		gwpName := gw.Spec.Infrastructure.ParametersRef.Name
+		gwp := &v1alpha1.GatewayParameters{}
+		if err := k.cli.Get(ctx, client.ObjectKey{Namespace: gw.GetNamespace(), Name: gwpName}, gwp); err != nil {
+			return nil, deployer.GetGatewayParametersError(err, gw.GetNamespace(), gwpName, gw.GetNamespace(), gw.GetName(), "Gateway")
+		}
+		ret = appendOverlays(ret, gwp)
+	}
+
+	return ret, nil
+}
+
+// appendOverlays appends the overlays of the GatewayParameters, if it has any.
+func appendOverlays(overlays []deployer.Overlays, gwp *v1alpha1.GatewayParameters) []deployer.Overlays {
+	gwpOverlays := gwp.Spec.GetKube().GetOverlays()
+	if len(gwpOverlays) == 0 {
+		return overlays
+	}
+	return append(overlays, deployer.Overlays{Source: gwp, Overlays: gwpOverlays})
+}
+
 // gets the default GatewayParameters associated with the GatewayClass of the provided Gateway
 func (k *kGatewayParameters) getDefaultGatewayParameters(ctx context.Context, gw *api.Gateway) (*v1alpha1.GatewayParameters, error) {
 	gwc, err := getGatewayClassFromGateway(ctx, k.cli, gw)
@@ -261,11 +369,22 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 			Xds: &deployer.HelmXds{
 				// The xds host/port MUST map to the Service definition for the Control Plane
 				// This is the socket address that the Proxy will connect to on startup, to receive xds updates
-				Host: &k.inputs.ControlPlane.XdsHost,
-				Port: &k.inputs.ControlPlane.XdsPort,
+				Host:  &k.inputs.ControlPlane.XdsHost,
+				Port:  &k.inputs.ControlPlane.XdsPort,
+				Delta: &k.inputs.ControlPlane.XdsDelta,
 			},
 		},
 	}
+	if xdsTLS := k.inputs.ControlPlane.XdsTLS; xdsTLS != nil {
+		vals.Gateway.Xds.Tls = &deployer.HelmXdsTls{
+			CaCert: ptr.To(string(xdsTLS.CACert())),
+		}
+		if xdsTLS.Auth {
+			vals.Gateway.Xds.Auth = &deployer.HelmXdsAuth{
+				TokenAudience: ptr.To(wellknown.XdsTokenAudience),
+			}
+		}
+	}
 
 	// if there is no GatewayParameters, return the values as is
 	if gwParam == nil {
@@ -284,10 +403,13 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 
 	kubeProxyConfig := gwParam.Spec.Kube
 	deployConfig := kubeProxyConfig.GetDeployment()
+	daemonSetConfig := kubeProxyConfig.GetDaemonSet()
 	podConfig := kubeProxyConfig.GetPodTemplate()
 	envoyContainerConfig := kubeProxyConfig.GetEnvoyContainer()
 	svcConfig := kubeProxyConfig.GetService()
 	svcAccountConfig := kubeProxyConfig.GetServiceAccount()
+	pdbConfig := kubeProxyConfig.GetPodDisruptionBudget()
+	hpaConfig := kubeProxyConfig.GetHorizontalPodAutoscaler()
 	istioConfig := kubeProxyConfig.GetIstio()
 
 	sdsContainerConfig := kubeProxyConfig.GetSdsContainer()
@@ -297,10 +419,23 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 	agentGatewayConfig := kubeProxyConfig.GetAgentGateway()
 
 	gateway := vals.Gateway
+	// workload values
+	if workloadKind := kubeProxyConfig.GetWorkloadKind(); workloadKind != nil {
+		gateway.WorkloadKind = ptr.To(string(*workloadKind))
+	}
 	// deployment values
-	if deployConfig.GetOmitReplicas() != nil && *deployConfig.GetOmitReplicas() {
+	if deployer.IsDaemonSet(kubeProxyConfig) {
+		// A daemonset runs one pod per node, so neither replicas nor an HPA apply
+		gateway.ReplicaCount = nil
+		hpaConfig = nil
+		gateway.HostNetwork = daemonSetConfig.GetHostNetwork()
+		gateway.HostPort = daemonSetConfig.GetHostPort()
+	} else if deployConfig.GetOmitReplicas() != nil && *deployConfig.GetOmitReplicas() {
 		// Don't set replica count - let HPA (if applied) handle it
 		gateway.ReplicaCount = nil
+	} else if hpaConfig != nil {
+		// Don't set replica count - the HPA we deploy handles it
+		gateway.ReplicaCount = nil
 	} else {
 		// Use the specified replica count
 		gateway.ReplicaCount = deployConfig.GetReplicas()
@@ -310,6 +445,14 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 	gateway.Service = deployer.GetServiceValues(svcConfig)
 	// serviceaccount values
 	gateway.ServiceAccount = deployer.GetServiceAccountValues(svcAccountConfig)
+	// poddisruptionbudget and horizontalpodautoscaler values
+	gateway.PodDisruptionBudget = deployer.GetPodDisruptionBudgetValues(pdbConfig)
+	gateway.HorizontalPodAutoscaler = deployer.GetHorizontalPodAutoscalerValues(hpaConfig)
+	// networkpolicy values, only look up the backends of the Gateway when a NetworkPolicy is deployed
+	if npConfig := kubeProxyConfig.GetNetworkPolicy(); npConfig != nil {
+		backendNamespaces := deployer.GetServiceBackendNamespaces(irGW, k.inputs.CommonCollections)
+		gateway.NetworkPolicy = deployer.GetNetworkPolicyValues(npConfig, k.inputs.ControlPlane.XdsNamespace, backendNamespaces)
+	}
 	// pod template values
 	gateway.ExtraPodAnnotations = podConfig.GetExtraAnnotations()
 	gateway.ExtraPodLabels = podConfig.GetExtraLabels()
@@ -336,6 +479,9 @@ func (k *kGatewayParameters) getValues(gw *api.Gateway, gwParam *v1alpha1.Gatewa
 
 	agentgatewayEnabled := agentGatewayConfig.GetEnabled()
 	if agentgatewayEnabled != nil && *agentgatewayEnabled {
+		if vals.Gateway.Xds.Tls != nil {
+			return nil, deployer.AgentGatewayXdsTLSErr
+		}
 		gateway.Resources = agentGatewayConfig.GetResources()
 		gateway.SecurityContext = agentGatewayConfig.GetSecurityContext()
 		gateway.Image = deployer.GetImageValues(agentGatewayConfig.GetImage())
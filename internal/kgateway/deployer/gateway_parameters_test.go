@@ -106,6 +106,57 @@ func TestShouldUseExtendedGatewayParameters(t *testing.T) {
 	assert.Contains(t, vals, "testHelmValuesGenerator")
 }
 
+func TestHasNetworkPolicy(t *testing.T) {
+	gwc := defaultGatewayClass()
+	gwParams := emptyGatewayParameters()
+	npGwParams := &gw2_v1alpha1.GatewayParameters{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "network-policy",
+			Namespace: defaultNamespace,
+		},
+		Spec: gw2_v1alpha1.GatewayParametersSpec{
+			Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+				NetworkPolicy: &gw2_v1alpha1.NetworkPolicy{},
+			},
+		},
+	}
+
+	newGateway := func(params string) *api.Gateway {
+		gw := &api.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: defaultNamespace,
+			},
+			Spec: api.GatewaySpec{
+				GatewayClassName: wellknown.DefaultGatewayClassName,
+			},
+		}
+		if params != "" {
+			gw.Spec.Infrastructure = &api.GatewayInfrastructure{
+				ParametersRef: &api.LocalParametersReference{
+					Group: gw2_v1alpha1.GroupName,
+					Kind:  api.Kind(wellknown.GatewayParametersGVK.Kind),
+					Name:  params,
+				},
+			}
+		}
+		return gw
+	}
+
+	gwp := NewGatewayParameters(newFakeClientWithObjs(gwc, gwParams, npGwParams), defaultInputs(t, gwc))
+
+	hasNetworkPolicy, err := gwp.HasNetworkPolicy(context.Background(), newGateway(""))
+	assert.NoError(t, err)
+	assert.False(t, hasNetworkPolicy)
+
+	hasNetworkPolicy, err = gwp.HasNetworkPolicy(context.Background(), newGateway("network-policy"))
+	assert.NoError(t, err)
+	assert.True(t, hasNetworkPolicy)
+
+	_, err = gwp.HasNetworkPolicy(context.Background(), newGateway("missing"))
+	assert.Error(t, err)
+}
+
 func TestGatewayGVKsToWatch(t *testing.T) {
 	gwc := defaultGatewayClass()
 	gwParams := emptyGatewayParameters()
@@ -117,12 +168,16 @@ func TestGatewayGVKsToWatch(t *testing.T) {
 
 	gvks, err := GatewayGVKsToWatch(context.TODO(), d)
 	assert.NoError(t, err)
-	assert.Len(t, gvks, 4)
+	assert.Len(t, gvks, 8)
 	assert.ElementsMatch(t, gvks, []schema.GroupVersionKind{
 		wellknown.DeploymentGVK,
+		wellknown.DaemonSetGVK,
 		wellknown.ServiceGVK,
 		wellknown.ServiceAccountGVK,
 		wellknown.ConfigMapGVK,
+		wellknown.PodDisruptionBudgetGVK,
+		wellknown.HorizontalPodAutoscalerGVK,
+		wellknown.NetworkPolicyGVK,
 	})
 }
 
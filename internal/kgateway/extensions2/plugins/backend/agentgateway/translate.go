@@ -146,6 +146,24 @@ func buildAIIr(krtctx krt.HandlerContext, be *v1alpha1.Backend, secrets *krtcoll
 			},
 		}
 		auth = buildTranslatedAuthPolicy(krtctx, &llm.Provider.VertexAI.AuthToken, secrets, be.Namespace)
+	} else if llm.Provider.SelfHosted != nil {
+		selfHosted := llm.Provider.SelfHosted
+		if ptr.Deref(selfHosted.API, v1alpha1.SelfHostedAPIOpenAI) != v1alpha1.SelfHostedAPIOpenAI {
+			return nil, fmt.Errorf("the %s API of self-hosted LLM providers is not supported by agentgateway", *selfHosted.API)
+		}
+		// self-hosted servers serve the OpenAI-compatible API from the Service of the server
+		openai := &api.AIBackend_OpenAI{}
+		if selfHosted.Model != nil {
+			openai.Model = &wrappers.StringValue{Value: *selfHosted.Model}
+		}
+		aiBackend.Provider = &api.AIBackend_Openai{
+			Openai: openai,
+		}
+		aiBackend.Override = &api.AIBackend_Override{
+			Host: kubeutils.GetServiceHostname(selfHosted.Service.Name, be.Namespace),
+			Port: selfHosted.Service.Port,
+		}
+		auth = buildTranslatedAuthPolicy(krtctx, selfHosted.AuthToken, secrets, be.Namespace)
 	} else if llm.Provider.Bedrock != nil {
 		model := &wrappers.StringValue{
 			Value: llm.Provider.Bedrock.Model,
@@ -568,6 +568,63 @@ func TestBuildAIBackendIr(t *testing.T) {
 					aiIr.AuthPolicy.GetKey().Secret == "first-token"
 			},
 		},
+		{
+			name: "Self-hosted backend without auth",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vllm-backend",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeAI,
+					AI: &v1alpha1.AIBackend{
+						LLM: &v1alpha1.LLMProvider{
+							Provider: v1alpha1.SupportedLLMProvider{
+								SelfHosted: &v1alpha1.SelfHostedConfig{
+									Service: v1alpha1.SelfHostedService{Name: "vllm", Port: 8000},
+									Model:   stringPtr("llama-3.1-8b"),
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets:     nil,
+			expectError: false,
+			validate: func(aiIr *AIIr) bool {
+				return aiIr != nil &&
+					aiIr.Name == "test-ns/vllm-backend" &&
+					aiIr.Backend.GetOpenai() != nil &&
+					aiIr.Backend.GetOpenai().Model.Value == "llama-3.1-8b" &&
+					aiIr.Backend.GetOverride().GetHost() == "vllm.test-ns.svc.cluster.local" &&
+					aiIr.Backend.GetOverride().GetPort() == 8000 &&
+					aiIr.AuthPolicy == nil
+			},
+		},
+		{
+			name: "Error case - self-hosted Ollama API",
+			backend: &v1alpha1.Backend{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ollama-backend",
+					Namespace: "test-ns",
+				},
+				Spec: v1alpha1.BackendSpec{
+					Type: v1alpha1.BackendTypeAI,
+					AI: &v1alpha1.AIBackend{
+						LLM: &v1alpha1.LLMProvider{
+							Provider: v1alpha1.SupportedLLMProvider{
+								SelfHosted: &v1alpha1.SelfHostedConfig{
+									Service: v1alpha1.SelfHostedService{Name: "ollama", Port: 11434},
+									API:     ptr.To(v1alpha1.SelfHostedAPIOllama),
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets:     nil,
+			expectError: true,
+		},
 		{
 			name: "Error case - nil AI spec",
 			backend: &v1alpha1.Backend{
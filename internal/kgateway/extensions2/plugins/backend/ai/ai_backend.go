@@ -3,9 +3,11 @@ package ai
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"os"
+	"strings"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
@@ -13,6 +15,7 @@ import (
 	envoytransformation "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/trafficpolicy"
@@ -26,6 +29,8 @@ type IR struct {
 	AIMultiSecret  map[string]*ir.Secret
 	Transformation *envoytransformation.RouteTransformations
 	Extproc        *envoy_ext_proc_v3.ExtProcPerRoute
+	// RetryPolicy fails requests over across the priorities of a MultiPool backend
+	RetryPolicy *envoyroutev3.RetryPolicy
 }
 
 func (i *IR) Equals(otherAIIr *IR) bool {
@@ -53,6 +58,9 @@ func (i *IR) Equals(otherAIIr *IR) bool {
 		if !proto.Equal(i.Transformation, otherAIIr.Transformation) {
 			return false
 		}
+		if !proto.Equal(i.RetryPolicy, otherAIIr.RetryPolicy) {
+			return false
+		}
 	}
 	return true
 }
@@ -88,6 +96,15 @@ func ApplyAIBackend(ir *IR, pCtx *ir.RouteBackendContext, out *envoyroutev3.Rout
 		AutoHostRewrite: wrapperspb.Bool(true),
 	}
 
+	if ir.RetryPolicy != nil {
+		// Backends are applied before route policies, which only set a retry policy if there is none,
+		// so the failover retry policy is used in place of any retry policy of the route
+		if out.GetRoute().GetRetryPolicy() == nil {
+			out.GetRoute().RetryPolicy = proto.Clone(ir.RetryPolicy).(*envoyroutev3.RetryPolicy)
+		}
+		out.ResponseHeadersToAdd = append(out.GetResponseHeadersToAdd(), providerResponseHeader())
+	}
+
 	return nil
 }
 
@@ -128,35 +145,64 @@ func PreprocessAIBackend(ctx context.Context, aiBackend *v1alpha1.AIBackend, ir
 		llmProvider = k
 	}
 
+	apiFormat, err := getAPIFormat(aiBackend)
+	if err != nil {
+		return err
+	}
+
 	// We only want to add the transformation filter if we have a single AI backend
 	// Otherwise we already have the transformation filter added by the weighted destination.
-	transformation := createTransformationTemplate(aiBackend)
-	routeTransformation := &envoytransformation.RouteTransformations_RouteTransformation{
-		Match: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch_{
-			RequestMatch: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{
-				RequestTransformation: &envoytransformation.Transformation{
-					// Set this env var to true to log the request/response info for each transformation
-					LogRequestResponseInfo: wrapperspb.Bool(os.Getenv(trafficpolicy.AiDebugTransformations) == "true"),
-					TransformationType: &envoytransformation.Transformation_TransformationTemplate{
-						TransformationTemplate: transformation,
-					},
-				},
-			},
-		},
-	}
 	// Sets the transformation for the backend. Can be updated in a route policy is attached.
-	transformations := &envoytransformation.RouteTransformations{
-		Transformations: []*envoytransformation.RouteTransformations_RouteTransformation{routeTransformation},
+	// The first matching transformation is applied, so the transformations of the endpoints
+	// served by the provider come before the chat completions one, which matches all requests.
+	transformations := &envoytransformation.RouteTransformations{}
+	var endpoints []string
+	for _, endpoint := range trafficpolicy.AIEndpoints {
+		transformation := createEndpointTransformationTemplate(aiBackend, endpoint)
+		if transformation == nil {
+			continue
+		}
+		endpoints = append(endpoints, string(endpoint))
+		transformations.Transformations = append(transformations.GetTransformations(),
+			newRouteTransformation(trafficpolicy.AIEndpointMatch(endpoint), transformation))
 	}
+	transformations.Transformations = append(transformations.GetTransformations(),
+		newRouteTransformation(nil, createTransformationTemplate(aiBackend)))
 	// Store transformations in IR
 	ir.Transformation = transformations
 
+	if aiBackend.MultiPool != nil {
+		retryPolicy, err := buildFailoverRetryPolicy(aiBackend.MultiPool.Failover)
+		if err != nil {
+			return err
+		}
+		ir.RetryPolicy = retryPolicy
+	}
+
 	extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
 		&envoycorev3.HeaderValue{
 			Key:   "x-llm-provider",
 			Value: llmProvider,
 		},
 	)
+	// The ai extension translates OpenAI requests to the provider format, and the responses back
+	if apiFormat == v1alpha1.APIFormatOpenAI {
+		extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
+			&envoycorev3.HeaderValue{
+				Key:   "x-llm-api-format",
+				Value: "openai",
+			},
+		)
+	}
+	// The ai extension rejects requests to the endpoints the provider does not serve
+	if len(endpoints) > 0 {
+		extProcRouteSettings.GetOverrides().GrpcInitialMetadata = append(extProcRouteSettings.GetOverrides().GetGrpcInitialMetadata(),
+			&envoycorev3.HeaderValue{
+				Key:   "x-llm-endpoints",
+				Value: strings.Join(endpoints, ","),
+			},
+		)
+	}
 	// If the backend specifies a model, add a header to the ext-proc request
 	// TODO: add support for multi pool setting different models for different pools
 	if llmModel != "" {
@@ -175,6 +221,11 @@ func PreprocessAIBackend(ctx context.Context, aiBackend *v1alpha1.AIBackend, ir
 			Key:   "x-request-id",
 			Value: "%REQ(X-REQUEST-ID)%",
 		},
+		// The route name is used as the route label of the usage metrics
+		&envoycorev3.HeaderValue{
+			Key:   "x-route-name",
+			Value: "%ROUTE_NAME%",
+		},
 	)
 
 	// Store extproc settings in IR
@@ -183,6 +234,65 @@ func PreprocessAIBackend(ctx context.Context, aiBackend *v1alpha1.AIBackend, ir
 	return nil
 }
 
+func newRouteTransformation(
+	match *envoyroutev3.RouteMatch,
+	transformation *envoytransformation.TransformationTemplate,
+) *envoytransformation.RouteTransformations_RouteTransformation {
+	return &envoytransformation.RouteTransformations_RouteTransformation{
+		Match: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch_{
+			RequestMatch: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{
+				Match: match,
+				RequestTransformation: &envoytransformation.Transformation{
+					// Set this env var to true to log the request/response info for each transformation
+					LogRequestResponseInfo: wrapperspb.Bool(os.Getenv(trafficpolicy.AiDebugTransformations) == "true"),
+					TransformationType: &envoytransformation.Transformation_TransformationTemplate{
+						TransformationTemplate: transformation,
+					},
+				},
+			},
+		},
+	}
+}
+
+// getAPIFormat returns the API format of the LLM providers of an AI backend.
+func getAPIFormat(aiBackend *v1alpha1.AIBackend) (v1alpha1.APIFormat, error) {
+	if aiBackend.LLM != nil {
+		return getProviderAPIFormat(aiBackend.LLM)
+	}
+	var apiFormat v1alpha1.APIFormat
+	if aiBackend.MultiPool != nil {
+		for i, priority := range aiBackend.MultiPool.Priorities {
+			for j, pool := range priority.Pool {
+				poolFormat, err := getProviderAPIFormat(&pool)
+				if err != nil {
+					return "", err
+				}
+				if i == 0 && j == 0 {
+					apiFormat = poolFormat
+				} else if poolFormat != apiFormat {
+					return "", fmt.Errorf("all the providers of a multi pool must use the same API format, got %q and %q", apiFormat, poolFormat)
+				}
+			}
+		}
+	}
+	return apiFormat, nil
+}
+
+// getProviderAPIFormat returns the API format of an LLM provider. The OpenAI API format is
+// rejected for the providers whose requests the ai extension does not translate, as it would
+// either have no effect or send OpenAI requests to a provider that does not accept them.
+func getProviderAPIFormat(llm *v1alpha1.LLMProvider) (v1alpha1.APIFormat, error) {
+	apiFormat := ptr.Deref(llm.APIFormat, "")
+	if apiFormat != v1alpha1.APIFormatOpenAI {
+		return apiFormat, nil
+	}
+	provider := llm.Provider
+	if provider.Anthropic == nil && provider.Gemini == nil && provider.VertexAI == nil {
+		return "", errors.New("the OpenAI API format is only supported for the anthropic, gemini and vertexai providers")
+	}
+	return apiFormat, nil
+}
+
 func getBackendModel(llm *v1alpha1.LLMProvider, byType map[string]struct{}) string {
 	llmModel := ""
 	provider := llm.Provider
@@ -205,6 +315,16 @@ func getBackendModel(llm *v1alpha1.LLMProvider, byType map[string]struct{}) stri
 	} else if provider.VertexAI != nil {
 		byType["vertex-ai"] = struct{}{}
 		llmModel = provider.VertexAI.Model
+	} else if provider.SelfHosted != nil {
+		// the OpenAI-compatible API is parsed like OpenAI
+		if ptr.Deref(provider.SelfHosted.API, v1alpha1.SelfHostedAPIOpenAI) == v1alpha1.SelfHostedAPIOllama {
+			byType["ollama"] = struct{}{}
+		} else {
+			byType["openai"] = struct{}{}
+		}
+		if provider.SelfHosted.Model != nil {
+			llmModel = *provider.SelfHosted.Model
+		}
 	} else if provider.Bedrock != nil {
 		// currently only supported in agentgateway
 		byType["bedrock"] = struct{}{}
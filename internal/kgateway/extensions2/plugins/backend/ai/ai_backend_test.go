@@ -4,13 +4,18 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	envoytransformation "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -60,6 +65,10 @@ func TestApplyAIBackend(t *testing.T) {
 									Key:   "x-llm-provider",
 									Value: "openai",
 								},
+								{
+									Key:   "x-llm-endpoints",
+									Value: "embeddings,image_generation,audio_transcription",
+								},
 								{
 									Key:   "x-llm-model",
 									Value: "gpt-3",
@@ -68,13 +77,17 @@ func TestApplyAIBackend(t *testing.T) {
 									Key:   "x-request-id",
 									Value: "%REQ(X-REQUEST-ID)%",
 								},
+								{
+									Key:   "x-route-name",
+									Value: "%ROUTE_NAME%",
+								},
 							},
 						},
 					},
 				},
 				wellknown.AIBackendTransformationFilterName: &envoytransformation.RouteTransformations{
-					Transformations: []*envoytransformation.RouteTransformations_RouteTransformation{
-						{
+					Transformations: append(expectedOpenAIEndpointTransformations("Authorization", "Bearer "),
+						&envoytransformation.RouteTransformations_RouteTransformation{
 							Match: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch_{
 								RequestMatch: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{
 									RequestTransformation: &envoytransformation.Transformation{
@@ -106,7 +119,7 @@ func TestApplyAIBackend(t *testing.T) {
 								},
 							},
 						},
-					},
+					),
 				},
 			},
 		},
@@ -143,6 +156,10 @@ func TestApplyAIBackend(t *testing.T) {
 									Key:   "x-llm-provider",
 									Value: "openai",
 								},
+								{
+									Key:   "x-llm-endpoints",
+									Value: "embeddings,image_generation,audio_transcription",
+								},
 								{
 									Key:   "x-llm-model",
 									Value: "gpt-3",
@@ -151,13 +168,17 @@ func TestApplyAIBackend(t *testing.T) {
 									Key:   "x-request-id",
 									Value: "%REQ(X-REQUEST-ID)%",
 								},
+								{
+									Key:   "x-route-name",
+									Value: "%ROUTE_NAME%",
+								},
 							},
 						},
 					},
 				},
 				wellknown.AIBackendTransformationFilterName: &envoytransformation.RouteTransformations{
-					Transformations: []*envoytransformation.RouteTransformations_RouteTransformation{
-						{
+					Transformations: append(expectedOpenAIEndpointTransformations(customHeader, customPrefix),
+						&envoytransformation.RouteTransformations_RouteTransformation{
 							Match: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch_{
 								RequestMatch: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{
 									RequestTransformation: &envoytransformation.Transformation{
@@ -189,7 +210,7 @@ func TestApplyAIBackend(t *testing.T) {
 								},
 							},
 						},
-					},
+					),
 				},
 			},
 		},
@@ -288,3 +309,195 @@ func TestApplyAIBackend(t *testing.T) {
 		})
 	}
 }
+
+// expectedOpenAIEndpointTransformations returns the transformations of the requests of an OpenAI
+// backend to the embeddings, image generation and audio transcription endpoints.
+func expectedOpenAIEndpointTransformations(headerName, prefix string) []*envoytransformation.RouteTransformations_RouteTransformation {
+	var transformations []*envoytransformation.RouteTransformations_RouteTransformation
+	for _, endpoint := range []struct {
+		regex, path string
+		body        bool
+	}{
+		{regex: ".*/embeddings", path: "/v1/embeddings", body: true},
+		{regex: ".*/images/generations", path: "/v1/images/generations", body: true},
+		{regex: ".*/audio/transcriptions", path: "/v1/audio/transcriptions"},
+	} {
+		template := &envoytransformation.TransformationTemplate{
+			Headers: map[string]*envoytransformation.InjaTemplate{
+				":path": {Text: endpoint.path},
+				headerName: {
+					Text: prefix + `{% if host_metadata("auth_token") != "" %}{{host_metadata("auth_token")}}{% else %}{{dynamic_metadata("auth_token","ai.kgateway.io")}}{% endif %}`,
+				},
+			},
+		}
+		if endpoint.body {
+			template.BodyTransformation = defaultBodyTransformation()
+		} else {
+			template.ParseBodyBehavior = envoytransformation.TransformationTemplate_DontParse
+			template.BodyTransformation = &envoytransformation.TransformationTemplate_Passthrough{
+				Passthrough: &envoytransformation.Passthrough{},
+			}
+		}
+		transformations = append(transformations, &envoytransformation.RouteTransformations_RouteTransformation{
+			Match: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch_{
+				RequestMatch: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{
+					Match: &envoyroutev3.RouteMatch{
+						PathSpecifier: &envoyroutev3.RouteMatch_SafeRegex{
+							SafeRegex: &envoy_type_matcher_v3.RegexMatcher{Regex: endpoint.regex},
+						},
+					},
+					RequestTransformation: &envoytransformation.Transformation{
+						LogRequestResponseInfo: &wrapperspb.BoolValue{},
+						TransformationType: &envoytransformation.Transformation_TransformationTemplate{
+							TransformationTemplate: template,
+						},
+					},
+				},
+			},
+		})
+	}
+	return transformations
+}
+
+func TestApplyAIBackend_MultiPoolFailover(t *testing.T) {
+	newPool := func(model string) v1alpha1.Priority {
+		return v1alpha1.Priority{
+			Pool: []v1alpha1.LLMProvider{
+				{
+					Provider: v1alpha1.SupportedLLMProvider{
+						OpenAI: &v1alpha1.OpenAIConfig{
+							Model: ptr.To(model),
+							AuthToken: v1alpha1.SingleAuthToken{
+								Kind:   v1alpha1.Inline,
+								Inline: ptr.To("token"),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	aiBackend := &v1alpha1.AIBackend{
+		MultiPool: &v1alpha1.MultiPoolConfig{
+			Priorities: []v1alpha1.Priority{newPool("gpt-4o"), newPool("gpt-4o-mini")},
+			Failover: &v1alpha1.MultiPoolFailover{
+				StatusCodes:   []int32{429, 503},
+				MaxRetries:    ptr.To[int32](2),
+				MaxRetryAfter: &metav1.Duration{Duration: 2 * time.Second},
+			},
+		},
+	}
+	apply := func(t *testing.T, out *envoyroutev3.Route) {
+		t.Helper()
+		aiIR := &IR{}
+		require.NoError(t, PreprocessAIBackend(context.Background(), aiBackend, aiIR))
+		pCtx := &ir.RouteBackendContext{
+			TypedFilterConfig: ir.TypedFilterConfigMap(map[string]proto.Message{}),
+		}
+		require.NoError(t, ApplyAIBackend(aiIR, pCtx, out))
+	}
+
+	t.Run("failover retry policy and provider header", func(t *testing.T) {
+		out := &envoyroutev3.Route{}
+		apply(t, out)
+
+		retryPolicy := out.GetRoute().GetRetryPolicy()
+		require.NotNil(t, retryPolicy)
+		require.NoError(t, retryPolicy.ValidateAll())
+		assert.Equal(t, "connect-failure,refused-stream,reset,retriable-status-codes", retryPolicy.GetRetryOn())
+		assert.Equal(t, []uint32{429, 503}, retryPolicy.GetRetriableStatusCodes())
+		assert.Equal(t, uint32(2), retryPolicy.GetNumRetries().GetValue())
+		assert.Equal(t, "envoy.retry_priorities.previous_priorities", retryPolicy.GetRetryPriority().GetName())
+		require.Len(t, retryPolicy.GetRetryHostPredicate(), 1)
+		assert.Equal(t, "envoy.retry_host_predicates.previous_hosts", retryPolicy.GetRetryHostPredicate()[0].GetName())
+		backOff := retryPolicy.GetRateLimitedRetryBackOff()
+		require.NotNil(t, backOff)
+		assert.Equal(t, "retry-after", backOff.GetResetHeaders()[0].GetName())
+		assert.Equal(t, envoyroutev3.RetryPolicy_SECONDS, backOff.GetResetHeaders()[0].GetFormat())
+		assert.Equal(t, 2*time.Second, backOff.GetMaxInterval().AsDuration())
+
+		require.Len(t, out.GetResponseHeadersToAdd(), 1)
+		header := out.GetResponseHeadersToAdd()[0].GetHeader()
+		assert.Equal(t, ProviderHeader, header.GetKey())
+		assert.Equal(t, `%UPSTREAM_METADATA(["io.solo.transformation", "provider_host"])%`, header.GetValue())
+	})
+
+	t.Run("existing retry policy is kept", func(t *testing.T) {
+		existing := &envoyroutev3.RetryPolicy{RetryOn: "5xx"}
+		out := &envoyroutev3.Route{
+			Action: &envoyroutev3.Route_Route{
+				Route: &envoyroutev3.RouteAction{RetryPolicy: existing},
+			},
+		}
+		apply(t, out)
+		assert.Same(t, existing, out.GetRoute().GetRetryPolicy())
+	})
+
+	t.Run("defaults without failover", func(t *testing.T) {
+		aiIR := &IR{}
+		require.NoError(t, PreprocessAIBackend(context.Background(), &v1alpha1.AIBackend{
+			MultiPool: &v1alpha1.MultiPoolConfig{
+				Priorities: []v1alpha1.Priority{newPool("gpt-4o")},
+			},
+		}, aiIR))
+		assert.Nil(t, aiIR.RetryPolicy)
+	})
+}
+
+func TestPreprocessAIBackend_OpenAIAPIFormat(t *testing.T) {
+	anthropic := func(apiFormat *v1alpha1.APIFormat) v1alpha1.LLMProvider {
+		return v1alpha1.LLMProvider{
+			Provider: v1alpha1.SupportedLLMProvider{
+				Anthropic: &v1alpha1.AnthropicConfig{
+					AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("token")},
+				},
+			},
+			APIFormat: apiFormat,
+		}
+	}
+	metadata := func(aiIR *IR) map[string]string {
+		out := map[string]string{}
+		for _, md := range aiIR.Extproc.GetOverrides().GetGrpcInitialMetadata() {
+			out[md.GetKey()] = md.GetValue()
+		}
+		return out
+	}
+
+	t.Run("translated provider", func(t *testing.T) {
+		llm := anthropic(ptr.To(v1alpha1.APIFormatOpenAI))
+		aiIR := &IR{}
+		require.NoError(t, PreprocessAIBackend(context.Background(), &v1alpha1.AIBackend{LLM: &llm}, aiIR))
+		assert.Equal(t, "openai", metadata(aiIR)["x-llm-api-format"])
+	})
+
+	t.Run("provider format", func(t *testing.T) {
+		llm := anthropic(nil)
+		aiIR := &IR{}
+		require.NoError(t, PreprocessAIBackend(context.Background(), &v1alpha1.AIBackend{LLM: &llm}, aiIR))
+		assert.NotContains(t, metadata(aiIR), "x-llm-api-format")
+	})
+
+	t.Run("provider without translation", func(t *testing.T) {
+		err := PreprocessAIBackend(context.Background(), &v1alpha1.AIBackend{
+			LLM: &v1alpha1.LLMProvider{
+				Provider: v1alpha1.SupportedLLMProvider{
+					Bedrock: &v1alpha1.BedrockConfig{Model: "anthropic.claude-3-haiku"},
+				},
+				APIFormat: ptr.To(v1alpha1.APIFormatOpenAI),
+			},
+		}, &IR{})
+		require.ErrorContains(t, err, "only supported for the anthropic, gemini and vertexai providers")
+	})
+
+	t.Run("multi pool with different API formats", func(t *testing.T) {
+		err := PreprocessAIBackend(context.Background(), &v1alpha1.AIBackend{
+			MultiPool: &v1alpha1.MultiPoolConfig{
+				Priorities: []v1alpha1.Priority{
+					{Pool: []v1alpha1.LLMProvider{anthropic(ptr.To(v1alpha1.APIFormatOpenAI))}},
+					{Pool: []v1alpha1.LLMProvider{anthropic(nil)}},
+				},
+			},
+		}, &IR{})
+		require.ErrorContains(t, err, "same API format")
+	})
+}
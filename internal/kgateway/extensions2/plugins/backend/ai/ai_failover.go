@@ -0,0 +1,148 @@
+package ai
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	previoushostsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	previousprioritiesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/priority/previous_priorities/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+)
+
+const (
+	// ProviderHeader is the response header that identifies the provider that served a request
+	ProviderHeader = "x-kgateway-ai-provider"
+
+	// providerHostMetadataKey is the endpoint metadata key holding the host of a MultiPool provider
+	providerHostMetadataKey = "provider_host"
+
+	defaultFailoverMaxRetries          = 1
+	defaultFailoverConsecutiveFailures = 3
+	defaultFailoverCooldown            = 30 * time.Second
+	// maxOutlierDetectionInterval is the default interval of envoy outlier detection,
+	// a shorter cooldown uses the cooldown as the interval so that providers return on time
+	maxOutlierDetectionInterval = 10 * time.Second
+)
+
+var defaultFailoverStatusCodes = []uint32{429, 500, 502, 503, 504}
+
+// buildFailoverRetryPolicy builds the route retry policy that fails requests over to the next
+// priority of a MultiPool backend.
+func buildFailoverRetryPolicy(failover *v1alpha1.MultiPoolFailover) (*envoyroutev3.RetryPolicy, error) {
+	if failover == nil {
+		return nil, nil
+	}
+
+	statusCodes := defaultFailoverStatusCodes
+	if len(failover.StatusCodes) > 0 {
+		statusCodes = make([]uint32, 0, len(failover.StatusCodes))
+		for _, code := range failover.StatusCodes {
+			statusCodes = append(statusCodes, uint32(code))
+		}
+	}
+
+	// Every retry skips the priorities that were already attempted
+	previousPriorities, err := utils.MessageToAny(&previousprioritiesv3.PreviousPrioritiesConfig{
+		UpdateFrequency: 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	previousHosts, err := utils.MessageToAny(&previoushostsv3.PreviousHostsPredicate{})
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &envoyroutev3.RetryPolicy{
+		RetryOn:              "connect-failure,refused-stream,reset,retriable-status-codes",
+		RetriableStatusCodes: statusCodes,
+		NumRetries:           wrapperspb.UInt32(uint32(ptr.Deref(failover.MaxRetries, defaultFailoverMaxRetries))),
+		RetryPriority: &envoyroutev3.RetryPolicy_RetryPriority{
+			Name: "envoy.retry_priorities.previous_priorities",
+			ConfigType: &envoyroutev3.RetryPolicy_RetryPriority_TypedConfig{
+				TypedConfig: previousPriorities,
+			},
+		},
+		RetryHostPredicate: []*envoyroutev3.RetryPolicy_RetryHostPredicate{
+			{
+				Name: "envoy.retry_host_predicates.previous_hosts",
+				ConfigType: &envoyroutev3.RetryPolicy_RetryHostPredicate_TypedConfig{
+					TypedConfig: previousHosts,
+				},
+			},
+		},
+		HostSelectionRetryMaxAttempts: 3,
+	}
+	if failover.MaxRetryAfter != nil {
+		policy.RateLimitedRetryBackOff = &envoyroutev3.RetryPolicy_RateLimitedRetryBackOff{
+			ResetHeaders: []*envoyroutev3.RetryPolicy_ResetHeader{
+				{
+					Name:   "retry-after",
+					Format: envoyroutev3.RetryPolicy_SECONDS,
+				},
+			},
+			MaxInterval: durationpb.New(failover.MaxRetryAfter.Duration),
+		}
+	}
+	return policy, nil
+}
+
+// buildFailoverOutlierDetection builds the outlier detection that ejects failing providers of a
+// MultiPool backend for the cooldown duration, which shifts their load to the next priority.
+// Only server errors and connection failures count toward ejection, as envoy outlier detection
+// does not track 429 responses; rate limited requests still fail over through the retry policy.
+func buildFailoverOutlierDetection(failover *v1alpha1.MultiPoolFailover) *envoyclusterv3.OutlierDetection {
+	if failover == nil {
+		return nil
+	}
+	consecutiveFailures := wrapperspb.UInt32(uint32(ptr.Deref(failover.ConsecutiveFailures, defaultFailoverConsecutiveFailures)))
+	cooldown := defaultFailoverCooldown
+	if failover.Cooldown != nil {
+		cooldown = failover.Cooldown.Duration
+	}
+	return &envoyclusterv3.OutlierDetection{
+		Consecutive_5Xx:                    consecutiveFailures,
+		ConsecutiveGatewayFailure:          consecutiveFailures,
+		EnforcingConsecutiveGatewayFailure: wrapperspb.UInt32(100),
+		Interval:                           durationpb.New(min(cooldown, maxOutlierDetectionInterval)),
+		BaseEjectionTime:                   durationpb.New(cooldown),
+		// Keep the cooldown constant instead of growing it with the number of ejections
+		MaxEjectionTime:    durationpb.New(cooldown),
+		MaxEjectionPercent: wrapperspb.UInt32(100),
+	}
+}
+
+// providerResponseHeader returns the response header that identifies the MultiPool provider
+// that served a request.
+func providerResponseHeader() *envoycorev3.HeaderValueOption {
+	return &envoycorev3.HeaderValueOption{
+		Header: &envoycorev3.HeaderValue{
+			Key:   ProviderHeader,
+			Value: `%UPSTREAM_METADATA(["io.solo.transformation", "` + providerHostMetadataKey + `"])%`,
+		},
+		AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+	}
+}
+
+// setProviderHostMetadata adds the host of a MultiPool provider to its endpoint metadata,
+// which is returned in the provider response header.
+func setProviderHostMetadata(ep *envoyendpointv3.LbEndpoint) {
+	socketAddress := ep.GetEndpoint().GetAddress().GetSocketAddress()
+	fields := ep.GetMetadata().GetFilterMetadata()["io.solo.transformation"].GetFields()
+	if socketAddress == nil || fields == nil {
+		return
+	}
+	host := net.JoinHostPort(socketAddress.GetAddress(), strconv.Itoa(int(socketAddress.GetPortValue())))
+	fields[providerHostMetadataKey] = structpb.NewStringValue(host)
+}
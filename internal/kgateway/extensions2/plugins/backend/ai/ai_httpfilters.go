@@ -136,7 +136,9 @@ func AddExtprocHTTPFilter() ([]plugins.StagedHttpFilter, error) {
 		MessageTimeout: durationpb.New(5 * time.Second),
 		MetadataOptions: &envoy_ext_proc_v3.MetadataOptions{
 			ForwardingNamespaces: &envoy_ext_proc_v3.MetadataOptions_MetadataNamespaces{
-				Untyped: []string{"io.solo.transformation", "envoy.filters.ai.solo.io"},
+				// the payloads of the JWTs verified by the JWT filter are used by custom
+				// labels and prompt template variables
+				Untyped: []string{"io.solo.transformation", "envoy.filters.ai.solo.io", "envoy.filters.http.jwt_authn"},
 				Typed:   []string{"envoy.filters.ai.solo.io"},
 			},
 			ReceivingNamespaces: &envoy_ext_proc_v3.MetadataOptions_MetadataNamespaces{
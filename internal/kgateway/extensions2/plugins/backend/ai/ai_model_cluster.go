@@ -12,11 +12,14 @@ import (
 	envoytransformation "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/trafficpolicy"
 	aiutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
 )
 
 const (
@@ -36,12 +39,13 @@ func tlsMatch() *structpb.Struct {
 	}
 }
 
-func ProcessAIBackend(in *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecrets map[string]*ir.Secret, out *envoyclusterv3.Cluster) error {
+// ProcessAIBackend builds the cluster of an AI backend in the given namespace.
+func ProcessAIBackend(in *v1alpha1.AIBackend, namespace string, aiSecret *ir.Secret, multiSecrets map[string]*ir.Secret, out *envoyclusterv3.Cluster) error {
 	if in == nil {
 		return nil
 	}
 
-	if err := buildModelCluster(in, aiSecret, multiSecrets, out); err != nil {
+	if err := buildModelCluster(in, namespace, aiSecret, multiSecrets, out); err != nil {
 		return err
 	}
 
@@ -52,15 +56,17 @@ func ProcessAIBackend(in *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecrets
 // This function is used by the `ProcessBackend` function to build the cluster for the AI backend.
 // It is ALSO used by `ProcessRoute` to create the cluster in the event of backup models being used
 // and fallbacks being required.
-func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecrets map[string]*ir.Secret, out *envoyclusterv3.Cluster) error {
+func buildModelCluster(aiUs *v1alpha1.AIBackend, namespace string, aiSecret *ir.Secret, multiSecrets map[string]*ir.Secret, out *envoyclusterv3.Cluster) error {
 	// set the type to strict dns to support mutli pool backends
 	out.ClusterDiscoveryType = &envoyclusterv3.Cluster_Type{
 		Type: envoyclusterv3.Cluster_STRICT_DNS,
 	}
 
-	// We are reliant on https://github.com/envoyproxy/envoy/pull/34154 to merge
-	// before we can do OutlierDetection on 429s here
-	// out.OutlierDetection = getOutlierDetectionConfig(aiUs)
+	// Envoy outlier detection does not track 429s, so rate limited providers are
+	// only failed over by the retry policy of the route, see buildFailoverRetryPolicy
+	if aiUs.MultiPool != nil && aiUs.MultiPool.Failover != nil {
+		out.OutlierDetection = buildFailoverOutlierDetection(aiUs.MultiPool.Failover)
+	}
 
 	var prioritized []*envoyendpointv3.LocalityLbEndpoints
 	var err error
@@ -109,6 +115,12 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 						secretForMultiPool = multiSecrets[GetMultiPoolSecretKey(idx, jdx, secretRef.Name)]
 					}
 					result, err = buildVertexAIEndpoint(ep.Provider.VertexAI, ep.HostOverride, secretForMultiPool)
+				} else if ep.Provider.SelfHosted != nil {
+					var secretForMultiPool *ir.Secret
+					if authToken := ep.Provider.SelfHosted.AuthToken; authToken != nil && authToken.Kind == v1alpha1.SecretRef {
+						secretForMultiPool = multiSecrets[GetMultiPoolSecretKey(idx, jdx, authToken.SecretRef.Name)]
+					}
+					result, err = buildSelfHostedEndpoint(ep.Provider.SelfHosted, namespace, ep.HostOverride, secretForMultiPool)
 				} else if ep.Provider.Bedrock != nil {
 					// currently only supported in agentgateway
 					slog.Error("bedrock on the AI backend are not supported yet, switch to agentgateway class")
@@ -116,6 +128,9 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 				if err != nil {
 					return err
 				}
+				if aiUs.MultiPool.Failover != nil {
+					setProviderHostMetadata(result)
+				}
 				eps = append(eps, result)
 			}
 			priority := idx
@@ -128,7 +143,7 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 			return fmt.Errorf("multi backend pools must all be of the same type, got %v", epByType)
 		}
 	} else if aiUs.LLM != nil {
-		prioritized, err = buildLLMEndpoint(aiUs, aiSecret)
+		prioritized, err = buildLLMEndpoint(aiUs, namespace, aiSecret)
 		if err != nil {
 			return err
 		}
@@ -176,7 +191,7 @@ func buildModelCluster(aiUs *v1alpha1.AIBackend, aiSecret *ir.Secret, multiSecre
 	return nil
 }
 
-func buildLLMEndpoint(aiUs *v1alpha1.AIBackend, aiSecrets *ir.Secret) ([]*envoyendpointv3.LocalityLbEndpoints, error) {
+func buildLLMEndpoint(aiUs *v1alpha1.AIBackend, namespace string, aiSecrets *ir.Secret) ([]*envoyendpointv3.LocalityLbEndpoints, error) {
 	var prioritized []*envoyendpointv3.LocalityLbEndpoints
 	provider := aiUs.LLM.Provider
 	if provider.OpenAI != nil {
@@ -219,6 +234,14 @@ func buildLLMEndpoint(aiUs *v1alpha1.AIBackend, aiSecrets *ir.Secret) ([]*envoye
 		prioritized = []*envoyendpointv3.LocalityLbEndpoints{
 			{LbEndpoints: []*envoyendpointv3.LbEndpoint{host}},
 		}
+	} else if provider.SelfHosted != nil {
+		host, err := buildSelfHostedEndpoint(provider.SelfHosted, namespace, aiUs.LLM.HostOverride, aiSecrets)
+		if err != nil {
+			return nil, err
+		}
+		prioritized = []*envoyendpointv3.LocalityLbEndpoints{
+			{LbEndpoints: []*envoyendpointv3.LbEndpoint{host}},
+		}
 	}
 	return prioritized, nil
 }
@@ -305,6 +328,25 @@ func buildVertexAIEndpoint(data *v1alpha1.VertexAIConfig, hostOverride *v1alpha1
 	), nil
 }
 
+// buildSelfHostedEndpoint builds the endpoint of the Service of a self-hosted LLM server.
+// The auth token is optional, as self-hosted servers usually do not require one.
+func buildSelfHostedEndpoint(data *v1alpha1.SelfHostedConfig, namespace string, hostOverride *v1alpha1.Host, aiSecrets *ir.Secret) (*envoyendpointv3.LbEndpoint, error) {
+	var token string
+	if data.AuthToken != nil {
+		var err error
+		token, err = aiutils.GetAuthToken(*data.AuthToken, aiSecrets)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buildLocalityLbEndpoint(
+		kubeutils.GetServiceHostname(data.Service.Name, namespace),
+		data.Service.Port,
+		hostOverride,
+		buildEndpointMeta(token, ptr.Deref(data.Model, ""), nil),
+	), nil
+}
+
 func buildLocalityLbEndpoint(
 	host string,
 	port int32,
@@ -382,16 +424,61 @@ func createTransformationTemplate(aiBackend *v1alpha1.AIBackend) *envoytransform
 		llmMultiPool := aiBackend.MultiPool.Priorities[0].Pool[0]
 		headerName, prefix, path, bodyTransformation = getTransformation(&llmMultiPool)
 	}
-	transformationTemplate.GetHeaders()[headerName] = &envoytransformation.InjaTemplate{
-		Text: prefix + `{% if host_metadata("auth_token") != "" %}{{host_metadata("auth_token")}}{% else %}{{dynamic_metadata("auth_token","ai.kgateway.io")}}{% endif %}`,
+	// self-hosted providers may not require auth, or keep the path of the request
+	if headerName != "" {
+		transformationTemplate.GetHeaders()[headerName] = authHeaderTemplate(prefix)
 	}
-	transformationTemplate.GetHeaders()[":path"] = &envoytransformation.InjaTemplate{
-		Text: path,
+	if path != "" {
+		transformationTemplate.GetHeaders()[":path"] = &envoytransformation.InjaTemplate{
+			Text: path,
+		}
 	}
 	transformationTemplate.BodyTransformation = bodyTransformation
 	return transformationTemplate
 }
 
+// createEndpointTransformationTemplate creates the transformation of the requests to an endpoint
+// other than chat completions, or returns nil if the provider of the backend does not serve it.
+func createEndpointTransformationTemplate(aiBackend *v1alpha1.AIBackend, endpoint trafficpolicy.AIEndpoint) *envoytransformation.TransformationTemplate {
+	llm := aiBackend.LLM
+	if llm == nil && aiBackend.MultiPool != nil {
+		// We already know that all the backends are the same type so we can just take the first one
+		llm = &aiBackend.MultiPool.Priorities[0].Pool[0]
+	}
+	if llm == nil {
+		return nil
+	}
+	headerName, prefix, path, bodyTransformation := getEndpointTransformation(llm, endpoint)
+	if path == "" {
+		return nil
+	}
+
+	transformationTemplate := &envoytransformation.TransformationTemplate{
+		Headers: map[string]*envoytransformation.InjaTemplate{
+			":path": {Text: path},
+		},
+	}
+	if headerName != "" {
+		transformationTemplate.GetHeaders()[headerName] = authHeaderTemplate(prefix)
+	}
+	if endpoint == trafficpolicy.AIEndpointAudioTranscription {
+		// audio is uploaded as a multipart form, which is sent as is
+		transformationTemplate.ParseBodyBehavior = envoytransformation.TransformationTemplate_DontParse
+		transformationTemplate.BodyTransformation = &envoytransformation.TransformationTemplate_Passthrough{
+			Passthrough: &envoytransformation.Passthrough{},
+		}
+	} else if bodyTransformation != nil {
+		transformationTemplate.BodyTransformation = bodyTransformation
+	}
+	return transformationTemplate
+}
+
+func authHeaderTemplate(prefix string) *envoytransformation.InjaTemplate {
+	return &envoytransformation.InjaTemplate{
+		Text: prefix + `{% if host_metadata("auth_token") != "" %}{{host_metadata("auth_token")}}{% else %}{{dynamic_metadata("auth_token","ai.kgateway.io")}}{% endif %}`,
+	}
+}
+
 func getTransformation(llm *v1alpha1.LLMProvider) (string, string, string, *envoytransformation.TransformationTemplate_MergeJsonKeys) {
 	headerName := "Authorization"
 	var prefix, path string
@@ -410,7 +497,7 @@ func getTransformation(llm *v1alpha1.LLMProvider) (string, string, string, *envo
 		path = `/openai/deployments/{{ host_metadata("model") }}/chat/completions?api-version={{ host_metadata("api_version" )}}`
 	} else if provider.Gemini != nil {
 		headerName = "key"
-		path = getGeminiPath()
+		path = getGeminiPath(getStreamingCondition(llm))
 	} else if provider.VertexAI != nil {
 		prefix = "Bearer "
 		var modelPath string
@@ -418,11 +505,11 @@ func getTransformation(llm *v1alpha1.LLMProvider) (string, string, string, *envo
 		if modelCall == nil {
 			switch provider.VertexAI.Publisher {
 			case v1alpha1.GOOGLE:
-				modelPath = getVertexAIGeminiModelPath()
+				modelPath = getVertexAIGeminiModelPath(getStreamingCondition(llm))
 			default:
 				// TODO(npolshak): add support for other publishers
 				slog.Warn("unsupported Vertex AI publisher, defaulting to Google", "publisher", string(provider.VertexAI.Publisher))
-				modelPath = getVertexAIGeminiModelPath()
+				modelPath = getVertexAIGeminiModelPath(getStreamingCondition(llm))
 			}
 		} else {
 			// Use user provided model path
@@ -430,6 +517,17 @@ func getTransformation(llm *v1alpha1.LLMProvider) (string, string, string, *envo
 		}
 		// https://${LOCATION}-aiplatform.googleapis.com/${VERSION}/projects/${PROJECT_ID}/locations/${LOCATION}/publishers/${PUBLISHER}/models/${MODEL}:{generateContent|streamGenerateContent}
 		path = fmt.Sprintf(`/{{host_metadata("api_version")}}/projects/{{host_metadata("project")}}/locations/{{host_metadata("location")}}/publishers/{{host_metadata("publisher")}}/%s`, modelPath)
+	} else if provider.SelfHosted != nil {
+		if provider.SelfHosted.AuthToken != nil {
+			prefix = "Bearer "
+		} else {
+			headerName = ""
+		}
+		// the native Ollama API has both a chat and a generate path, so the path of the request is kept
+		if ptr.Deref(provider.SelfHosted.API, v1alpha1.SelfHostedAPIOpenAI) == v1alpha1.SelfHostedAPIOpenAI {
+			path = "/v1/chat/completions"
+		}
+		bodyTransformation = defaultBodyTransformation()
 	}
 	if llm.PathOverride != nil {
 		path = *llm.PathOverride.FullPath
@@ -446,12 +544,81 @@ func getTransformation(llm *v1alpha1.LLMProvider) (string, string, string, *envo
 	return headerName, prefix, path, bodyTransformation
 }
 
-func getGeminiPath() string {
-	return `/{{host_metadata("api_version")}}/models/{{host_metadata("model")}}:{% if dynamic_metadata("route_type") == "CHAT_STREAMING" %}streamGenerateContent?key={{host_metadata("auth_token")}}&alt=sse{% else %}generateContent?key={{host_metadata("auth_token")}}{% endif %}`
+// openAIEndpointPaths are the paths of the endpoints in the OpenAI API, relative to its version.
+var openAIEndpointPaths = map[trafficpolicy.AIEndpoint]string{
+	trafficpolicy.AIEndpointEmbeddings:         "embeddings",
+	trafficpolicy.AIEndpointImageGeneration:    "images/generations",
+	trafficpolicy.AIEndpointAudioTranscription: "audio/transcriptions",
 }
 
-func getVertexAIGeminiModelPath() string {
-	return `models/{{host_metadata("model")}}:{% if dynamic_metadata("route_type") == "CHAT_STREAMING" %}streamGenerateContent?alt=sse{% else %}generateContent{% endif %}`
+// getEndpointTransformation returns the auth header, path and body transformation of the requests
+// to an endpoint other than chat completions. The path is empty if the provider does not serve the endpoint.
+// The path override of the provider only applies to chat completions.
+func getEndpointTransformation(llm *v1alpha1.LLMProvider, endpoint trafficpolicy.AIEndpoint) (string, string, string, *envoytransformation.TransformationTemplate_MergeJsonKeys) {
+	headerName := "Authorization"
+	var prefix, path string
+	var bodyTransformation *envoytransformation.TransformationTemplate_MergeJsonKeys
+	provider := llm.Provider
+	if provider.OpenAI != nil {
+		prefix = "Bearer "
+		path = "/v1/" + openAIEndpointPaths[endpoint]
+		bodyTransformation = defaultBodyTransformation()
+	} else if provider.AzureOpenAI != nil {
+		headerName = "api-key"
+		path = `/openai/deployments/{{ host_metadata("model") }}/` + openAIEndpointPaths[endpoint] + `?api-version={{ host_metadata("api_version" )}}`
+	} else if provider.Gemini != nil {
+		// Gemini serves embeddings and image generation with its OpenAI compatible API
+		if endpoint != trafficpolicy.AIEndpointAudioTranscription {
+			prefix = "Bearer "
+			path = `/{{host_metadata("api_version")}}/openai/` + openAIEndpointPaths[endpoint]
+			bodyTransformation = defaultBodyTransformation()
+		}
+	} else if provider.SelfHosted != nil {
+		if provider.SelfHosted.AuthToken != nil {
+			prefix = "Bearer "
+		} else {
+			headerName = ""
+		}
+		if ptr.Deref(provider.SelfHosted.API, v1alpha1.SelfHostedAPIOpenAI) == v1alpha1.SelfHostedAPIOpenAI {
+			path = "/v1/" + openAIEndpointPaths[endpoint]
+		} else if endpoint == trafficpolicy.AIEndpointEmbeddings {
+			// the native Ollama API only serves embeddings
+			path = "/api/embed"
+		}
+		bodyTransformation = defaultBodyTransformation()
+	}
+	if path == "" {
+		return "", "", "", nil
+	}
+	if llm.AuthHeaderOverride != nil {
+		if llm.AuthHeaderOverride.HeaderName != nil {
+			headerName = *llm.AuthHeaderOverride.HeaderName
+		}
+		if llm.AuthHeaderOverride.Prefix != nil {
+			prefix = *llm.AuthHeaderOverride.Prefix
+		}
+	}
+
+	return headerName, prefix, path, bodyTransformation
+}
+
+func getGeminiPath(streamingCondition string) string {
+	return `/{{host_metadata("api_version")}}/models/{{host_metadata("model")}}:{% if ` + streamingCondition + ` %}streamGenerateContent?key={{host_metadata("auth_token")}}&alt=sse{% else %}generateContent?key={{host_metadata("auth_token")}}{% endif %}`
+}
+
+func getVertexAIGeminiModelPath(streamingCondition string) string {
+	return `models/{{host_metadata("model")}}:{% if ` + streamingCondition + ` %}streamGenerateContent?alt=sse{% else %}generateContent{% endif %}`
+}
+
+// getStreamingCondition returns the inja condition of streaming requests.
+// Requests are streaming on CHAT_STREAMING routes. OpenAI requests ask for a streaming response in
+// their body, which the ai extension reports once it has translated the request to the provider format.
+func getStreamingCondition(llm *v1alpha1.LLMProvider) string {
+	condition := `dynamic_metadata("route_type") == "CHAT_STREAMING"`
+	if ptr.Deref(llm.APIFormat, "") == v1alpha1.APIFormatOpenAI {
+		condition += ` or dynamic_metadata("route_type", "ai.kgateway.io") == "CHAT_STREAMING"`
+	}
+	return condition
 }
 
 func defaultBodyTransformation() *envoytransformation.TransformationTemplate_MergeJsonKeys {
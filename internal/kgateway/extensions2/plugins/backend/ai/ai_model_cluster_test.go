@@ -3,13 +3,16 @@ package ai
 import (
 	"strings"
 	"testing"
+	"time"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/plugins/trafficpolicy"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 )
 
@@ -18,7 +21,7 @@ func TestProcessAIBackend_Empty(t *testing.T) {
 		Name: "test-cluster",
 	}
 
-	err := ProcessAIBackend(nil, nil, nil, cluster)
+	err := ProcessAIBackend(nil, "default", nil, nil, cluster)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "test-cluster", cluster.Name)
@@ -48,7 +51,7 @@ func TestProcessAIBackend_OpenAI(t *testing.T) {
 	secrets := &ir.Secret{}
 	multiSecrets := map[string]*ir.Secret{}
 
-	err := ProcessAIBackend(aiBackend, secrets, multiSecrets, cluster)
+	err := ProcessAIBackend(aiBackend, "default", secrets, multiSecrets, cluster)
 
 	require.NoError(t, err)
 
@@ -116,7 +119,7 @@ func TestProcessAIBackend_Anthropic(t *testing.T) {
 	secrets := &ir.Secret{}
 	multiSecrets := map[string]*ir.Secret{}
 
-	err := ProcessAIBackend(aiBackend, secrets, multiSecrets, cluster)
+	err := ProcessAIBackend(aiBackend, "default", secrets, multiSecrets, cluster)
 
 	require.NoError(t, err)
 
@@ -166,7 +169,7 @@ func TestProcessAIBackend_AzureOpenAI(t *testing.T) {
 	secrets := &ir.Secret{}
 	multiSecrets := map[string]*ir.Secret{}
 
-	err := ProcessAIBackend(aiBackend, secrets, multiSecrets, cluster)
+	err := ProcessAIBackend(aiBackend, "default", secrets, multiSecrets, cluster)
 
 	require.NoError(t, err)
 
@@ -216,7 +219,7 @@ func TestProcessAIBackend_Gemini(t *testing.T) {
 	secrets := &ir.Secret{}
 	multiSecrets := map[string]*ir.Secret{}
 
-	err := ProcessAIBackend(aiBackend, secrets, multiSecrets, cluster)
+	err := ProcessAIBackend(aiBackend, "default", secrets, multiSecrets, cluster)
 
 	require.NoError(t, err)
 
@@ -269,7 +272,7 @@ func TestProcessAIBackend_VertexAI(t *testing.T) {
 	secrets := &ir.Secret{}
 	multiSecrets := map[string]*ir.Secret{}
 
-	err := ProcessAIBackend(aiBackend, secrets, multiSecrets, cluster)
+	err := ProcessAIBackend(aiBackend, "default", secrets, multiSecrets, cluster)
 
 	require.NoError(t, err)
 
@@ -334,7 +337,7 @@ func TestProcessAIBackend_CustomURL(t *testing.T) {
 	secrets := &ir.Secret{}
 	multiSecrets := map[string]*ir.Secret{}
 
-	err := ProcessAIBackend(aiBackend, secrets, multiSecrets, cluster)
+	err := ProcessAIBackend(aiBackend, "default", secrets, multiSecrets, cluster)
 
 	require.NoError(t, err)
 
@@ -402,7 +405,7 @@ func TestProcessAIBackend_MultiPool(t *testing.T) {
 	secrets := &ir.Secret{}
 	multiSecrets := map[string]*ir.Secret{}
 
-	err := ProcessAIBackend(aiBackend, secrets, multiSecrets, cluster)
+	err := ProcessAIBackend(aiBackend, "default", secrets, multiSecrets, cluster)
 
 	require.NoError(t, err)
 
@@ -440,6 +443,60 @@ func TestProcessAIBackend_MultiPool(t *testing.T) {
 	assert.Equal(t, "gpt-3.5-turbo", metadata1.Fields["model"].GetStringValue())
 }
 
+func TestProcessAIBackend_MultiPoolFailover(t *testing.T) {
+	cluster := &envoyclusterv3.Cluster{
+		Name: "multi-pool-cluster",
+	}
+
+	newPriority := func(token string) v1alpha1.Priority {
+		return v1alpha1.Priority{
+			Pool: []v1alpha1.LLMProvider{
+				{
+					Provider: v1alpha1.SupportedLLMProvider{
+						OpenAI: &v1alpha1.OpenAIConfig{
+							AuthToken: v1alpha1.SingleAuthToken{
+								Kind:   v1alpha1.Inline,
+								Inline: ptr.To(token),
+							},
+						},
+					},
+					HostOverride: &v1alpha1.Host{Host: token + ".internal", Port: 8080},
+				},
+			},
+		}
+	}
+	aiBackend := &v1alpha1.AIBackend{
+		MultiPool: &v1alpha1.MultiPoolConfig{
+			Priorities: []v1alpha1.Priority{newPriority("primary"), newPriority("fallback")},
+			Failover: &v1alpha1.MultiPoolFailover{
+				ConsecutiveFailures: ptr.To[int32](5),
+				Cooldown:            &metav1.Duration{Duration: 5 * time.Second},
+			},
+		},
+	}
+
+	err := ProcessAIBackend(aiBackend, "default", nil, map[string]*ir.Secret{}, cluster)
+	require.NoError(t, err)
+
+	outlierDetection := cluster.GetOutlierDetection()
+	require.NotNil(t, outlierDetection)
+	assert.Equal(t, uint32(5), outlierDetection.GetConsecutive_5Xx().GetValue())
+	assert.Equal(t, uint32(5), outlierDetection.GetConsecutiveGatewayFailure().GetValue())
+	assert.Equal(t, 5*time.Second, outlierDetection.GetBaseEjectionTime().AsDuration())
+	assert.Equal(t, 5*time.Second, outlierDetection.GetMaxEjectionTime().AsDuration())
+	assert.Equal(t, 5*time.Second, outlierDetection.GetInterval().AsDuration())
+	assert.Equal(t, uint32(100), outlierDetection.GetMaxEjectionPercent().GetValue())
+	require.NoError(t, outlierDetection.ValidateAll())
+
+	require.Len(t, cluster.LoadAssignment.Endpoints, 2)
+	providerHost := func(priority int) string {
+		metadata := cluster.LoadAssignment.Endpoints[priority].LbEndpoints[0].Metadata.FilterMetadata["io.solo.transformation"]
+		return metadata.Fields[providerHostMetadataKey].GetStringValue()
+	}
+	assert.Equal(t, "primary.internal:8080", providerHost(0))
+	assert.Equal(t, "fallback.internal:8080", providerHost(1))
+}
+
 // findTransportSocketMatchByPrefix finds a transport socket match with a name starting with prefix
 func findTransportSocketMatchByPrefix(matches []*envoyclusterv3.Cluster_TransportSocketMatch, prefix string) *envoyclusterv3.Cluster_TransportSocketMatch {
 	for _, match := range matches {
@@ -459,3 +516,195 @@ func findTransportSocketMatchByName(matches []*envoyclusterv3.Cluster_TransportS
 	}
 	return nil
 }
+
+func TestCreateTransformationTemplate_OpenAIAPIFormat(t *testing.T) {
+	gemini := func(apiFormat *v1alpha1.APIFormat) *v1alpha1.AIBackend {
+		return &v1alpha1.AIBackend{
+			LLM: &v1alpha1.LLMProvider{
+				Provider: v1alpha1.SupportedLLMProvider{
+					Gemini: &v1alpha1.GeminiConfig{
+						Model:      "gemini-1.5-flash",
+						ApiVersion: "v1beta",
+						AuthToken:  v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("token")},
+					},
+				},
+				APIFormat: apiFormat,
+			},
+		}
+	}
+
+	path := createTransformationTemplate(gemini(nil)).GetHeaders()[":path"].GetText()
+	assert.Contains(t, path, `{% if dynamic_metadata("route_type") == "CHAT_STREAMING" %}`)
+
+	// OpenAI requests ask for streaming responses in their body, which is reported by the ai extension
+	path = createTransformationTemplate(gemini(ptr.To(v1alpha1.APIFormatOpenAI))).GetHeaders()[":path"].GetText()
+	assert.Contains(t, path, `{% if dynamic_metadata("route_type") == "CHAT_STREAMING" or dynamic_metadata("route_type", "ai.kgateway.io") == "CHAT_STREAMING" %}`)
+}
+
+func TestProcessAIBackend_SelfHosted(t *testing.T) {
+	cluster := &envoyclusterv3.Cluster{
+		Name: "self-hosted-cluster",
+	}
+
+	aiBackend := &v1alpha1.AIBackend{
+		LLM: &v1alpha1.LLMProvider{
+			Provider: v1alpha1.SupportedLLMProvider{
+				SelfHosted: &v1alpha1.SelfHostedConfig{
+					Service: v1alpha1.SelfHostedService{Name: "ollama", Port: 11434},
+					API:     ptr.To(v1alpha1.SelfHostedAPIOllama),
+					Model:   ptr.To("llama3.1"),
+				},
+			},
+		},
+	}
+
+	err := ProcessAIBackend(aiBackend, "llm", nil, map[string]*ir.Secret{}, cluster)
+
+	require.NoError(t, err)
+
+	endpoints := cluster.LoadAssignment.Endpoints[0].LbEndpoints
+	require.Len(t, endpoints, 1)
+
+	// Verify the endpoint is the Service in the namespace of the backend, over plaintext
+	address := endpoints[0].GetEndpoint().Address.GetSocketAddress()
+	require.NotNil(t, address)
+	assert.Equal(t, "ollama.llm.svc.cluster.local", address.Address)
+	assert.Equal(t, uint32(11434), address.GetPortValue())
+	assert.NotContains(t, endpoints[0].Metadata.FilterMetadata, "envoy.transport_socket_match")
+
+	filterMeta := endpoints[0].Metadata.FilterMetadata["io.solo.transformation"]
+	require.NotNil(t, filterMeta)
+	assert.Empty(t, filterMeta.Fields["auth_token"].GetStringValue())
+	assert.Equal(t, "llama3.1", filterMeta.Fields["model"].GetStringValue())
+}
+
+func TestCreateTransformationTemplate_SelfHosted(t *testing.T) {
+	tests := []struct {
+		name       string
+		selfHosted *v1alpha1.SelfHostedConfig
+		wantAuth   bool
+		wantPath   string
+	}{
+		{
+			name: "OpenAI-compatible API without auth",
+			selfHosted: &v1alpha1.SelfHostedConfig{
+				Service: v1alpha1.SelfHostedService{Name: "vllm", Port: 8000},
+			},
+			wantPath: "/v1/chat/completions",
+		},
+		{
+			name: "Ollama API with auth keeps the path of the request",
+			selfHosted: &v1alpha1.SelfHostedConfig{
+				Service:   v1alpha1.SelfHostedService{Name: "ollama", Port: 11434},
+				API:       ptr.To(v1alpha1.SelfHostedAPIOllama),
+				AuthToken: &v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("token")},
+			},
+			wantAuth: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := createTransformationTemplate(&v1alpha1.AIBackend{
+				LLM: &v1alpha1.LLMProvider{
+					Provider: v1alpha1.SupportedLLMProvider{SelfHosted: tt.selfHosted},
+				},
+			})
+
+			auth, ok := template.GetHeaders()["Authorization"]
+			assert.Equal(t, tt.wantAuth, ok)
+			if tt.wantAuth {
+				assert.True(t, strings.HasPrefix(auth.GetText(), "Bearer "))
+			}
+			assert.Equal(t, tt.wantPath, template.GetHeaders()[":path"].GetText())
+			assert.NotNil(t, template.GetMergeJsonKeys())
+		})
+	}
+}
+
+func TestCreateEndpointTransformationTemplate(t *testing.T) {
+	token := v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("token")}
+	tests := []struct {
+		name     string
+		provider v1alpha1.SupportedLLMProvider
+		endpoint trafficpolicy.AIEndpoint
+		wantAuth string
+		wantPath string
+	}{
+		{
+			name:     "azure openai embeddings use the deployment",
+			provider: v1alpha1.SupportedLLMProvider{AzureOpenAI: &v1alpha1.AzureOpenAIConfig{AuthToken: token}},
+			endpoint: trafficpolicy.AIEndpointEmbeddings,
+			wantAuth: "api-key",
+			wantPath: `/openai/deployments/{{ host_metadata("model") }}/embeddings?api-version={{ host_metadata("api_version" )}}`,
+		},
+		{
+			name:     "gemini image generation uses the OpenAI compatible API",
+			provider: v1alpha1.SupportedLLMProvider{Gemini: &v1alpha1.GeminiConfig{AuthToken: token}},
+			endpoint: trafficpolicy.AIEndpointImageGeneration,
+			wantAuth: "Authorization",
+			wantPath: `/{{host_metadata("api_version")}}/openai/images/generations`,
+		},
+		{
+			name:     "gemini does not serve audio transcription",
+			provider: v1alpha1.SupportedLLMProvider{Gemini: &v1alpha1.GeminiConfig{AuthToken: token}},
+			endpoint: trafficpolicy.AIEndpointAudioTranscription,
+		},
+		{
+			name:     "anthropic does not serve embeddings",
+			provider: v1alpha1.SupportedLLMProvider{Anthropic: &v1alpha1.AnthropicConfig{AuthToken: token}},
+			endpoint: trafficpolicy.AIEndpointEmbeddings,
+		},
+		{
+			name: "ollama embeddings without auth",
+			provider: v1alpha1.SupportedLLMProvider{SelfHosted: &v1alpha1.SelfHostedConfig{
+				Service: v1alpha1.SelfHostedService{Name: "ollama", Port: 11434},
+				API:     ptr.To(v1alpha1.SelfHostedAPIOllama),
+			}},
+			endpoint: trafficpolicy.AIEndpointEmbeddings,
+			wantPath: "/api/embed",
+		},
+		{
+			name: "ollama does not serve image generation",
+			provider: v1alpha1.SupportedLLMProvider{SelfHosted: &v1alpha1.SelfHostedConfig{
+				Service: v1alpha1.SelfHostedService{Name: "ollama", Port: 11434},
+				API:     ptr.To(v1alpha1.SelfHostedAPIOllama),
+			}},
+			endpoint: trafficpolicy.AIEndpointImageGeneration,
+		},
+		{
+			name: "self-hosted OpenAI compatible audio transcription",
+			provider: v1alpha1.SupportedLLMProvider{SelfHosted: &v1alpha1.SelfHostedConfig{
+				Service:   v1alpha1.SelfHostedService{Name: "whisper", Port: 8000},
+				AuthToken: &token,
+			}},
+			endpoint: trafficpolicy.AIEndpointAudioTranscription,
+			wantAuth: "Authorization",
+			wantPath: "/v1/audio/transcriptions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := createEndpointTransformationTemplate(&v1alpha1.AIBackend{
+				LLM: &v1alpha1.LLMProvider{Provider: tt.provider},
+			}, tt.endpoint)
+			if tt.wantPath == "" {
+				assert.Nil(t, template)
+				return
+			}
+			require.NotNil(t, template)
+			assert.Equal(t, tt.wantPath, template.GetHeaders()[":path"].GetText())
+			if tt.wantAuth != "" {
+				assert.Contains(t, template.GetHeaders(), tt.wantAuth)
+			} else {
+				assert.Len(t, template.GetHeaders(), 1)
+			}
+			if tt.endpoint == trafficpolicy.AIEndpointAudioTranscription {
+				assert.NotNil(t, template.GetPassthrough())
+			} else {
+				assert.Nil(t, template.GetPassthrough())
+			}
+		})
+	}
+}
@@ -259,6 +259,8 @@ func getAISecretRef(llm v1alpha1.SupportedLLMProvider) *corev1.LocalObjectRefere
 		secretRef = llm.Gemini.AuthToken.SecretRef
 	} else if llm.VertexAI != nil {
 		secretRef = llm.VertexAI.AuthToken.SecretRef
+	} else if llm.SelfHosted != nil && llm.SelfHosted.AuthToken != nil {
+		secretRef = llm.SelfHosted.AuthToken.SecretRef
 	}
 
 	return secretRef
@@ -289,7 +291,7 @@ func processBackendForEnvoy(ctx context.Context, in ir.BackendObjectIR, out *env
 			logger.Error("failed to process aws backend", "error", err)
 		}
 	case v1alpha1.BackendTypeAI:
-		err := ai.ProcessAIBackend(spec.AI, ir.AIIr.AISecret, ir.AIIr.AIMultiSecret, out)
+		err := ai.ProcessAIBackend(spec.AI, be.GetNamespace(), ir.AIIr.AISecret, ir.AIIr.AIMultiSecret, out)
 		if err != nil {
 			logger.Error("failed to process ai backend", "error", err)
 		}
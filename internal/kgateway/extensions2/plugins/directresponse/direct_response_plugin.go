@@ -124,6 +124,15 @@ func (p *directResponsePluginGwPass) ApplyForRoute(ctx context.Context, pCtx *ir
 		return fmt.Errorf("DirectResponse cannot be applied to route with existing action: %T", outputRoute.GetAction())
 	}
 
+	if dr.spec.A2ACatalog != nil {
+		outputRoute.Action = &envoyroutev3.Route_DirectResponse{
+			DirectResponse: &envoyroutev3.DirectResponseAction{
+				Status: http.StatusInternalServerError,
+			},
+		}
+		return fmt.Errorf("DirectResponse A2A catalogs are only supported by agentgateway")
+	}
+
 	drAction := &envoyroutev3.DirectResponseAction{
 		Status: dr.spec.StatusCode,
 	}
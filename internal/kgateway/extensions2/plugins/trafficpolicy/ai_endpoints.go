@@ -0,0 +1,45 @@
+package trafficpolicy
+
+import (
+	"regexp"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+)
+
+// AIEndpoint is an LLM provider API endpoint, other than chat completions, that requests to
+// AI backends are sent to. As in the OpenAI API, the endpoint of a request is determined by
+// the suffix of its path, and requests with any other path are chat completion requests.
+// The values are shared with the ai extension, which accounts for the usage of each endpoint.
+type AIEndpoint string
+
+const (
+	AIEndpointEmbeddings         AIEndpoint = "embeddings"
+	AIEndpointImageGeneration    AIEndpoint = "image_generation"
+	AIEndpointAudioTranscription AIEndpoint = "audio_transcription"
+)
+
+// AIEndpoints lists the endpoints in the order their transformations are matched.
+var AIEndpoints = []AIEndpoint{
+	AIEndpointEmbeddings,
+	AIEndpointImageGeneration,
+	AIEndpointAudioTranscription,
+}
+
+var aiEndpointPathSuffixes = map[AIEndpoint]string{
+	AIEndpointEmbeddings:         "/embeddings",
+	AIEndpointImageGeneration:    "/images/generations",
+	AIEndpointAudioTranscription: "/audio/transcriptions",
+}
+
+// AIEndpointMatch returns the match of the requests to an endpoint, for transformations
+// that only apply to that endpoint.
+func AIEndpointMatch(endpoint AIEndpoint) *envoyroutev3.RouteMatch {
+	return &envoyroutev3.RouteMatch{
+		PathSpecifier: &envoyroutev3.RouteMatch_SafeRegex{
+			SafeRegex: &envoy_type_matcher_v3.RegexMatcher{
+				Regex: ".*" + regexp.QuoteMeta(aiEndpointPathSuffixes[endpoint]),
+			},
+		},
+	}
+}
@@ -181,14 +181,36 @@ func preProcessAITrafficPolicy(
 		return err
 	}
 
+	logRequestResponseInfo := wrapperspb.Bool(os.Getenv(AiDebugTransformations) == "true")
 	routeTransformations := &envoytransformation.RouteTransformations{
+		// The first matching transformation is applied. Audio transcription requests are
+		// multipart forms, so their transformation leaves out the JSON body.
 		Transformations: []*envoytransformation.RouteTransformations_RouteTransformation{
+			{
+				Match: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch_{
+					RequestMatch: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{
+						Match: AIEndpointMatch(AIEndpointAudioTranscription),
+						RequestTransformation: &envoytransformation.Transformation{
+							LogRequestResponseInfo: logRequestResponseInfo,
+							TransformationType: &envoytransformation.Transformation_TransformationTemplate{
+								TransformationTemplate: &envoytransformation.TransformationTemplate{
+									DynamicMetadataValues: transformationTemplate.GetDynamicMetadataValues(),
+									ParseBodyBehavior:     envoytransformation.TransformationTemplate_DontParse,
+									BodyTransformation: &envoytransformation.TransformationTemplate_Passthrough{
+										Passthrough: &envoytransformation.Passthrough{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			{
 				Match: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch_{
 					RequestMatch: &envoytransformation.RouteTransformations_RouteTransformation_RequestMatch{
 						RequestTransformation: &envoytransformation.Transformation{
 							// Set this env var to true to log the request/response info for each transformation
-							LogRequestResponseInfo: wrapperspb.Bool(os.Getenv(AiDebugTransformations) == "true"),
+							LogRequestResponseInfo: logRequestResponseInfo,
 							TransformationType: &envoytransformation.Transformation_TransformationTemplate{
 								TransformationTemplate: transformationTemplate,
 							},
@@ -308,7 +330,8 @@ func applyPromptEnrichment(
 	pe *v1alpha1.AIPromptEnrichment,
 	transformation *envoytransformation.TransformationTemplate,
 ) error {
-	if pe == nil {
+	// Prompt templates are added by the ai extension, so only inline messages are handled here
+	if pe == nil || (len(pe.Prepend) == 0 && len(pe.Append) == 0) {
 		return nil
 	}
 	// This function does some slightly complex json string work because we're instructing the transformation filter
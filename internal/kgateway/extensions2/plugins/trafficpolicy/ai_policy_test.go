@@ -215,6 +215,28 @@ func TestProcessAITrafficPolicy(t *testing.T) {
 		assert.NotNil(t, transformation)
 	})
 
+	t.Run("passes audio transcription bodies through", func(t *testing.T) {
+		chatStreamingType := v1alpha1.CHAT_STREAMING
+		aiConfig := &v1alpha1.AIPolicy{
+			RouteType: &chatStreamingType,
+			Defaults:  []v1alpha1.FieldDefault{{Field: "model", Value: "gpt-4"}},
+		}
+		aiIR := &aiPolicyIR{}
+		require.NoError(t, preProcessAITrafficPolicy(aiConfig, aiIR))
+
+		require.Len(t, aiIR.Transformation.GetTransformations(), 2)
+		audio := aiIR.Transformation.GetTransformations()[0].GetRequestMatch()
+		assert.Equal(t, `.*/audio/transcriptions`, audio.GetMatch().GetSafeRegex().GetRegex())
+		template := audio.GetRequestTransformation().GetTransformationTemplate()
+		assert.Equal(t, envoytransformation.TransformationTemplate_DontParse, template.GetParseBodyBehavior())
+		assert.NotNil(t, template.GetPassthrough())
+		assert.Len(t, template.GetDynamicMetadataValues(), 1, "route type metadata should be kept")
+
+		chat := aiIR.Transformation.GetTransformations()[1].GetRequestMatch()
+		assert.Nil(t, chat.GetMatch())
+		assert.Contains(t, chat.GetRequestTransformation().GetTransformationTemplate().GetMergeJsonKeys().GetJsonKeys(), "model")
+	})
+
 	t.Run("sets debug logging when environment variable is set", func(t *testing.T) {
 		// Setup
 		plugin := &trafficPolicyPluginGwPass{}
@@ -240,8 +262,9 @@ func TestProcessAITrafficPolicy(t *testing.T) {
 		require.NoError(t, err)
 		transformation, ok := typedFilterConfig.GetTypedConfig(wellknown.AIPolicyTransformationFilterName).(*envoytransformation.RouteTransformations)
 		assert.True(t, ok)
-		assert.True(t, len(transformation.Transformations) == 1)
+		assert.True(t, len(transformation.Transformations) == 2)
 		assert.True(t, transformation.Transformations[0].GetRequestMatch().GetRequestTransformation().GetLogRequestResponseInfo().GetValue())
+		assert.True(t, transformation.Transformations[1].GetRequestMatch().GetRequestTransformation().GetLogRequestResponseInfo().GetValue())
 	})
 
 	t.Run("applies defaults and prompt enrichment", func(t *testing.T) {
@@ -276,8 +299,9 @@ func TestProcessAITrafficPolicy(t *testing.T) {
 
 		routeTransformations, ok := typedFilterConfig.GetTypedConfig(wellknown.AIPolicyTransformationFilterName).(*envoytransformation.RouteTransformations)
 		assert.True(t, ok)
-		assert.True(t, len(routeTransformations.Transformations) == 1)
-		transformation := routeTransformations.Transformations[0]
+		assert.True(t, len(routeTransformations.Transformations) == 2)
+		// the first transformation only applies to audio transcription requests
+		transformation := routeTransformations.Transformations[1]
 
 		// Check the model field was set in the transformation
 		modelTemplate := transformation.GetRequestMatch().GetRequestTransformation().GetTransformationTemplate().GetMergeJsonKeys().GetJsonKeys()["model"]
@@ -359,7 +383,35 @@ func TestProcessAITrafficPolicy(t *testing.T) {
 		outputTransformationProto := typedFilterConfig.GetTypedConfig(wellknown.AIPolicyTransformationFilterName)
 		assert.NotNil(t, outputTransformationProto)
 		outputTransformation := outputTransformationProto.(*envoytransformation.RouteTransformations)
-		assert.Len(t, outputTransformation.Transformations, 1)
+		assert.Len(t, outputTransformation.Transformations, 2)
+	})
+
+	t.Run("passes the regex mask strategy to the ai extension", func(t *testing.T) {
+		aiConfig := &v1alpha1.AIPolicy{
+			PromptGuard: &v1alpha1.AIPromptGuard{
+				Request: &v1alpha1.PromptguardRequest{
+					Regex: &v1alpha1.Regex{
+						Builtins: []v1alpha1.BuiltIn{v1alpha1.EMAIL, v1alpha1.IBAN, v1alpha1.US_PASSPORT},
+						Action:   ptr.To(v1alpha1.MASK),
+						Mask: &v1alpha1.RegexMask{
+							Strategy: ptr.To(v1alpha1.MaskStrategyTokenize),
+						},
+					},
+				},
+			},
+		}
+		aiIR := &aiPolicyIR{}
+
+		err := preProcessAITrafficPolicy(aiConfig, aiIR)
+		require.NoError(t, err)
+
+		var reqConfig string
+		for _, header := range aiIR.Extproc.GetOverrides().GetGrpcInitialMetadata() {
+			if header.GetKey() == "x-req-guardrails-config" {
+				reqConfig = header.GetValue()
+			}
+		}
+		assert.JSONEq(t, `{"regex":{"builtins":["EMAIL","IBAN","US_PASSPORT"],"action":"MASK","mask":{"strategy":"Tokenize"}}}`, reqConfig)
 	})
 
 	t.Run("handles error from prompt guard", func(t *testing.T) {
@@ -526,8 +578,9 @@ func TestDefault(t *testing.T) {
 
 				routeTransformations, ok := typedFilterConfig.GetTypedConfig(wellknown.AIPolicyTransformationFilterName).(*envoytransformation.RouteTransformations)
 				assert.True(t, ok)
-				assert.True(t, len(routeTransformations.Transformations) == 1)
-				transformation := routeTransformations.Transformations[0]
+				assert.True(t, len(routeTransformations.Transformations) == 2)
+				// the first transformation only applies to audio transcription requests
+				transformation := routeTransformations.Transformations[1]
 
 				for i := range tt.aiConfig.Defaults {
 					jsonKey := tt.aiConfig.Defaults[i].Field
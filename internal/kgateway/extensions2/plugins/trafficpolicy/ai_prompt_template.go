@@ -0,0 +1,124 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// promptTemplateVersionKey is the ConfigMap key naming the version of a template
+// that is used when a policy does not pin one.
+const promptTemplateVersionKey = "version"
+
+// FetchConfigMapFunc defines the signature for fetching a ConfigMap referenced by a policy
+type FetchConfigMapFunc func(krtctx krt.HandlerContext, name, ns string) (*corev1.ConfigMap, error)
+
+// promptTemplateConfig is a resolved prompt template passed to the ai extension.
+// Needs to be defined in python ai extensions in the same format.
+type promptTemplateConfig struct {
+	Content   string                   `json:"content"`
+	Role      string                   `json:"role"`
+	Position  string                   `json:"position"`
+	Variables []promptTemplateVariable `json:"variables,omitempty"`
+}
+
+// promptTemplateVariable is a variable of a prompt template and the source of its value.
+type promptTemplateVariable struct {
+	Name    string `json:"name"`
+	Header  string `json:"header,omitempty"`
+	Claim   string `json:"claim,omitempty"`
+	Default string `json:"default,omitempty"`
+}
+
+// newFetchConfigMapFunc returns a FetchConfigMapFunc that resolves ConfigMaps from the given collection.
+func newFetchConfigMapFunc(configMaps krt.Collection[*corev1.ConfigMap]) FetchConfigMapFunc {
+	return func(krtctx krt.HandlerContext, name, ns string) (*corev1.ConfigMap, error) {
+		nn := types.NamespacedName{Namespace: ns, Name: name}
+		cm := krt.FetchOne(krtctx, configMaps, krt.FilterObjectName(nn))
+		if cm == nil {
+			return nil, fmt.Errorf("configmap %s not found", nn)
+		}
+		return *cm, nil
+	}
+}
+
+// constructAIPromptTemplates resolves the prompt templates of an AI policy from their ConfigMaps.
+// It must run after constructAI, as it extends the IR it produces.
+func constructAIPromptTemplates(
+	krtctx krt.HandlerContext,
+	in *v1alpha1.TrafficPolicy,
+	fetchConfigMap FetchConfigMapFunc,
+	out *trafficPolicySpecIr,
+) error {
+	if in.Spec.AI == nil || in.Spec.AI.PromptEnrichment == nil || len(in.Spec.AI.PromptEnrichment.Templates) == 0 {
+		return nil
+	}
+	// the AI IR is nil if constructAI failed, in which case the error was already reported
+	if out.ai == nil || out.ai.Extproc == nil {
+		return nil
+	}
+
+	templates := make([]promptTemplateConfig, 0, len(in.Spec.AI.PromptEnrichment.Templates))
+	for _, tmpl := range in.Spec.AI.PromptEnrichment.Templates {
+		cm, err := fetchConfigMap(krtctx, tmpl.ConfigMapRef.Name, in.GetNamespace())
+		if err != nil {
+			return fmt.Errorf("ai: prompt template: %w", err)
+		}
+		content, err := promptTemplateContent(cm, tmpl.Version)
+		if err != nil {
+			return fmt.Errorf("ai: prompt template configmap %s: %w", cm.GetName(), err)
+		}
+		config := promptTemplateConfig{
+			Content:  content,
+			Role:     strings.ToLower(string(ptr.Deref(tmpl.Role, v1alpha1.PromptTemplateRoleSystem))),
+			Position: strings.ToLower(string(ptr.Deref(tmpl.Position, v1alpha1.PromptTemplatePrepend))),
+		}
+		for _, v := range tmpl.Variables {
+			config.Variables = append(config.Variables, promptTemplateVariable{
+				Name:    v.Name,
+				Header:  strings.ToLower(string(ptr.Deref(v.Header, ""))),
+				Claim:   ptr.Deref(v.Claim, ""),
+				Default: ptr.Deref(v.Default, ""),
+			})
+		}
+		templates = append(templates, config)
+	}
+
+	bin, err := json.Marshal(templates)
+	if err != nil {
+		return err
+	}
+	overrides := out.ai.Extproc.GetOverrides()
+	overrides.GrpcInitialMetadata = append(overrides.GetGrpcInitialMetadata(),
+		&envoycorev3.HeaderValue{
+			Key:   "x-prompt-templates-config",
+			Value: string(bin),
+		},
+	)
+	return nil
+}
+
+// promptTemplateContent returns the requested version of the template stored in the ConfigMap,
+// or the version named by its version key if none is requested.
+func promptTemplateContent(cm *corev1.ConfigMap, version *string) (string, error) {
+	v := ptr.Deref(version, "")
+	if v == "" {
+		v = cm.Data[promptTemplateVersionKey]
+		if v == "" {
+			return "", fmt.Errorf("no version is set and the configmap has no %q key", promptTemplateVersionKey)
+		}
+	}
+	content, ok := cm.Data[v]
+	if !ok {
+		return "", fmt.Errorf("version %q not found", v)
+	}
+	return content, nil
+}
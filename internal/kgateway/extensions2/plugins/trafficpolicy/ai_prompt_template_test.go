@@ -0,0 +1,120 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestConstructAIPromptTemplates(t *testing.T) {
+	configMaps := map[string]*corev1.ConfigMap{
+		"support": {
+			ObjectMeta: metav1.ObjectMeta{Name: "support", Namespace: "default"},
+			Data: map[string]string{
+				"version": "v2",
+				"v1":      "You are a support assistant.",
+				"v2":      "You are a support assistant for {{ tenant }}.",
+			},
+		},
+		"unversioned": {
+			ObjectMeta: metav1.ObjectMeta{Name: "unversioned", Namespace: "default"},
+			Data: map[string]string{
+				"v1": "Answer in French.",
+			},
+		},
+	}
+	fetchConfigMap := func(_ krt.HandlerContext, name, ns string) (*corev1.ConfigMap, error) {
+		if cm, ok := configMaps[name]; ok && ns == "default" {
+			return cm, nil
+		}
+		return nil, fmt.Errorf("configmap %s/%s not found", ns, name)
+	}
+	construct := func(t *testing.T, templates []v1alpha1.PromptTemplate) (map[string]string, error) {
+		t.Helper()
+		policy := &v1alpha1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "support", Namespace: "default"},
+			Spec: v1alpha1.TrafficPolicySpec{
+				AI: &v1alpha1.AIPolicy{
+					PromptEnrichment: &v1alpha1.AIPromptEnrichment{Templates: templates},
+				},
+			},
+		}
+		aiIR := &aiPolicyIR{}
+		require.NoError(t, preProcessAITrafficPolicy(policy.Spec.AI, aiIR))
+		out := &trafficPolicySpecIr{ai: aiIR}
+		if err := constructAIPromptTemplates(krt.TestingDummyContext{}, policy, fetchConfigMap, out); err != nil {
+			return nil, err
+		}
+		metadata := map[string]string{}
+		for _, md := range out.ai.Extproc.GetOverrides().GetGrpcInitialMetadata() {
+			metadata[md.GetKey()] = md.GetValue()
+		}
+		return metadata, nil
+	}
+
+	t.Run("current version with variables", func(t *testing.T) {
+		metadata, err := construct(t, []v1alpha1.PromptTemplate{{
+			ConfigMapRef: corev1.LocalObjectReference{Name: "support"},
+			Variables: []v1alpha1.PromptTemplateVariable{
+				{Name: "tenant", Header: ptr.To(gwv1.HeaderName("X-Tenant")), Default: ptr.To("ACME")},
+				{Name: "user", Claim: ptr.To("profile.name")},
+			},
+		}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{
+			"content": "You are a support assistant for {{ tenant }}.",
+			"role": "system",
+			"position": "prepend",
+			"variables": [
+				{"name": "tenant", "header": "x-tenant", "default": "ACME"},
+				{"name": "user", "claim": "profile.name"}
+			]
+		}]`, metadata["x-prompt-templates-config"])
+	})
+
+	t.Run("pinned version appended as user message", func(t *testing.T) {
+		metadata, err := construct(t, []v1alpha1.PromptTemplate{{
+			ConfigMapRef: corev1.LocalObjectReference{Name: "support"},
+			Version:      ptr.To("v1"),
+			Role:         ptr.To(v1alpha1.PromptTemplateRoleUser),
+			Position:     ptr.To(v1alpha1.PromptTemplateAppend),
+		}})
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{
+			"content": "You are a support assistant.",
+			"role": "user",
+			"position": "append"
+		}]`, metadata["x-prompt-templates-config"])
+	})
+
+	t.Run("missing configmap", func(t *testing.T) {
+		_, err := construct(t, []v1alpha1.PromptTemplate{{
+			ConfigMapRef: corev1.LocalObjectReference{Name: "missing"},
+		}})
+		assert.ErrorContains(t, err, "configmap default/missing not found")
+	})
+
+	t.Run("missing version key", func(t *testing.T) {
+		_, err := construct(t, []v1alpha1.PromptTemplate{{
+			ConfigMapRef: corev1.LocalObjectReference{Name: "unversioned"},
+		}})
+		assert.ErrorContains(t, err, `no version is set and the configmap has no "version" key`)
+	})
+
+	t.Run("missing version", func(t *testing.T) {
+		_, err := construct(t, []v1alpha1.PromptTemplate{{
+			ConfigMapRef: corev1.LocalObjectReference{Name: "support"},
+			Version:      ptr.To("v3"),
+		}})
+		assert.ErrorContains(t, err, `version "v3" not found`)
+	})
+}
@@ -0,0 +1,194 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+const (
+	defaultEmbeddingModel             = "text-embedding-3-small"
+	defaultSemanticCacheMaxEntries    = 1000
+	defaultSemanticCacheSimilarity    = "0.95"
+	semanticCacheEmbeddingOpenAI      = "openai"
+	semanticCacheEmbeddingAzureOpenAI = "azureopenai"
+)
+
+// FetchBackendFunc defines the signature for fetching a Backend referenced by a policy
+type FetchBackendFunc func(krtctx krt.HandlerContext, name, ns string) (*v1alpha1.Backend, error)
+
+// semanticCacheConfig is the semantic cache config passed to the ai extension.
+// Needs to be defined in python ai extensions in the same format.
+type semanticCacheConfig struct {
+	Embedding           semanticCacheEmbedding `json:"embedding"`
+	MaxEntries          int32                  `json:"maxEntries"`
+	SimilarityThreshold string                 `json:"similarityThreshold"`
+	TTL                 string                 `json:"ttl,omitempty"`
+}
+
+// semanticCacheEmbedding is the resolved embedding model of a semantic cache.
+type semanticCacheEmbedding struct {
+	Provider string `json:"provider"`
+	// AuthToken is passed as is, so that the value of a Secret is resolved by the ai extension
+	// from the Secrets mounted in its container rather than sent in the ext_proc metadata
+	AuthToken v1alpha1.SingleAuthToken `json:"authToken"`
+	Model     string                   `json:"model,omitempty"`
+	// BaseURL overrides the OpenAI API base url
+	BaseURL string `json:"baseUrl,omitempty"`
+	// Endpoint, Deployment and APIVersion are only set for Azure OpenAI
+	Endpoint   string `json:"endpoint,omitempty"`
+	Deployment string `json:"deployment,omitempty"`
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// newFetchBackendFunc returns a FetchBackendFunc that resolves Backends from the backend index.
+func newFetchBackendFunc(backends *krtcollections.BackendIndex) FetchBackendFunc {
+	return func(krtctx krt.HandlerContext, name, ns string) (*v1alpha1.Backend, error) {
+		src := ir.ObjectSource{
+			Group:     wellknown.TrafficPolicyGVK.Group,
+			Kind:      wellknown.TrafficPolicyGVK.Kind,
+			Namespace: ns,
+		}
+		ref := gwv1.BackendObjectReference{
+			Group: ptr.To(gwv1.Group(wellknown.BackendGVK.Group)),
+			Kind:  ptr.To(gwv1.Kind(wellknown.BackendGVK.Kind)),
+			Name:  gwv1.ObjectName(name),
+		}
+		backendIR, err := backends.GetBackendFromRef(krtctx, src, ref)
+		if err != nil {
+			return nil, err
+		}
+		backend, ok := backendIR.Obj.(*v1alpha1.Backend)
+		if !ok {
+			return nil, fmt.Errorf("backend %s/%s is not a Backend resource", ns, name)
+		}
+		return backend, nil
+	}
+}
+
+// constructAISemanticCache constructs the semantic cache of an AI policy.
+// It must run after constructAI, as it extends the IR it produces.
+func constructAISemanticCache(
+	krtctx krt.HandlerContext,
+	in *v1alpha1.TrafficPolicy,
+	fetchBackend FetchBackendFunc,
+	secrets *krtcollections.SecretIndex,
+	out *trafficPolicySpecIr,
+) error {
+	if in.Spec.AI == nil || in.Spec.AI.SemanticCache == nil {
+		return nil
+	}
+	// the AI IR is nil if constructAI failed, in which case the error was already reported
+	if out.ai == nil || out.ai.Extproc == nil {
+		return nil
+	}
+	cache := in.Spec.AI.SemanticCache
+
+	backend, err := fetchBackend(krtctx, cache.Embedding.BackendRef.Name, in.GetNamespace())
+	if err != nil {
+		return fmt.Errorf("ai: semantic cache embedding backend: %w", err)
+	}
+	embedding, err := buildSemanticCacheEmbedding(krtctx, backend, cache.Embedding.Model, secrets)
+	if err != nil {
+		return fmt.Errorf("ai: semantic cache embedding backend %s: %w", backend.GetName(), err)
+	}
+
+	config := semanticCacheConfig{
+		Embedding:           *embedding,
+		MaxEntries:          defaultSemanticCacheMaxEntries,
+		SimilarityThreshold: ptr.Deref(cache.SimilarityThreshold, defaultSemanticCacheSimilarity),
+	}
+	if cache.Store != nil && cache.Store.Memory != nil {
+		config.MaxEntries = ptr.Deref(cache.Store.Memory.MaxEntries, defaultSemanticCacheMaxEntries)
+	}
+	if cache.TTL != nil {
+		config.TTL = cache.TTL.Duration.String()
+	}
+
+	bin, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	// Cache entries are keyed by the policy and the hash of its config, so that they are
+	// neither shared across policies nor served after the config changes
+	configHash, _ := hashUnique(config, nil)
+	overrides := out.ai.Extproc.GetOverrides()
+	overrides.GrpcInitialMetadata = append(overrides.GetGrpcInitialMetadata(),
+		&envoycorev3.HeaderValue{
+			Key:   "x-semantic-cache-config",
+			Value: string(bin),
+		},
+		&envoycorev3.HeaderValue{
+			Key:   "x-semantic-cache-key",
+			Value: fmt.Sprintf("%s/%s/%d", in.GetNamespace(), in.GetName(), configHash),
+		},
+	)
+	return nil
+}
+
+// buildSemanticCacheEmbedding resolves the embedding model served by an AI Backend.
+func buildSemanticCacheEmbedding(
+	krtctx krt.HandlerContext,
+	backend *v1alpha1.Backend,
+	model *string,
+	secrets *krtcollections.SecretIndex,
+) (*semanticCacheEmbedding, error) {
+	if backend.Spec.Type != v1alpha1.BackendTypeAI || backend.Spec.AI == nil || backend.Spec.AI.LLM == nil {
+		return nil, errors.New("only AI backends with a single LLM provider are supported")
+	}
+	llm := backend.Spec.AI.LLM
+
+	var authToken v1alpha1.SingleAuthToken
+	embedding := &semanticCacheEmbedding{}
+	switch {
+	case llm.Provider.OpenAI != nil:
+		authToken = llm.Provider.OpenAI.AuthToken
+		embedding.Provider = semanticCacheEmbeddingOpenAI
+		// the model of the Backend is not used, as it usually is a chat model
+		embedding.Model = ptr.Deref(model, defaultEmbeddingModel)
+		if host := llm.HostOverride; host != nil {
+			scheme := "http"
+			if host.Port == 443 {
+				scheme = "https"
+			}
+			embedding.BaseURL = fmt.Sprintf("%s://%s:%d/v1", scheme, host.Host, host.Port)
+		}
+	case llm.Provider.AzureOpenAI != nil:
+		azure := llm.Provider.AzureOpenAI
+		authToken = azure.AuthToken
+		embedding.Provider = semanticCacheEmbeddingAzureOpenAI
+		embedding.Model = ptr.Deref(model, "")
+		embedding.Endpoint = azure.Endpoint
+		embedding.Deployment = azure.DeploymentName
+		embedding.APIVersion = azure.ApiVersion
+	default:
+		return nil, errors.New("only the openai and azureopenai providers are supported")
+	}
+
+	// the Secret is only validated here, its value is read by the ai extension
+	if authToken.Kind == v1alpha1.SecretRef {
+		if authToken.SecretRef == nil {
+			return nil, errors.New("secretRef must be set if the auth token kind is SecretRef")
+		}
+		secret, err := pluginutils.GetSecretIr(secrets, krtctx, authToken.SecretRef.Name, backend.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		if _, err := pluginutils.GetAuthToken(authToken, secret); err != nil {
+			return nil, err
+		}
+	}
+	embedding.AuthToken = authToken
+	return embedding, nil
+}
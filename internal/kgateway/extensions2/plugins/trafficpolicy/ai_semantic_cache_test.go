@@ -0,0 +1,234 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestConstructAISemanticCache(t *testing.T) {
+	backends := map[string]*v1alpha1.Backend{
+		"openai": {
+			ObjectMeta: metav1.ObjectMeta{Name: "openai", Namespace: "default"},
+			Spec: v1alpha1.BackendSpec{
+				Type: v1alpha1.BackendTypeAI,
+				AI: &v1alpha1.AIBackend{
+					LLM: &v1alpha1.LLMProvider{
+						Provider: v1alpha1.SupportedLLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{
+								AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("sk-test")},
+							},
+						},
+						HostOverride: &v1alpha1.Host{Host: "embeddings.internal", Port: 8080},
+					},
+				},
+			},
+		},
+		"azure": {
+			ObjectMeta: metav1.ObjectMeta{Name: "azure", Namespace: "default"},
+			Spec: v1alpha1.BackendSpec{
+				Type: v1alpha1.BackendTypeAI,
+				AI: &v1alpha1.AIBackend{
+					LLM: &v1alpha1.LLMProvider{
+						Provider: v1alpha1.SupportedLLMProvider{
+							AzureOpenAI: &v1alpha1.AzureOpenAIConfig{
+								AuthToken:      v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("azure-key")},
+								Endpoint:       "my-endpoint.openai.azure.com",
+								DeploymentName: "embeddings",
+								ApiVersion:     "2024-02-01",
+							},
+						},
+					},
+				},
+			},
+		},
+		"openai-chat": {
+			ObjectMeta: metav1.ObjectMeta{Name: "openai-chat", Namespace: "default"},
+			Spec: v1alpha1.BackendSpec{
+				Type: v1alpha1.BackendTypeAI,
+				AI: &v1alpha1.AIBackend{
+					LLM: &v1alpha1.LLMProvider{
+						Provider: v1alpha1.SupportedLLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{
+								AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("sk-test")},
+								Model:     ptr.To("gpt-4o"),
+							},
+						},
+					},
+				},
+			},
+		},
+		"passthrough": {
+			ObjectMeta: metav1.ObjectMeta{Name: "passthrough", Namespace: "default"},
+			Spec: v1alpha1.BackendSpec{
+				Type: v1alpha1.BackendTypeAI,
+				AI: &v1alpha1.AIBackend{
+					LLM: &v1alpha1.LLMProvider{
+						Provider: v1alpha1.SupportedLLMProvider{
+							OpenAI: &v1alpha1.OpenAIConfig{
+								AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Passthrough},
+							},
+						},
+					},
+				},
+			},
+		},
+		"anthropic": {
+			ObjectMeta: metav1.ObjectMeta{Name: "anthropic", Namespace: "default"},
+			Spec: v1alpha1.BackendSpec{
+				Type: v1alpha1.BackendTypeAI,
+				AI: &v1alpha1.AIBackend{
+					LLM: &v1alpha1.LLMProvider{
+						Provider: v1alpha1.SupportedLLMProvider{
+							Anthropic: &v1alpha1.AnthropicConfig{
+								AuthToken: v1alpha1.SingleAuthToken{Kind: v1alpha1.Inline, Inline: ptr.To("key")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	fetchBackend := func(_ krt.HandlerContext, name, ns string) (*v1alpha1.Backend, error) {
+		if b, ok := backends[name]; ok && ns == "default" {
+			return b, nil
+		}
+		return nil, fmt.Errorf("backend %s/%s not found", ns, name)
+	}
+	construct := func(t *testing.T, cache *v1alpha1.AISemanticCache) (map[string]string, error) {
+		t.Helper()
+		policy := &v1alpha1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "faq", Namespace: "default"},
+			Spec: v1alpha1.TrafficPolicySpec{
+				AI: &v1alpha1.AIPolicy{SemanticCache: cache},
+			},
+		}
+		aiIR := &aiPolicyIR{}
+		require.NoError(t, preProcessAITrafficPolicy(policy.Spec.AI, aiIR))
+		out := &trafficPolicySpecIr{ai: aiIR}
+		if err := constructAISemanticCache(krt.TestingDummyContext{}, policy, fetchBackend, nil, out); err != nil {
+			return nil, err
+		}
+		metadata := map[string]string{}
+		for _, md := range out.ai.Extproc.GetOverrides().GetGrpcInitialMetadata() {
+			metadata[md.GetKey()] = md.GetValue()
+		}
+		return metadata, nil
+	}
+
+	t.Run("openai embedding backend with defaults", func(t *testing.T) {
+		metadata, err := construct(t, &v1alpha1.AISemanticCache{
+			Embedding: v1alpha1.AISemanticCacheEmbedding{
+				BackendRef: corev1.LocalObjectReference{Name: "openai"},
+			},
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"embedding": {
+				"provider": "openai",
+				"authToken": {"kind": "Inline", "inline": "sk-test"},
+				"model": "text-embedding-3-small",
+				"baseUrl": "http://embeddings.internal:8080/v1"
+			},
+			"maxEntries": 1000,
+			"similarityThreshold": "0.95"
+		}`, metadata["x-semantic-cache-config"])
+		assert.Regexp(t, `^default/faq/[0-9]+$`, metadata["x-semantic-cache-key"])
+	})
+
+	t.Run("openai embedding backend with a chat model", func(t *testing.T) {
+		metadata, err := construct(t, &v1alpha1.AISemanticCache{
+			Embedding: v1alpha1.AISemanticCacheEmbedding{
+				BackendRef: corev1.LocalObjectReference{Name: "openai-chat"},
+			},
+		})
+		require.NoError(t, err)
+		var config struct {
+			Embedding struct {
+				Model string `json:"model"`
+			} `json:"embedding"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(metadata["x-semantic-cache-config"]), &config))
+		assert.Equal(t, "text-embedding-3-small", config.Embedding.Model)
+
+		metadata, err = construct(t, &v1alpha1.AISemanticCache{
+			Embedding: v1alpha1.AISemanticCacheEmbedding{
+				BackendRef: corev1.LocalObjectReference{Name: "openai-chat"},
+				Model:      ptr.To("text-embedding-3-large"),
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal([]byte(metadata["x-semantic-cache-config"]), &config))
+		assert.Equal(t, "text-embedding-3-large", config.Embedding.Model)
+	})
+
+	t.Run("azure embedding backend with store and ttl", func(t *testing.T) {
+		metadata, err := construct(t, &v1alpha1.AISemanticCache{
+			Embedding: v1alpha1.AISemanticCacheEmbedding{
+				BackendRef: corev1.LocalObjectReference{Name: "azure"},
+			},
+			Store:               &v1alpha1.AISemanticCacheStore{Memory: &v1alpha1.AIMemoryVectorStore{MaxEntries: ptr.To[int32](10)}},
+			SimilarityThreshold: ptr.To("0.9"),
+			TTL:                 &metav1.Duration{Duration: time.Hour},
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"embedding": {
+				"provider": "azureopenai",
+				"authToken": {"kind": "Inline", "inline": "azure-key"},
+				"endpoint": "my-endpoint.openai.azure.com",
+				"deployment": "embeddings",
+				"apiVersion": "2024-02-01"
+			},
+			"maxEntries": 10,
+			"similarityThreshold": "0.9",
+			"ttl": "1h0m0s"
+		}`, metadata["x-semantic-cache-config"])
+	})
+
+	t.Run("passthrough auth token is resolved by the ai extension", func(t *testing.T) {
+		metadata, err := construct(t, &v1alpha1.AISemanticCache{
+			Embedding: v1alpha1.AISemanticCacheEmbedding{
+				BackendRef: corev1.LocalObjectReference{Name: "passthrough"},
+			},
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"embedding": {
+				"provider": "openai",
+				"authToken": {"kind": "Passthrough"},
+				"model": "text-embedding-3-small"
+			},
+			"maxEntries": 1000,
+			"similarityThreshold": "0.95"
+		}`, metadata["x-semantic-cache-config"])
+	})
+
+	t.Run("unsupported embedding provider", func(t *testing.T) {
+		_, err := construct(t, &v1alpha1.AISemanticCache{
+			Embedding: v1alpha1.AISemanticCacheEmbedding{
+				BackendRef: corev1.LocalObjectReference{Name: "anthropic"},
+			},
+		})
+		require.ErrorContains(t, err, "only the openai and azureopenai providers are supported")
+	})
+
+	t.Run("missing embedding backend", func(t *testing.T) {
+		_, err := construct(t, &v1alpha1.AISemanticCache{
+			Embedding: v1alpha1.AISemanticCacheEmbedding{
+				BackendRef: corev1.LocalObjectReference{Name: "missing"},
+			},
+		})
+		require.ErrorContains(t, err, "not found")
+	})
+}
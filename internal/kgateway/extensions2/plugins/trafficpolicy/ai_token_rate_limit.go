@@ -0,0 +1,132 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"istio.io/istio/pkg/kube/krt"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/pluginutils"
+)
+
+const (
+	// promptTokensHitsAddend is the number of prompt tokens of the request, set by the ai extension
+	// once it has parsed the request body.
+	promptTokensHitsAddend = "%DYNAMIC_METADATA(envoy.ratelimit:hits_addend)%"
+	// completionTokensHitsAddend is the number of completion tokens reported by the LLM provider,
+	// set by the ai extension once the response, or the response stream, completes.
+	completionTokensHitsAddend = "%DYNAMIC_METADATA(ai.kgateway.io:completion_tokens)%"
+	// tokenRateLimitDescriptorValue is the value of the generic_key entry prepended to the descriptors
+	// of token based rate limits, so they don't share counters with request based rate limits
+	// using the same descriptor entries.
+	tokenRateLimitDescriptorValue = "ai_tokens"
+)
+
+// constructAITokenRateLimit constructs the token based rate limit of an AI policy.
+// It must run after constructAI and constructGlobalRateLimit, as it extends the IR they produce.
+func constructAITokenRateLimit(
+	krtctx krt.HandlerContext,
+	in *v1alpha1.TrafficPolicy,
+	fetchGatewayExtension FetchGatewayExtensionFunc,
+	out *trafficPolicySpecIr,
+) error {
+	if in.Spec.AI == nil || in.Spec.AI.TokenRateLimit == nil {
+		return nil
+	}
+	tokenRateLimit := in.Spec.AI.TokenRateLimit
+
+	if tokenRateLimit.Local != nil {
+		// the AI IR is nil if constructAI failed, in which case the error was already reported
+		if out.ai == nil || out.ai.Extproc == nil {
+			return nil
+		}
+		return applyLocalTokenRateLimit(tokenRateLimit.Local, in.GetNamespace()+"/"+in.GetName(), out.ai)
+	}
+
+	if tokenRateLimit.Global != nil {
+		rateLimits, err := createTokenRateLimits(tokenRateLimit.Global.Descriptors)
+		if err != nil {
+			return fmt.Errorf("ai: failed to create token rate limit actions: %w", err)
+		}
+		gwExtIR, err := fetchGatewayExtension(krtctx, tokenRateLimit.Global.ExtensionRef, in.GetNamespace())
+		if err != nil {
+			return fmt.Errorf("ai: token ratelimit: %w", err)
+		}
+		if gwExtIR.ExtType != v1alpha1.GatewayExtensionTypeRateLimit || gwExtIR.RateLimit == nil {
+			return pluginutils.ErrInvalidExtensionType(v1alpha1.GatewayExtensionTypeRateLimit, gwExtIR.ExtType)
+		}
+		// A route can only use a single rate limit service, shared with the request based global rate limit.
+		if out.globalRateLimit == nil {
+			out.globalRateLimit = &globalRateLimitIR{provider: gwExtIR}
+		} else if out.globalRateLimit.provider.ResourceName() != gwExtIR.ResourceName() {
+			return fmt.Errorf("ai: token rate limit must use the same rate limit extension as rateLimit.global")
+		}
+		out.globalRateLimit.rateLimitActions = append(out.globalRateLimit.rateLimitActions, rateLimits...)
+	}
+
+	return nil
+}
+
+// createTokenRateLimits translates the API descriptors to Envoy rate limits that debit tokens instead of requests.
+// Prompt tokens are debited, and the limit enforced, when the request is sent to the provider.
+// Completion tokens are only known once the response completes, so they are debited on stream done and
+// count toward subsequent requests.
+func createTokenRateLimits(descriptors []v1alpha1.RateLimitDescriptor) ([]*envoyroutev3.RateLimit, error) {
+	descriptorActions, err := createRateLimitActions(descriptors)
+	if err != nil {
+		return nil, err
+	}
+	actions := append([]*envoyroutev3.RateLimit_Action{{
+		ActionSpecifier: &envoyroutev3.RateLimit_Action_GenericKey_{
+			GenericKey: &envoyroutev3.RateLimit_Action_GenericKey{
+				DescriptorValue: tokenRateLimitDescriptorValue,
+			},
+		},
+	}}, descriptorActions...)
+	return []*envoyroutev3.RateLimit{
+		{
+			Actions: actions,
+			HitsAddend: &envoyroutev3.RateLimit_HitsAddend{
+				Format: promptTokensHitsAddend,
+			},
+		},
+		{
+			Actions: actions,
+			HitsAddend: &envoyroutev3.RateLimit_HitsAddend{
+				Format: completionTokensHitsAddend,
+			},
+			ApplyOnStreamDone: true,
+		},
+	}, nil
+}
+
+// applyLocalTokenRateLimit passes the token budget to the ai extension, which keeps a token bucket
+// per policy and per value of the descriptor header.
+func applyLocalTokenRateLimit(
+	local *v1alpha1.AILocalTokenRateLimit,
+	policyName string,
+	ir *aiPolicyIR,
+) error {
+	// Needs to be defined in python ai extensions in the same format
+	bin, err := json.Marshal(local)
+	if err != nil {
+		return err
+	}
+	// Buckets are keyed by the policy and the hash of its config, so that updating the budget resets it
+	configHash, _ := hashUnique(local, nil)
+	overrides := ir.Extproc.GetOverrides()
+	overrides.GrpcInitialMetadata = append(overrides.GetGrpcInitialMetadata(),
+		&envoycorev3.HeaderValue{
+			Key:   "x-token-ratelimit-config",
+			Value: string(bin),
+		},
+		&envoycorev3.HeaderValue{
+			Key:   "x-token-ratelimit-key",
+			Value: fmt.Sprintf("%s/%d", policyName, configHash),
+		},
+	)
+	return nil
+}
@@ -0,0 +1,157 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestConstructAITokenRateLimit(t *testing.T) {
+	fetchRateLimit := func(_ krt.HandlerContext, ref v1alpha1.NamespacedObjectReference, ns string) (*TrafficPolicyGatewayExtensionIR, error) {
+		return &TrafficPolicyGatewayExtensionIR{
+			Name:      ns + "/" + string(ref.Name),
+			ExtType:   v1alpha1.GatewayExtensionTypeRateLimit,
+			RateLimit: &ratev3.RateLimit{Domain: "ai"},
+		}, nil
+	}
+	descriptors := []v1alpha1.RateLimitDescriptor{
+		{
+			Entries: []v1alpha1.RateLimitDescriptorEntry{
+				{Type: v1alpha1.RateLimitDescriptorEntryTypeHeader, Header: ptr.To("x-user-id")},
+			},
+		},
+	}
+	newPolicy := func(tokenRateLimit *v1alpha1.AITokenRateLimit) *v1alpha1.TrafficPolicy {
+		return &v1alpha1.TrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "llm", Namespace: "default"},
+			Spec: v1alpha1.TrafficPolicySpec{
+				AI: &v1alpha1.AIPolicy{TokenRateLimit: tokenRateLimit},
+			},
+		}
+	}
+
+	t.Run("global token rate limit debits prompt and completion tokens", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructAITokenRateLimit(krt.TestingDummyContext{}, newPolicy(&v1alpha1.AITokenRateLimit{
+			Global: &v1alpha1.RateLimitPolicy{
+				Descriptors:  descriptors,
+				ExtensionRef: v1alpha1.NamespacedObjectReference{Name: "ratelimit"},
+			},
+		}), fetchRateLimit, out)
+		require.NoError(t, err)
+		require.NotNil(t, out.globalRateLimit)
+		assert.Equal(t, "default/ratelimit", out.globalRateLimit.provider.ResourceName())
+
+		rateLimits := out.globalRateLimit.rateLimitActions
+		require.Len(t, rateLimits, 2)
+		assert.Equal(t, promptTokensHitsAddend, rateLimits[0].GetHitsAddend().GetFormat())
+		assert.False(t, rateLimits[0].GetApplyOnStreamDone())
+		assert.Equal(t, completionTokensHitsAddend, rateLimits[1].GetHitsAddend().GetFormat())
+		assert.True(t, rateLimits[1].GetApplyOnStreamDone())
+		for _, rateLimit := range rateLimits {
+			require.NoError(t, rateLimit.ValidateAll())
+			require.Len(t, rateLimit.GetActions(), 2)
+			assert.Equal(t, tokenRateLimitDescriptorValue, rateLimit.GetActions()[0].GetGenericKey().GetDescriptorValue())
+			assert.Equal(t, "x-user-id", rateLimit.GetActions()[1].GetRequestHeaders().GetHeaderName())
+		}
+	})
+
+	t.Run("global token rate limit does not share the descriptors of request rate limit", func(t *testing.T) {
+		// the request and token rate limits use the same descriptor entries
+		requestActions, err := createRateLimitActions(descriptors)
+		require.NoError(t, err)
+		out := &trafficPolicySpecIr{
+			globalRateLimit: &globalRateLimitIR{
+				provider:         &TrafficPolicyGatewayExtensionIR{Name: "default/ratelimit"},
+				rateLimitActions: []*envoyroutev3.RateLimit{{Actions: requestActions}},
+			},
+		}
+		err = constructAITokenRateLimit(krt.TestingDummyContext{}, newPolicy(&v1alpha1.AITokenRateLimit{
+			Global: &v1alpha1.RateLimitPolicy{
+				Descriptors:  descriptors,
+				ExtensionRef: v1alpha1.NamespacedObjectReference{Name: "ratelimit"},
+			},
+		}), fetchRateLimit, out)
+		require.NoError(t, err)
+
+		rateLimits := out.globalRateLimit.rateLimitActions
+		require.Len(t, rateLimits, 3)
+		// the token descriptors are the request descriptors prefixed with generic_key: ai_tokens
+		for _, rateLimit := range rateLimits[1:] {
+			tokenActions := rateLimit.GetActions()
+			require.Len(t, tokenActions, len(requestActions)+1)
+			assert.Equal(t, "ai_tokens", tokenActions[0].GetGenericKey().GetDescriptorValue())
+			for i, action := range requestActions {
+				assert.True(t, proto.Equal(action, tokenActions[i+1]))
+			}
+		}
+	})
+
+	t.Run("global token rate limit is appended to request rate limit", func(t *testing.T) {
+		out := &trafficPolicySpecIr{
+			globalRateLimit: &globalRateLimitIR{
+				provider:         &TrafficPolicyGatewayExtensionIR{Name: "default/ratelimit"},
+				rateLimitActions: []*envoyroutev3.RateLimit{{}},
+			},
+		}
+		err := constructAITokenRateLimit(krt.TestingDummyContext{}, newPolicy(&v1alpha1.AITokenRateLimit{
+			Global: &v1alpha1.RateLimitPolicy{
+				Descriptors:  descriptors,
+				ExtensionRef: v1alpha1.NamespacedObjectReference{Name: "ratelimit"},
+			},
+		}), fetchRateLimit, out)
+		require.NoError(t, err)
+		assert.Len(t, out.globalRateLimit.rateLimitActions, 3)
+	})
+
+	t.Run("global token rate limit with a different extension", func(t *testing.T) {
+		out := &trafficPolicySpecIr{
+			globalRateLimit: &globalRateLimitIR{
+				provider: &TrafficPolicyGatewayExtensionIR{Name: "default/other"},
+			},
+		}
+		err := constructAITokenRateLimit(krt.TestingDummyContext{}, newPolicy(&v1alpha1.AITokenRateLimit{
+			Global: &v1alpha1.RateLimitPolicy{
+				Descriptors:  descriptors,
+				ExtensionRef: v1alpha1.NamespacedObjectReference{Name: "ratelimit"},
+			},
+		}), fetchRateLimit, out)
+		require.ErrorContains(t, err, "same rate limit extension")
+	})
+
+	t.Run("local token rate limit is passed to the ai extension", func(t *testing.T) {
+		aiIR := &aiPolicyIR{}
+		require.NoError(t, preProcessAITrafficPolicy(&v1alpha1.AIPolicy{}, aiIR))
+		out := &trafficPolicySpecIr{ai: aiIR}
+
+		err := constructAITokenRateLimit(krt.TestingDummyContext{}, newPolicy(&v1alpha1.AITokenRateLimit{
+			Local: &v1alpha1.AILocalTokenRateLimit{
+				TokenBucket: v1alpha1.TokenBucket{
+					MaxTokens:    10000,
+					FillInterval: metav1.Duration{Duration: time.Minute},
+				},
+				Header: ptr.To[gwv1.HeaderName]("x-user-id"),
+			},
+		}), fetchRateLimit, out)
+		require.NoError(t, err)
+		assert.Nil(t, out.globalRateLimit)
+
+		metadata := map[string]string{}
+		for _, md := range out.ai.Extproc.GetOverrides().GetGrpcInitialMetadata() {
+			metadata[md.GetKey()] = md.GetValue()
+		}
+		assert.JSONEq(t, `{"tokenBucket":{"maxTokens":10000,"fillInterval":"1m0s"},"header":"x-user-id"}`, metadata["x-token-ratelimit-config"])
+		assert.Regexp(t, `^default/llm/[0-9]+$`, metadata["x-token-ratelimit-key"])
+	})
+}
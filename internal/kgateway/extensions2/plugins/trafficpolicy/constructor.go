@@ -53,6 +53,14 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	if err := constructAI(krtctx, policyCR, c.commoncol.Secrets, &outSpec); err != nil {
 		errors = append(errors, err)
 	}
+	// Construct AI semantic cache specific IR
+	if err := constructAISemanticCache(krtctx, policyCR, newFetchBackendFunc(c.commoncol.BackendIndex), c.commoncol.Secrets, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct AI prompt templates specific IR
+	if err := constructAIPromptTemplates(krtctx, policyCR, newFetchConfigMapFunc(c.commoncol.ConfigMaps), &outSpec); err != nil {
+		errors = append(errors, err)
+	}
 	// Construct transformation specific IR
 	if err := constructTransformation(policyCR, &outSpec); err != nil {
 		errors = append(errors, err)
@@ -77,6 +85,10 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	if err := constructGlobalRateLimit(krtctx, policyCR, c.FetchGatewayExtension, &outSpec); err != nil {
 		errors = append(errors, err)
 	}
+	// Construct AI token rate limit specific IR
+	if err := constructAITokenRateLimit(krtctx, policyCR, c.FetchGatewayExtension, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
 	// Construct cors specific IR
 	if err := constructCORS(policyCR, &outSpec); err != nil {
 		errors = append(errors, err)
@@ -102,6 +114,8 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	constructBuffer(policyCR.Spec, &outSpec)
 	// Construct timeout and retry specific IR
 	constructTimeoutRetry(policyCR.Spec, &outSpec)
+	// Construct model routing specific IR
+	constructModelRouting(policyCR.Spec, &outSpec)
 
 	for _, err := range errors {
 		logger.Error("error translating traffic policy", "namespace", policyCR.GetNamespace(), "name", policyCR.GetName(), "error", err)
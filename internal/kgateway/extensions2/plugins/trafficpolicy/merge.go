@@ -236,6 +236,20 @@ func mergeRetry(
 	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "retry")
 }
 
+func mergeModelRouting(
+	p1, p2 *TrafficPolicy,
+	p2Ref *pluginsdkir.AttachedPolicyRef,
+	p2MergeOrigins pluginsdkir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins pluginsdkir.MergeOrigins,
+) {
+	accessor := fieldAccessor[modelRoutingIR]{
+		Get: func(spec *trafficPolicySpecIr) *modelRoutingIR { return spec.modelRouting },
+		Set: func(spec *trafficPolicySpecIr, val *modelRoutingIR) { spec.modelRouting = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "modelRouting")
+}
+
 // fieldAccessor defines how to access and set a field on trafficPolicySpecIr
 type fieldAccessor[T any] struct {
 	Get func(*trafficPolicySpecIr) *T
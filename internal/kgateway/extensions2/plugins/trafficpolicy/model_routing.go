@@ -0,0 +1,98 @@
+package trafficpolicy
+
+import (
+	"fmt"
+
+	transformationpb "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+)
+
+const (
+	// modelRoutingFilterName is the transformation filter that copies the model of the request body
+	// to a header before the other filters run, so that they use the route selected for the model
+	modelRoutingFilterName = "transformation/model_routing"
+
+	// defaultModelRoutingHeader is the header used by the body based routing of the Gateway API Inference Extension
+	defaultModelRoutingHeader = "x-gateway-model-name"
+	defaultModelRoutingField  = "model"
+)
+
+type modelRoutingIR struct {
+	config *transformationpb.RouteTransformations
+}
+
+var _ PolicySubIR = &modelRoutingIR{}
+
+func (m *modelRoutingIR) Equals(other PolicySubIR) bool {
+	otherModelRouting, ok := other.(*modelRoutingIR)
+	if !ok {
+		return false
+	}
+	if m == nil || otherModelRouting == nil {
+		return m == nil && otherModelRouting == nil
+	}
+	return proto.Equal(m.config, otherModelRouting.config)
+}
+
+func (m *modelRoutingIR) Validate() error {
+	if m == nil || m.config == nil {
+		return nil
+	}
+	return m.config.ValidateAll()
+}
+
+// constructModelRouting constructs the model routing policy IR from the policy specification.
+func constructModelRouting(spec v1alpha1.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.ModelRouting == nil {
+		return
+	}
+	header := string(ptr.Deref(spec.ModelRouting.Header, defaultModelRoutingHeader))
+	field := ptr.Deref(spec.ModelRouting.Field, defaultModelRoutingField)
+
+	template := &transformationpb.TransformationTemplate{
+		ParseBodyBehavior: transformationpb.TransformationTemplate_ParseAsJson,
+		// requests that are not JSON are routed without a model
+		IgnoreErrorOnParse: true,
+		Headers: map[string]*transformationpb.InjaTemplate{
+			header: {Text: fmt.Sprintf(`{{ default(%s, "") }}`, field)},
+		},
+		BodyTransformation: &transformationpb.TransformationTemplate_Passthrough{
+			Passthrough: &transformationpb.Passthrough{},
+		},
+	}
+	out.modelRouting = &modelRoutingIR{
+		config: &transformationpb.RouteTransformations{
+			Transformations: []*transformationpb.RouteTransformations_RouteTransformation{
+				{
+					Match: &transformationpb.RouteTransformations_RouteTransformation_RequestMatch_{
+						RequestMatch: &transformationpb.RouteTransformations_RouteTransformation_RequestMatch{
+							RequestTransformation: &transformationpb.Transformation{
+								TransformationType: &transformationpb.Transformation_TransformationTemplate{
+									TransformationTemplate: template,
+								},
+							},
+							// select the route again now that the model header is set
+							ClearRouteCache: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) handleModelRouting(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, modelRouting *modelRoutingIR) {
+	if modelRouting == nil || modelRouting.config == nil {
+		return
+	}
+	pCtxTypedFilterConfig.AddTypedConfig(modelRoutingFilterName, modelRouting.config)
+
+	if p.modelRoutingInChain == nil {
+		p.modelRoutingInChain = make(map[string]bool)
+	}
+	p.modelRoutingInChain[fcn] = true
+}
@@ -0,0 +1,76 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestConstructModelRouting(t *testing.T) {
+	tests := []struct {
+		name         string
+		modelRouting *v1alpha1.ModelRouting
+		wantHeader   string
+		wantTemplate string
+	}{
+		{
+			name:         "defaults to the model field and the inference extension header",
+			modelRouting: &v1alpha1.ModelRouting{},
+			wantHeader:   "x-gateway-model-name",
+			wantTemplate: `{{ default(model, "") }}`,
+		},
+		{
+			name: "custom header and nested field",
+			modelRouting: &v1alpha1.ModelRouting{
+				Header: ptr.To(gwv1.HeaderName("x-model")),
+				Field:  ptr.To("metadata.model"),
+			},
+			wantHeader:   "x-model",
+			wantTemplate: `{{ default(metadata.model, "") }}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			constructModelRouting(v1alpha1.TrafficPolicySpec{
+				ModelRouting: tt.modelRouting,
+			}, out)
+			require.NotNil(t, out.modelRouting)
+			require.NoError(t, out.modelRouting.Validate())
+
+			requestMatch := out.modelRouting.config.GetTransformations()[0].GetRequestMatch()
+			assert.True(t, requestMatch.GetClearRouteCache())
+			template := requestMatch.GetRequestTransformation().GetTransformationTemplate()
+			assert.True(t, template.GetIgnoreErrorOnParse())
+			assert.NotNil(t, template.GetPassthrough())
+			assert.Equal(t, tt.wantTemplate, template.GetHeaders()[tt.wantHeader].GetText())
+		})
+	}
+
+	t.Run("nil model routing", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		constructModelRouting(v1alpha1.TrafficPolicySpec{}, out)
+		assert.Nil(t, out.modelRouting)
+	})
+}
+
+func TestModelRoutingIREquals(t *testing.T) {
+	construct := func(modelRouting *v1alpha1.ModelRouting) *modelRoutingIR {
+		out := &trafficPolicySpecIr{}
+		constructModelRouting(v1alpha1.TrafficPolicySpec{ModelRouting: modelRouting}, out)
+		return out.modelRouting
+	}
+
+	assert.True(t, construct(nil).Equals(construct(nil)))
+	assert.False(t, construct(&v1alpha1.ModelRouting{}).Equals(construct(nil)))
+	assert.True(t, construct(&v1alpha1.ModelRouting{}).Equals(construct(&v1alpha1.ModelRouting{})))
+	assert.False(t, construct(&v1alpha1.ModelRouting{}).Equals(construct(&v1alpha1.ModelRouting{
+		Field: ptr.To("llm.model"),
+	})))
+}
@@ -95,6 +95,7 @@ type trafficPolicySpecIr struct {
 	autoHostRewrite *autoHostRewriteIR
 	retry           *retryIR
 	timeouts        *timeoutsIR
+	modelRouting    *modelRoutingIR
 }
 
 func (d *TrafficPolicy) CreationTime() time.Time {
@@ -152,6 +153,9 @@ func (d *TrafficPolicy) Equals(in any) bool {
 	if !d.spec.timeouts.Equals(d2.spec.timeouts) {
 		return false
 	}
+	if !d.spec.modelRouting.Equals(d2.spec.modelRouting) {
+		return false
+	}
 	return true
 }
 
@@ -172,6 +176,7 @@ func (p *TrafficPolicy) Validate() error {
 	validators = append(validators, p.spec.headerModifiers.Validate)
 	validators = append(validators, p.spec.buffer.Validate)
 	validators = append(validators, p.spec.autoHostRewrite.Validate)
+	validators = append(validators, p.spec.modelRouting.Validate)
 	for _, validator := range validators {
 		if err := validator(); err != nil {
 			return err
@@ -196,6 +201,7 @@ type trafficPolicyPluginGwPass struct {
 	csrfInChain           map[string]*envoy_csrf_v3.CsrfPolicy
 	headerMutationInChain map[string]*header_mutationv3.HeaderMutationPerRoute
 	bufferInChain         map[string]*bufferv3.Buffer
+	modelRoutingInChain   map[string]bool
 }
 
 var _ ir.ProxyTranslationPass = &trafficPolicyPluginGwPass{}
@@ -587,6 +593,17 @@ func (p *trafficPolicyPluginGwPass) HttpFilters(ctx context.Context, fcc ir.Filt
 		filters = append(filters, filter)
 	}
 
+	// Add the model routing transformation filter before the other filters,
+	// so that they all use the route selected for the model of the request.
+	if p.modelRoutingInChain[fcc.FilterChainName] {
+		filter := plugins.MustNewStagedFilter(modelRoutingFilterName,
+			&transformationpb.FilterTransformations{},
+			plugins.BeforeStage(plugins.FaultStage),
+		)
+		filter.Filter.Disabled = true
+		filters = append(filters, filter)
+	}
+
 	if len(filters) == 0 {
 		return nil, nil
 	}
@@ -612,6 +629,7 @@ func (p *trafficPolicyPluginGwPass) handlePolicies(
 	p.handleCsrf(fcn, typedFilterConfig, spec.csrf)
 	p.handleHeaderModifiers(fcn, typedFilterConfig, spec.headerModifiers)
 	p.handleBuffer(fcn, typedFilterConfig, spec.buffer)
+	p.handleModelRouting(fcn, typedFilterConfig, spec.modelRouting)
 }
 
 // handlePerRoutePolicies handles policies that are meant to be processed at the route level
@@ -693,6 +711,7 @@ func MergeTrafficPolicies(
 		mergeAutoHostRewrite,
 		mergeTimeouts,
 		mergeRetry,
+		mergeModelRouting,
 	}
 
 	for _, mergeFunc := range mergeFuncs {
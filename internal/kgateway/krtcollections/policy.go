@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"strings"
 
 	"istio.io/istio/pkg/config/labels"
 	"istio.io/istio/pkg/kube/krt"
@@ -13,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	k8sptr "k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -27,6 +29,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/backendref"
 	tmetrics "github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/metrics"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+	kgwutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/delegation"
 	krtinternal "github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
@@ -299,6 +302,14 @@ func NewGatewayIndex(
 		}}
 	})
 
+	byMergeTargetIndex := krtpkg.UnnamedIndex(gws, func(in *gwv1.Gateway) []types.NamespacedName {
+		target := kgwutils.MergeIntoGatewayName(in)
+		if target == "" {
+			return nil
+		}
+		return []types.NamespacedName{{Namespace: in.GetNamespace(), Name: target}}
+	})
+
 	h.Gateways = krt.NewCollection(gws, func(kctx krt.HandlerContext, gw *gwv1.Gateway) *ir.Gateway {
 		// only care about gateways use a class controlled by us
 		gwClass := ptr.Flatten(krt.FetchOne(kctx, gwClasses, krt.FilterKey(string(gw.Spec.GatewayClassName))))
@@ -306,6 +317,14 @@ func NewGatewayIndex(
 			return nil
 		}
 
+		// the listeners of a gateway merged into another one are part of the other gateway
+		if target := kgwutils.MergeIntoGatewayName(gw); target != "" {
+			targetGw := ptr.Flatten(krt.FetchOne(kctx, gws, krt.FilterKey(types.NamespacedName{Namespace: gw.GetNamespace(), Name: target}.String())))
+			if kgwutils.CanMergeInto(gw, targetGw) {
+				return nil
+			}
+		}
+
 		gwIR := ir.Gateway{
 			ObjectSource: ir.ObjectSource{
 				Group:     gwv1.SchemeGroupVersion.Group,
@@ -427,6 +446,45 @@ func NewGatewayIndex(
 			gwIR.Listeners = append(gwIR.Listeners, lsIR.Listeners...)
 		}
 
+		mergedGws := krt.Fetch(kctx, gws, krt.FilterIndex(byMergeTargetIndex, types.NamespacedName{
+			Namespace: gw.GetNamespace(),
+			Name:      gw.GetName(),
+		}))
+		slices.SortFunc(mergedGws, func(a, b *gwv1.Gateway) int {
+			if c := a.GetCreationTimestamp().Compare(b.GetCreationTimestamp().Time); c != 0 {
+				return c
+			}
+			return strings.Compare(a.GetName(), b.GetName())
+		})
+		for _, mergedGw := range mergedGws {
+			if !kgwutils.CanMergeInto(mergedGw, gw) {
+				continue
+			}
+			mergedSource := ir.ObjectSource{
+				Group:     gwv1.SchemeGroupVersion.Group,
+				Kind:      wellknown.GatewayKind,
+				Namespace: mergedGw.Namespace,
+				Name:      mergedGw.Name,
+			}
+			// the policies of the merged gateway only apply to its own listeners, like for ListenerSets
+			mergedGwPolicies := h.policies.getTargetingPolicies(kctx, mergedSource, "", mergedGw.GetLabels())
+			for _, l := range mergedGw.Spec.Listeners {
+				listenerPolicies := append(h.policies.getTargetingPolicies(kctx, mergedSource, string(l.Name), mergedGw.GetLabels()), mergedGwPolicies...)
+				gwIR.Listeners = append(gwIR.Listeners, ir.Listener{
+					Listener:         l,
+					Parent:           mergedGw,
+					AttachedPolicies: toAttachedPolicies(listenerPolicies),
+					PolicyAncestorRef: gwv1.ParentReference{
+						Group:     k8sptr.To(gwv1.Group(wellknown.GatewayGVK.Group)),
+						Kind:      k8sptr.To(gwv1.Kind(wellknown.GatewayGVK.Kind)),
+						Name:      gwv1.ObjectName(mergedGw.Name),
+						Namespace: k8sptr.To(gwv1.Namespace(mergedGw.Namespace)),
+					},
+				})
+			}
+			gwIR.MergedGateways = append(gwIR.MergedGateways, mergedGw)
+		}
+
 		return &gwIR
 	}, krtopts.ToOptions("gateways")...)
 
@@ -969,27 +1027,8 @@ func NewRoutesIndex(
 		parentRefs := in.Route.GetParentRefs()
 		ret := make([]targetRefIndexKey, len(parentRefs))
 		for i, pRef := range parentRefs {
-			ns := strOr(pRef.Namespace, "")
-			if ns == "" {
-				ns = in.Route.GetNamespace()
-			}
-			// HTTPRoute defaults GK to Gateway
-			group := wellknown.GatewayGVK.Group
-			kind := wellknown.GatewayGVK.Kind
-			if pRef.Group != nil {
-				group = string(*pRef.Group)
-			}
-			if pRef.Kind != nil {
-				kind = string(*pRef.Kind)
-			}
 			// lookup by the root object
-			ret[i] = targetRefIndexKey{
-				Namespace: ns,
-				Group:     group,
-				Kind:      kind,
-				Name:      string(pRef.Name),
-				// this index intentionally doesn't include sectionName or port
-			}
+			ret[i] = parentRefIndexKey(in.Route, pRef)
 		}
 		return ret
 	})
@@ -998,6 +1037,30 @@ func NewRoutesIndex(
 	return h
 }
 
+// parentRefIndexKey returns the index key of a parent reference of a route, which
+// intentionally doesn't include the sectionName or port.
+func parentRefIndexKey(route ir.Route, pRef gwv1.ParentReference) targetRefIndexKey {
+	ns := strOr(pRef.Namespace, "")
+	if ns == "" {
+		ns = route.GetNamespace()
+	}
+	// HTTPRoute defaults GK to Gateway
+	group := wellknown.GatewayGVK.Group
+	kind := wellknown.GatewayGVK.Kind
+	if pRef.Group != nil {
+		group = string(*pRef.Group)
+	}
+	if pRef.Kind != nil {
+		kind = string(*pRef.Kind)
+	}
+	return targetRefIndexKey{
+		Namespace: ns,
+		Group:     group,
+		Kind:      kind,
+		Name:      string(pRef.Name),
+	}
+}
+
 func (h *RoutesIndex) FetchHTTPRoutesBySelector(kctx krt.HandlerContext, selector HTTPRouteSelector) []ir.HttpRouteIR {
 	return krt.Fetch(kctx, h.httpRoutes, krt.FilterIndex(h.httpBySelector, selector))
 }
@@ -1024,6 +1087,153 @@ func (h *RoutesIndex) RoutesFor(kctx krt.HandlerContext, nns types.NamespacedNam
 	return ret
 }
 
+// ServiceBackendNamespaces returns the namespaces of the Service backends of the routes attached
+// to the parent, including those of the routes they delegate to. Unlike the other lookups of the
+// index it doesn't use a krt.HandlerContext, so that it can be used outside of krt transformations.
+func (h *RoutesIndex) ServiceBackendNamespaces(nns types.NamespacedName, group, kind string) sets.Set[string] {
+	namespaces := sets.New[string]()
+	addBackend := func(b *ir.BackendRefIR) {
+		if b == nil || b.BackendObject == nil {
+			return
+		}
+		if b.BackendObject.Group == "" && b.BackendObject.Kind == wellknown.ServiceKind {
+			namespaces.Insert(b.BackendObject.Namespace)
+		}
+	}
+
+	visited := sets.New[string]()
+	var addHttpRoute func(rt *ir.HttpRouteIR)
+	addHttpRoute = func(rt *ir.HttpRouteIR) {
+		if visited.Has(rt.ResourceName()) {
+			return
+		}
+		visited.Insert(rt.ResourceName())
+		for _, rule := range rt.Rules {
+			for _, b := range rule.Backends {
+				addBackend(b.Backend)
+				if b.Delegate == nil {
+					continue
+				}
+				for _, child := range h.delegatedRoutes(*b.Delegate) {
+					addHttpRoute(&child)
+				}
+			}
+		}
+	}
+
+	rts := h.byParentRef.Lookup(targetRefIndexKey{
+		Name:      nns.Name,
+		Group:     group,
+		Kind:      kind,
+		Namespace: nns.Namespace,
+	})
+	for _, rt := range rts {
+		switch r := rt.Route.(type) {
+		case *ir.HttpRouteIR:
+			addHttpRoute(r)
+		case *ir.TcpRouteIR:
+			for i := range r.Backends {
+				addBackend(&r.Backends[i])
+			}
+		case *ir.TlsRouteIR:
+			for i := range r.Backends {
+				addBackend(&r.Backends[i])
+			}
+		}
+	}
+	return namespaces
+}
+
+// Routes returns the krt collection of the routes of all kinds.
+func (h *RoutesIndex) Routes() krt.Collection[RouteWrapper] {
+	return h.routes
+}
+
+// RouteParents returns the parents of the route that aren't routes, such as Gateways and
+// ListenerSets, including the parents of the routes that delegate to it. Like
+// ServiceBackendNamespaces it doesn't use a krt.HandlerContext.
+func (h *RoutesIndex) RouteParents(route ir.Route) []ir.ObjectSource {
+	var ret []ir.ObjectSource
+	visited := sets.New[string]()
+	var addRoute func(rt ir.Route)
+	addRoute = func(rt ir.Route) {
+		key := RouteWrapper{Route: rt}.ResourceName()
+		if visited.Has(key) {
+			return
+		}
+		visited.Insert(key)
+
+		delegated := len(rt.GetParentRefs()) == 0
+		for _, pRef := range rt.GetParentRefs() {
+			k := parentRefIndexKey(rt, pRef)
+			if k.Group == wellknown.HTTPRouteGVK.Group && k.Kind == wellknown.HTTPRouteKind {
+				delegated = true
+				continue
+			}
+			parent := ir.ObjectSource{Group: k.Group, Kind: k.Kind, Namespace: k.Namespace, Name: k.Name}
+			if !slices.Contains(ret, parent) {
+				ret = append(ret, parent)
+			}
+		}
+
+		// a delegated route refers to its parent routes, if at all, so they are looked up
+		// by the delegating backends that select it
+		httpRoute, ok := rt.(*ir.HttpRouteIR)
+		if !delegated || !ok {
+			return
+		}
+		for _, parent := range h.httpRoutes.List() {
+			if h.delegatesTo(&parent, httpRoute) {
+				addRoute(&parent)
+			}
+		}
+	}
+	addRoute(route)
+	return ret
+}
+
+// delegatesTo returns whether a backend of the parent HTTPRoute delegates to the child HTTPRoute.
+func (h *RoutesIndex) delegatesTo(parent, child *ir.HttpRouteIR) bool {
+	for _, rule := range parent.Rules {
+		for _, b := range rule.Backends {
+			if b.Delegate == nil {
+				continue
+			}
+			if slices.ContainsFunc(h.delegatedRoutes(*b.Delegate), func(rt ir.HttpRouteIR) bool {
+				return rt.ResourceName() == child.ResourceName()
+			}) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// delegatedRoutes returns the HTTPRoutes a delegating backend ref refers to, by name,
+// by namespace or by the delegation label.
+func (h *RoutesIndex) delegatedRoutes(ref ir.ObjectSource) []ir.HttpRouteIR {
+	if ref.Group+"/"+ref.Kind == apilabels.DelegationLabelSelector {
+		selector := HTTPRouteSelector{LabelValue: ref.Name}
+		if ref.Namespace != apilabels.DelegationLabelSelectorWildcardNamespace {
+			selector.Namespace = ref.Namespace
+		}
+		return h.httpBySelector.Lookup(selector)
+	}
+	if ref.Name == "" || ref.Name == "*" {
+		return h.httpBySelector.Lookup(HTTPRouteSelector{Namespace: ref.Namespace})
+	}
+	src := ir.ObjectSource{
+		Group:     gwv1.SchemeGroupVersion.Group,
+		Kind:      "HTTPRoute",
+		Namespace: ref.Namespace,
+		Name:      ref.Name,
+	}
+	if rt := h.httpRoutes.GetKey(src.ResourceName()); rt != nil {
+		return []ir.HttpRouteIR{*rt}
+	}
+	return nil
+}
+
 func (h *RoutesIndex) FetchHttp(kctx krt.HandlerContext, ns, n string) *ir.HttpRouteIR {
 	src := ir.ObjectSource{
 		Group:     gwv1.SchemeGroupVersion.Group,
@@ -13,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
 	infextv1a2 "sigs.k8s.io/gateway-api-inference-extension/api/v1alpha2"
@@ -427,6 +428,74 @@ func TestInferencePoolPortOverride(t *testing.T) {
 }
 
 // Helper to build a Namespace pointer, or nil if empty
+func TestServiceBackendNamespaces(t *testing.T) {
+	parentRef := gwv1.ParentReference{Name: "gw"}
+	route := httpRouteWithSvcBackendRef("foo", "default2")
+	route.Spec.ParentRefs = []gwv1.ParentReference{parentRef}
+	// delegate to all the routes of the team namespace
+	route.Spec.Rules[0].BackendRefs = append(route.Spec.Rules[0].BackendRefs, gwv1.HTTPBackendRef{
+		BackendRef: gwv1.BackendRef{
+			BackendObjectReference: gwv1.BackendObjectReference{
+				Group:     ptr.To(gwv1.Group(gwv1.GroupName)),
+				Kind:      ptr.To(gwv1.Kind("HTTPRoute")),
+				Name:      "*",
+				Namespace: ptrToNamespace("team"),
+			},
+		},
+	})
+	child := httpRouteWithSvcBackendRef("foo", "")
+	child.Name = "child"
+	child.Namespace = "team"
+
+	// a route attached to another Gateway
+	other := tcpRouteWithBackendRef("foo", "")
+	other.Spec.ParentRefs = []gwv1.ParentReference{{Name: "other"}}
+
+	rtidx := preRouteIndex(t, []any{svc("default2"), svc("team"), svc(""), refGrant(), route, child, other})
+	namespaces := rtidx.ServiceBackendNamespaces(
+		types.NamespacedName{Namespace: "default", Name: "gw"},
+		wellknown.GatewayGVK.Group, wellknown.GatewayGVK.Kind,
+	)
+	assert.ElementsMatch(t, []string{"default2", "team"}, namespaces.UnsortedList())
+}
+
+func TestRouteParents(t *testing.T) {
+	route := httpRouteWithSvcBackendRef("foo", "")
+	route.Spec.ParentRefs = []gwv1.ParentReference{
+		{Name: "gw"},
+		{
+			Group:     ptr.To(gwv1.Group(wellknown.XListenerSetGVK.Group)),
+			Kind:      ptr.To(gwv1.Kind(wellknown.XListenerSetKind)),
+			Name:      "ls",
+			Namespace: ptrToNamespace("other"),
+		},
+	}
+	// delegate to all the routes of the team namespace
+	route.Spec.Rules[0].BackendRefs = append(route.Spec.Rules[0].BackendRefs, gwv1.HTTPBackendRef{
+		BackendRef: gwv1.BackendRef{
+			BackendObjectReference: gwv1.BackendObjectReference{
+				Group:     ptr.To(gwv1.Group(gwv1.GroupName)),
+				Kind:      ptr.To(gwv1.Kind("HTTPRoute")),
+				Name:      "*",
+				Namespace: ptrToNamespace("team"),
+			},
+		},
+	})
+	// a delegated route without parent refs
+	child := httpRouteWithSvcBackendRef("foo", "")
+	child.Name = "child"
+	child.Namespace = "team"
+
+	rtidx := preRouteIndex(t, []any{svc(""), svc("team"), route, child})
+
+	want := []ir.ObjectSource{
+		{Group: wellknown.GatewayGVK.Group, Kind: wellknown.GatewayKind, Namespace: "default", Name: "gw"},
+		{Group: wellknown.XListenerSetGVK.Group, Kind: wellknown.XListenerSetKind, Namespace: "other", Name: "ls"},
+	}
+	assert.ElementsMatch(t, want, rtidx.RouteParents(rtidx.FetchHttp(krt.TestingDummyContext{}, "default", "httproute")))
+	assert.ElementsMatch(t, want, rtidx.RouteParents(rtidx.FetchHttp(krt.TestingDummyContext{}, "team", "child")))
+}
+
 func ptrToNamespace(ns string) *gwv1.Namespace {
 	if ns == "" {
 		return nil
@@ -53,6 +53,11 @@ type callbacksCollection struct {
 type callbacks struct {
 	collection        atomic.Pointer[callbacksCollection]
 	extraXDSCallbacks xdsserver.Callbacks
+	// identities are the authenticated identities of the proxies of the streams, by stream ID.
+	// They are only set when the xDS server authenticates the proxies.
+	identities sync.Map
+	// streams tracks the config accepted and rejected by the proxies.
+	streams *xdsStreams
 }
 
 // If augmentedPods is nil, we won't use the pod locality info, and all pods for the same gateway will receive the same config.
@@ -60,16 +65,26 @@ type UniquelyConnectedClientsBulider func(ctx context.Context, krtOpts krtintern
 
 // THIS IS THE SET OF THINGS WE RUN TRANSLATION FOR
 // add returned callbacks to the xds server.
+// The returned XdsStreamsBuilder builds the collection of the config accepted and rejected by the clients.
 
-func NewUniquelyConnectedClients(extraXDSCallbacks xdsserver.Callbacks) (xdsserver.Callbacks, UniquelyConnectedClientsBulider) {
-	cb := &callbacks{extraXDSCallbacks: extraXDSCallbacks}
+func NewUniquelyConnectedClients(extraXDSCallbacks xdsserver.Callbacks) (xdsserver.Callbacks, UniquelyConnectedClientsBulider, XdsStreamsBuilder) {
+	cb := &callbacks{
+		extraXDSCallbacks: extraXDSCallbacks,
+		streams:           newXdsStreams(),
+	}
 
 	envoycb := xdsserver.CallbackFuncs{
-		StreamClosedFunc:  cb.OnStreamClosed,
-		StreamRequestFunc: cb.OnStreamRequest,
-		FetchRequestFunc:  cb.OnFetchRequest,
+		StreamOpenFunc:          cb.OnStreamOpen,
+		StreamClosedFunc:        cb.OnStreamClosed,
+		StreamRequestFunc:       cb.OnStreamRequest,
+		StreamResponseFunc:      cb.OnStreamResponse,
+		DeltaStreamOpenFunc:     cb.OnDeltaStreamOpen,
+		DeltaStreamClosedFunc:   cb.OnDeltaStreamClosed,
+		StreamDeltaRequestFunc:  cb.OnStreamDeltaRequest,
+		StreamDeltaResponseFunc: cb.OnStreamDeltaResponse,
+		FetchRequestFunc:        cb.OnFetchRequest,
 	}
-	return envoycb, buildCollection(cb)
+	return envoycb, buildCollection(cb), cb.streams.builder()
 }
 
 func buildCollection(callbacks *callbacks) UniquelyConnectedClientsBulider {
@@ -96,11 +111,66 @@ func buildCollection(callbacks *callbacks) UniquelyConnectedClientsBulider {
 	}
 }
 
+// OnStreamOpen is called once an xDS stream is open with a stream ID and the type URL (or "" for ADS).
+// Returning an error will end processing and close the stream. OnStreamClosed will still be called.
+func (x *callbacks) OnStreamOpen(ctx context.Context, sid int64, typeURL string) error {
+	if x.extraXDSCallbacks != nil {
+		if err := x.extraXDSCallbacks.OnStreamOpen(ctx, sid, typeURL); err != nil {
+			return err
+		}
+	}
+
+	x.streamOpen(ctx, sid)
+	return nil
+}
+
+// OnDeltaStreamOpen is called once an incremental xDS stream is open with a stream ID and the type URL (or "" for ADS).
+// Returning an error will end processing and close the stream. OnDeltaStreamClosed will still be called.
+func (x *callbacks) OnDeltaStreamOpen(ctx context.Context, sid int64, typeURL string) error {
+	if x.extraXDSCallbacks != nil {
+		if err := x.extraXDSCallbacks.OnDeltaStreamOpen(ctx, sid, typeURL); err != nil {
+			return err
+		}
+	}
+
+	x.streamOpen(ctx, deltaStreamID(sid))
+	return nil
+}
+
+// deltaStreamID returns the ID tracking the incremental xDS stream with the ID. The state-of-the-world
+// and incremental xDS servers number their streams independently starting from 1, so the
+// incremental streams are tracked with negative IDs.
+func deltaStreamID(sid int64) int64 {
+	return -sid
+}
+
+func (x *callbacks) streamOpen(ctx context.Context, sid int64) {
+	// the proxy can only receive the config its identity is bound to, which is checked
+	// on each request as the node is only known once the proxy sends a request
+	if id, ok := xds.IdentityFromContext(ctx); ok {
+		x.identities.Store(sid, id)
+	}
+}
+
 // OnStreamClosed is called immediately prior to closing an xDS stream with a stream ID.
 func (x *callbacks) OnStreamClosed(sid int64, node *envoycorev3.Node) {
 	if x.extraXDSCallbacks != nil {
 		x.extraXDSCallbacks.OnStreamClosed(sid, node)
 	}
+	x.streamClosed(sid)
+}
+
+// OnDeltaStreamClosed is called immediately prior to closing an incremental xDS stream with a stream ID.
+func (x *callbacks) OnDeltaStreamClosed(sid int64, node *envoycorev3.Node) {
+	if x.extraXDSCallbacks != nil {
+		x.extraXDSCallbacks.OnDeltaStreamClosed(sid, node)
+	}
+	x.streamClosed(deltaStreamID(sid))
+}
+
+func (x *callbacks) streamClosed(sid int64) {
+	x.identities.Delete(sid)
+	x.streams.streamClosed(sid)
 
 	c := x.collection.Load()
 	if c == nil {
@@ -136,16 +206,16 @@ func (x *callbacksCollection) del(sid int64) *ir.UniqlyConnectedClient {
 	return nil
 }
 
-func roleFromRequest(r *envoy_service_discovery_v3.DiscoveryRequest) string {
-	return r.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
+func roleFromNode(node *envoycorev3.Node) string {
+	return node.GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
 }
 
-func (x *callbacksCollection) add(sid int64, r *envoy_service_discovery_v3.DiscoveryRequest) (string, bool, error) {
+func (x *callbacksCollection) add(sid int64, node *envoycorev3.Node) (string, bool, error) {
 	var pod *LocalityPod
 	// see if user wants to use pod locality info
 	usePod := x.augmentedPods != nil
-	if usePod && r.GetNode() != nil {
-		podRef := getRef(r.GetNode())
+	if usePod && node != nil {
+		podRef := getRef(node)
 		k := krt.Named{Name: podRef.Name, Namespace: podRef.Namespace}.ResourceName()
 		pod = x.augmentedPods.GetKey(k)
 	}
@@ -160,14 +230,14 @@ func (x *callbacksCollection) add(sid int64, r *envoy_service_discovery_v3.Disco
 		if usePod {
 			if pod == nil {
 				// we need to use the pod locality info, so it's an error if we can't get the pod
-				return "", false, fmt.Errorf("pod not found for node %v", r.GetNode())
+				return "", false, fmt.Errorf("pod not found for node %v", node)
 			} else {
 				locality = pod.Locality
 				ns = pod.Namespace
 				labels = pod.AugmentedLabels
 			}
 		}
-		role := roleFromRequest(r)
+		role := roleFromNode(node)
 		x.logger.Debug("adding xds client", "locality", locality, "ns", ns, "labels", labels, "role", role)
 		// TODO: modify request to include the label that are relevant for the client?
 		ucc := ir.NewUniqlyConnectedClient(role, ns, labels, locality)
@@ -192,7 +262,43 @@ func (x *callbacks) OnStreamRequest(sid int64, r *envoy_service_discovery_v3.Dis
 		}
 	}
 
-	role := roleFromRequest(r)
+	if err := x.streamRequest(sid, r.GetNode()); err != nil {
+		return err
+	}
+	x.streams.request(sid, r.GetNode(), xds.ProtocolSotw, r.GetTypeUrl(), r.GetVersionInfo(), r.GetResponseNonce(), r.GetErrorDetail())
+	return nil
+}
+
+// OnStreamDeltaRequest is called once a request is received on an incremental xDS stream.
+// Returning an error will end processing and close the stream. OnDeltaStreamClosed will still be called.
+func (x *callbacks) OnStreamDeltaRequest(sid int64, r *envoy_service_discovery_v3.DeltaDiscoveryRequest) error {
+	if x.extraXDSCallbacks != nil {
+		if err := x.extraXDSCallbacks.OnStreamDeltaRequest(sid, r); err != nil {
+			return err
+		}
+	}
+
+	// unlike the state-of-the-world xDS server, the incremental one only sets the node of the
+	// first request on the later requests of the stream after this callback; the role of that
+	// node was already rewritten when handling the first request
+	if r.GetNode() != nil {
+		if err := x.streamRequest(deltaStreamID(sid), r.GetNode()); err != nil {
+			return err
+		}
+	}
+	x.streams.request(deltaStreamID(sid), r.GetNode(), xds.ProtocolDelta, r.GetTypeUrl(), "", r.GetResponseNonce(), r.GetErrorDetail())
+	return nil
+}
+
+func (x *callbacks) streamRequest(sid int64, node *envoycorev3.Node) error {
+	if id, ok := x.identities.Load(sid); ok {
+		if err := xds.AuthorizeNode(id.(xds.Identity), node); err != nil {
+			logger.Warn("rejecting xds stream", "error", err)
+			return err
+		}
+	}
+
+	role := roleFromNode(node)
 	// as gloo-edge and kgateway share a control plane, check that this collection only handles kgateway clients
 	// TODO remove this check if it's no longer needed
 	if !xds.IsKubeGatewayCacheKey(role) {
@@ -202,17 +308,34 @@ func (x *callbacks) OnStreamRequest(sid int64, r *envoy_service_discovery_v3.Dis
 	if c == nil {
 		return errors.New("kgateway not initialized")
 	}
-	return c.newStream(sid, r)
+	return c.newStream(sid, node)
+}
+
+// OnStreamResponse is called immediately prior to sending a response on a stream.
+func (x *callbacks) OnStreamResponse(ctx context.Context, sid int64, req *envoy_service_discovery_v3.DiscoveryRequest, resp *envoy_service_discovery_v3.DiscoveryResponse) {
+	if x.extraXDSCallbacks != nil {
+		x.extraXDSCallbacks.OnStreamResponse(ctx, sid, req, resp)
+	}
+	xds.RecordResponse(xds.ProtocolSotw, resp.GetTypeUrl(), len(resp.GetResources()), resp)
 }
 
-func (x *callbacksCollection) newStream(sid int64, r *envoy_service_discovery_v3.DiscoveryRequest) error {
-	ucc, isNew, err := x.add(sid, r)
+// OnStreamDeltaResponse is called immediately prior to sending a response on an incremental xDS stream.
+func (x *callbacks) OnStreamDeltaResponse(sid int64, req *envoy_service_discovery_v3.DeltaDiscoveryRequest, resp *envoy_service_discovery_v3.DeltaDiscoveryResponse) {
+	if x.extraXDSCallbacks != nil {
+		x.extraXDSCallbacks.OnStreamDeltaResponse(sid, req, resp)
+	}
+	x.streams.response(deltaStreamID(sid), resp.GetTypeUrl(), resp.GetNonce(), resp.GetSystemVersionInfo())
+	xds.RecordResponse(xds.ProtocolDelta, resp.GetTypeUrl(), len(resp.GetResources()), resp)
+}
+
+func (x *callbacksCollection) newStream(sid int64, node *envoycorev3.Node) error {
+	ucc, isNew, err := x.add(sid, node)
 	if err != nil {
 		x.logger.Debug("error processing xds client", "error", err)
 		return err
 	}
 	if ucc != "" {
-		nodeMd := r.GetNode().GetMetadata()
+		nodeMd := node.GetMetadata()
 		if nodeMd == nil {
 			nodeMd = &structpb.Struct{}
 		}
@@ -225,7 +348,7 @@ func (x *callbacksCollection) newStream(sid int64, r *envoy_service_discovery_v3
 		// with how the snapshot is inserted to the cache for the proxy - it needs to be done with
 		// the unique client resource name as well.
 		nodeMd.GetFields()[xds.RoleKey] = structpb.NewStringValue(ucc)
-		r.GetNode().Metadata = nodeMd
+		node.Metadata = nodeMd
 		if isNew {
 			x.trigger.TriggerRecomputation()
 		}
@@ -252,6 +375,13 @@ func (x *callbacks) OnFetchRequest(ctx context.Context, r *envoy_service_discove
 		}
 	}
 
+	if id, ok := xds.IdentityFromContext(ctx); ok {
+		if err := xds.AuthorizeNode(id, r.GetNode()); err != nil {
+			logger.Warn("rejecting xds fetch request", "error", err)
+			return err
+		}
+	}
+
 	role := r.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
 	// as gloo-edge and kgateway share a control plane, check that this collection only handles kgateway clients
 	// TODO remove this check if it's no longer needed
@@ -275,7 +405,7 @@ func (x *callbacksCollection) fetchRequest(_ context.Context, r *envoy_service_d
 	podRef := getRef(r.GetNode())
 	k := krt.Named{Name: podRef.Name, Namespace: podRef.Namespace}.ResourceName()
 	pod = x.augmentedPods.GetKey(k)
-	ucc := ir.NewUniqlyConnectedClient(roleFromRequest(r), pod.Namespace, pod.AugmentedLabels, pod.Locality)
+	ucc := ir.NewUniqlyConnectedClient(roleFromNode(r.GetNode()), pod.Namespace, pod.AugmentedLabels, pod.Locality)
 
 	nodeMd := r.GetNode().GetMetadata()
 	if nodeMd == nil {
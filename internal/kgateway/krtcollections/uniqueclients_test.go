@@ -8,12 +8,14 @@ import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/kube/krt/krttest"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
@@ -188,7 +190,7 @@ func TestUniqueClients(t *testing.T) {
 				pods.WaitUntilSynced(context.Background().Done())
 			}
 
-			cb, uccBuilder := NewUniquelyConnectedClients(nil)
+			cb, uccBuilder, _ := NewUniquelyConnectedClients(nil)
 			ucc := uccBuilder(context.Background(), krtinternal.KrtOptions{}, pods)
 			ucc.WaitUntilSynced(context.Background().Done())
 
@@ -242,3 +244,133 @@ func TestUniqueClients(t *testing.T) {
 		})
 	}
 }
+
+func TestUniqueClientsAuthorization(t *testing.T) {
+	g := NewWithT(t)
+
+	cb, uccBuilder, _ := NewUniquelyConnectedClients(nil)
+	ucc := uccBuilder(context.Background(), krtinternal.KrtOptions{}, nil)
+	ucc.WaitUntilSynced(context.Background().Done())
+
+	request := func(gwNamespace, gwName string) *envoy_service_discovery_v3.DiscoveryRequest {
+		return &envoy_service_discovery_v3.DiscoveryRequest{
+			Node: &envoycorev3.Node{
+				Id: "podname.ns",
+				Metadata: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						xds.RoleKey: structpb.NewStringValue(wellknown.GatewayApiProxyValue + "~" + gwNamespace + "~" + gwName),
+					},
+				},
+			},
+		}
+	}
+	ctx := xds.WithIdentity(context.Background(), xds.Identity{Namespace: "ns", ServiceAccount: "gw"})
+
+	// the identity of a stream is bound to the Gateway of its ServiceAccount
+	g.Expect(cb.OnStreamOpen(ctx, 1, "")).To(Succeed())
+	g.Expect(cb.OnStreamRequest(1, request("ns", "gw"))).To(Succeed())
+	g.Expect(cb.OnStreamOpen(ctx, 2, "")).To(Succeed())
+	g.Expect(cb.OnStreamRequest(2, request("ns", "other-gw"))).NotTo(Succeed())
+	g.Expect(cb.OnFetchRequest(ctx, request("ns", "gw"))).To(Succeed())
+	g.Expect(cb.OnFetchRequest(ctx, request("other-ns", "gw"))).NotTo(Succeed())
+
+	// streams without an identity are not authenticated by the xDS server
+	g.Expect(cb.OnStreamOpen(context.Background(), 3, "")).To(Succeed())
+	g.Expect(cb.OnStreamRequest(3, request("ns", "other-gw"))).To(Succeed())
+	g.Expect(cb.OnFetchRequest(context.Background(), request("ns", "other-gw"))).To(Succeed())
+
+	// the identity is forgotten once the stream is closed
+	cb.OnStreamClosed(2, nil)
+	g.Expect(cb.OnStreamRequest(2, request("ns", "other-gw"))).To(Succeed())
+}
+
+func TestUniqueClientsDeltaStreams(t *testing.T) {
+	g := NewWithT(t)
+
+	cb, uccBuilder, _ := NewUniquelyConnectedClients(nil)
+	ucc := uccBuilder(context.Background(), krtinternal.KrtOptions{}, nil)
+	ucc.WaitUntilSynced(context.Background().Done())
+
+	node := &envoycorev3.Node{
+		Id: "podname.ns",
+		Metadata: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				xds.RoleKey: structpb.NewStringValue(wellknown.GatewayApiProxyValue + "~ns~gw"),
+			},
+		},
+	}
+
+	// the state-of-the-world and incremental xDS servers number their streams independently
+	g.Expect(cb.OnStreamOpen(context.Background(), 1, "")).To(Succeed())
+	g.Expect(cb.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{Node: proto.Clone(node).(*envoycorev3.Node)})).To(Succeed())
+	g.Expect(cb.OnDeltaStreamOpen(context.Background(), 1, "")).To(Succeed())
+	deltaReq := &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: proto.Clone(node).(*envoycorev3.Node)}
+	g.Expect(cb.OnStreamDeltaRequest(1, deltaReq)).To(Succeed())
+	g.Expect(deltaReq.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()).To(Equal(wellknown.GatewayApiProxyValue + "~ns~gw"))
+	// later requests of an incremental stream may omit the node
+	g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{})).To(Succeed())
+
+	g.Eventually(ucc.List, "1s").Should(HaveLen(1))
+
+	// the client stays connected until both streams are closed
+	cb.OnStreamClosed(1, nil)
+	g.Consistently(ucc.List, "100ms").Should(HaveLen(1))
+	cb.OnDeltaStreamClosed(1, nil)
+	g.Eventually(ucc.List, "1s").Should(BeEmpty())
+}
+
+func TestUniqueClientsXdsStreams(t *testing.T) {
+	g := NewWithT(t)
+
+	cb, uccBuilder, streamsBuilder := NewUniquelyConnectedClients(nil)
+	ucc := uccBuilder(context.Background(), krtinternal.KrtOptions{}, nil)
+	ucc.WaitUntilSynced(context.Background().Done())
+	streams := streamsBuilder(krtinternal.KrtOptions{})
+	streams.WaitUntilSynced(context.Background().Done())
+
+	const listenerType = "type.googleapis.com/envoy.config.listener.v3.Listener"
+	node := &envoycorev3.Node{
+		Id: "podname.ns",
+		Metadata: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				xds.RoleKey: structpb.NewStringValue(wellknown.GatewayApiProxyValue + "~ns~gw"),
+			},
+		},
+	}
+	nack := &status.Status{Message: "invalid listener"}
+
+	// the state-of-the-world requests carry the version the proxy last accepted
+	g.Expect(cb.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{Node: node, TypeUrl: listenerType})).To(Succeed())
+	g.Expect(cb.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{Node: node, TypeUrl: listenerType, VersionInfo: "1", ResponseNonce: "1"})).To(Succeed())
+	g.Expect(cb.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{Node: node, TypeUrl: listenerType, VersionInfo: "1", ResponseNonce: "2", ErrorDetail: nack})).To(Succeed())
+	g.Eventually(streams.List, "1s").Should(ConsistOf(And(
+		HaveField("Gateway", types.NamespacedName{Namespace: "ns", Name: "gw"}),
+		HaveField("NodeID", "podname.ns"),
+		HaveField("Versions", map[string]string{listenerType: "1"}),
+		HaveField("Nacks", map[string]XdsNack{listenerType: {TypeUrl: listenerType, Message: "invalid listener"}}),
+	)))
+
+	// the next response of the type is accepted
+	g.Expect(cb.OnStreamRequest(1, &envoy_service_discovery_v3.DiscoveryRequest{Node: node, TypeUrl: listenerType, VersionInfo: "3", ResponseNonce: "3"})).To(Succeed())
+	g.Eventually(streams.List, "1s").Should(ConsistOf(And(
+		HaveField("Versions", map[string]string{listenerType: "3"}),
+		HaveField("Nacks", BeEmpty()),
+	)))
+
+	// the incremental requests reference the response they ACK by its nonce, and may omit the node
+	g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{Node: node, TypeUrl: listenerType})).To(Succeed())
+	cb.OnStreamDeltaResponse(1, nil, &envoy_service_discovery_v3.DeltaDiscoveryResponse{TypeUrl: listenerType, SystemVersionInfo: "4", Nonce: "1"})
+	g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{TypeUrl: listenerType, ResponseNonce: "1"})).To(Succeed())
+	g.Eventually(streams.List, "1s").Should(ContainElement(HaveField("Versions", map[string]string{listenerType: "4"})))
+	cb.OnStreamDeltaResponse(1, nil, &envoy_service_discovery_v3.DeltaDiscoveryResponse{TypeUrl: listenerType, SystemVersionInfo: "5", Nonce: "2"})
+	g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{TypeUrl: listenerType, ResponseNonce: "2", ErrorDetail: nack})).To(Succeed())
+	g.Eventually(streams.List, "1s").Should(ContainElement(And(
+		HaveField("Versions", map[string]string{listenerType: "4"}),
+		HaveField("Nacks", HaveLen(1)),
+	)))
+
+	// the streams are forgotten once closed
+	cb.OnStreamClosed(1, nil)
+	cb.OnDeltaStreamClosed(1, nil)
+	g.Eventually(streams.List, "1s").Should(BeEmpty())
+}
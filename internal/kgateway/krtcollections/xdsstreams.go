@@ -0,0 +1,219 @@
+package krtcollections
+
+import (
+	"maps"
+	"strconv"
+	"strings"
+	"sync"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
+
+	krtinternal "github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+)
+
+// XdsStream is the state of the xDS stream of a proxy of a Gateway: the config the proxy accepted
+// (ACKed) and the config it rejected (NACKed). The proxy keeps serving the last config of a type
+// it accepted until it accepts a new response of that type.
+type XdsStream struct {
+	// Gateway is the Gateway the proxy is programmed for.
+	Gateway types.NamespacedName
+	// NodeID is the ID of the proxy node, i.e. <pod name>.<pod namespace>.
+	NodeID string
+	// Versions are the versions of the config the proxy accepted, by type URL.
+	Versions map[string]string
+	// Nacks are the responses the proxy rejected since it last accepted a response of their type,
+	// by type URL.
+	Nacks map[string]XdsNack
+
+	streamID int64
+}
+
+// XdsNack is an xDS response rejected (NACKed) by a proxy.
+type XdsNack struct {
+	// TypeUrl is the type of the rejected resources.
+	TypeUrl string
+	// Message is the error reported by the proxy.
+	Message string
+}
+
+func (s XdsStream) ResourceName() string {
+	return strconv.FormatInt(s.streamID, 10)
+}
+
+func (s XdsStream) Equals(in XdsStream) bool {
+	return s.Gateway == in.Gateway &&
+		s.NodeID == in.NodeID &&
+		s.streamID == in.streamID &&
+		maps.Equal(s.Versions, in.Versions) &&
+		maps.Equal(s.Nacks, in.Nacks)
+}
+
+// XdsStreamsBuilder builds the collection of the xDS streams of the proxies of Gateways connected to
+// this control plane.
+type XdsStreamsBuilder func(krtOpts krtinternal.KrtOptions) krt.Collection[XdsStream]
+
+// trackedStream is the state of an xDS stream of a proxy.
+type trackedStream struct {
+	gateway types.NamespacedName
+	nodeID  string
+	// sent are the last responses sent on the incremental stream, by type URL
+	sent     map[string]sentResponse
+	versions map[string]string
+	nacks    map[string]XdsNack
+}
+
+type sentResponse struct {
+	nonce   string
+	version string
+}
+
+// xdsStreams tracks the config accepted and rejected by the proxies from the requests they send on
+// their xDS streams: a request with an error detail NACKs the response of its nonce, and a request
+// without one ACKs it. Only the streams connected to this replica are tracked, which is why the
+// gateway programmed modes based on ACKs are refused with several leader-elected replicas.
+type xdsStreams struct {
+	streams map[int64]*trackedStream
+	lock    sync.RWMutex
+
+	trigger *krt.RecomputeTrigger
+}
+
+func newXdsStreams() *xdsStreams {
+	return &xdsStreams{
+		streams: make(map[int64]*trackedStream),
+		trigger: krt.NewRecomputeTrigger(true),
+	}
+}
+
+func (x *xdsStreams) builder() XdsStreamsBuilder {
+	return func(krtOpts krtinternal.KrtOptions) krt.Collection[XdsStream] {
+		return krt.NewManyFromNothing(
+			func(ctx krt.HandlerContext) []XdsStream {
+				x.trigger.MarkDependant(ctx)
+
+				return x.list()
+			},
+			krtOpts.ToOptions("XdsStreams")...,
+		)
+	}
+}
+
+// request records a request on the stream, which ACKs or NACKs the response of its nonce. The node
+// is only needed on the first request of the stream, as it identifies the Gateway of the proxy.
+// The version is the version the proxy last accepted, which is only set by the state-of-the-world
+// requests; the accepted version of the incremental streams is the one of the response ACKed.
+func (x *xdsStreams) request(sid int64, node *envoycorev3.Node, protocol, typeURL, version, nonce string, errorDetail *status.Status) {
+	x.lock.Lock()
+	s := x.stream(sid, node)
+	if s == nil {
+		x.lock.Unlock()
+		return
+	}
+
+	var changed bool
+	if errorDetail != nil {
+		nack := XdsNack{
+			TypeUrl: typeURL,
+			Message: errorDetail.GetMessage(),
+		}
+		changed = s.nacks[typeURL] != nack
+		s.nacks[typeURL] = nack
+	} else {
+		// only the last response sent is tracked, older ones were superseded before being ACKed
+		if sent, ok := s.sent[typeURL]; ok && nonce != "" && sent.nonce == nonce {
+			version = sent.version
+		}
+		if version != "" && s.versions[typeURL] != version {
+			s.versions[typeURL] = version
+			changed = true
+		}
+		if _, ok := s.nacks[typeURL]; ok && nonce != "" {
+			delete(s.nacks, typeURL)
+			changed = true
+		}
+	}
+	x.lock.Unlock()
+
+	if errorDetail != nil {
+		logger.Warn("proxy rejected xds config", "node", s.nodeID, "gateway", s.gateway, "type", typeURL, "error", errorDetail.GetMessage())
+		xds.RecordNack(protocol, s.gateway.Namespace, s.gateway.Name, typeURL)
+	}
+	if changed {
+		x.trigger.TriggerRecomputation()
+	}
+}
+
+// response records the version of a response sent on an incremental stream, so it can be matched
+// with the request ACKing it.
+func (x *xdsStreams) response(sid int64, typeURL, nonce, version string) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	if s, ok := x.streams[sid]; ok {
+		s.sent[typeURL] = sentResponse{nonce: nonce, version: version}
+	}
+}
+
+// stream returns the state of the stream, which starts being tracked on its first request if the
+// node is a proxy of a Gateway. It must be called with the lock held.
+func (x *xdsStreams) stream(sid int64, node *envoycorev3.Node) *trackedStream {
+	if s, ok := x.streams[sid]; ok || node == nil {
+		return s
+	}
+	gateway, ok := gatewayFromRole(roleFromNode(node))
+	if !ok {
+		return nil
+	}
+	s := &trackedStream{
+		gateway:  gateway,
+		nodeID:   node.GetId(),
+		sent:     make(map[string]sentResponse),
+		versions: make(map[string]string),
+		nacks:    make(map[string]XdsNack),
+	}
+	x.streams[sid] = s
+	return s
+}
+
+func (x *xdsStreams) streamClosed(sid int64) {
+	x.lock.Lock()
+	_, ok := x.streams[sid]
+	delete(x.streams, sid)
+	x.lock.Unlock()
+
+	if ok {
+		x.trigger.TriggerRecomputation()
+	}
+}
+
+func (x *xdsStreams) list() []XdsStream {
+	x.lock.RLock()
+	defer x.lock.RUnlock()
+	streams := make([]XdsStream, 0, len(x.streams))
+	for sid, s := range x.streams {
+		streams = append(streams, XdsStream{
+			Gateway:  s.gateway,
+			NodeID:   s.nodeID,
+			Versions: maps.Clone(s.versions),
+			Nacks:    maps.Clone(s.nacks),
+			streamID: sid,
+		})
+	}
+	return streams
+}
+
+// gatewayFromRole returns the Gateway of the role of a kgateway proxy, i.e.
+// kgateway-kube-gateway-api~<namespace>~<name>, which may be augmented with the pod locality.
+func gatewayFromRole(role string) (types.NamespacedName, bool) {
+	if !xds.IsKubeGatewayCacheKey(role) {
+		return types.NamespacedName{}, false
+	}
+	parts := strings.Split(role, xds.KeyDelimiter)
+	if len(parts) < 3 {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: parts[1], Name: parts[2]}, true
+}
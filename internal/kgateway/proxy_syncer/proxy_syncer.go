@@ -22,6 +22,7 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/extensions2/common"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/irtranslator"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
@@ -31,6 +32,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
 	plug "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+	"github.com/kgateway-dev/kgateway/v2/pkg/settings"
 )
 
 var _ manager.LeaderElectionRunnable = &ProxySyncer{}
@@ -54,6 +56,7 @@ type ProxySyncer struct {
 	proxyTranslator ProxyTranslator
 
 	uniqueClients krt.Collection[ir.UniqlyConnectedClient]
+	xdsStreams    krt.Collection[krtcollections.XdsStream]
 
 	statusReport            krt.Singleton[report]
 	backendPolicyReport     krt.Singleton[report]
@@ -135,6 +138,7 @@ func NewProxySyncer(
 	mgr manager.Manager,
 	client kube.Client,
 	uniqueClients krt.Collection[ir.UniqlyConnectedClient],
+	xdsStreams krt.Collection[krtcollections.XdsStream],
 	mergedPlugins plug.Plugin,
 	commonCols *common.CommonCollections,
 	xdsCache envoycache.SnapshotCache,
@@ -148,6 +152,7 @@ func NewProxySyncer(
 		istioClient:              client,
 		proxyTranslator:          NewProxyTranslator(xdsCache),
 		uniqueClients:            uniqueClients,
+		xdsStreams:               xdsStreams,
 		translator:               translator.NewCombinedTranslator(ctx, mergedPlugins, commonCols),
 		plugins:                  mergedPlugins,
 		reportQueue:              utils.NewAsyncQueue[reports.ReportMap](),
@@ -258,6 +263,13 @@ func (s *ProxySyncer) Init(ctx context.Context, krtopts krtinternal.KrtOptions)
 	s.statusReport = krt.NewSingleton(func(kctx krt.HandlerContext) *report {
 		proxies := krt.Fetch(kctx, s.mostXdsSnapshots)
 		merged := mergeProxyReports(proxies)
+		if s.xdsStreams != nil {
+			streams := krt.Fetch(kctx, s.xdsStreams)
+			reportXdsNacks(merged, streams)
+			if mode := s.commonCols.Settings.GatewayProgrammedMode; mode == settings.GatewayProgrammedAnyProxyAck || mode == settings.GatewayProgrammedAllProxiesAck {
+				reportXdsAcks(merged, proxies, streams, mode == settings.GatewayProgrammedAllProxiesAck)
+			}
+		}
 		return &report{merged}
 	})
 
@@ -0,0 +1,131 @@
+package proxy_syncer
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	envoyresourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+// reportXdsNacks sets the Programmed condition of the Gateways whose proxies rejected their xDS
+// config to False, as the proxies keep serving the last config they accepted.
+//
+// The status of the routes is left as is: a proxy rejects all the resources of a response, e.g. a
+// RouteConfiguration merging the routes of every listener of the Gateway, so a NACK cannot be
+// attributed to a route, and the route conditions (Accepted, ResolvedRefs) describe the route
+// itself rather than whether the proxies serve it.
+func reportXdsNacks(merged reports.ReportMap, streams []krtcollections.XdsStream) {
+	type nack struct {
+		nodeID string
+		krtcollections.XdsNack
+	}
+	nacksByGateway := make(map[types.NamespacedName][]nack)
+	for _, s := range streams {
+		for _, n := range s.Nacks {
+			nacksByGateway[s.Gateway] = append(nacksByGateway[s.Gateway], nack{nodeID: s.NodeID, XdsNack: n})
+		}
+	}
+
+	for gw, gwNacks := range nacksByGateway {
+		gwReport := merged.Gateways[gw]
+		if gwReport == nil {
+			continue
+		}
+
+		slices.SortFunc(gwNacks, func(a, b nack) int {
+			return cmp.Or(strings.Compare(a.nodeID, b.nodeID), strings.Compare(a.TypeUrl, b.TypeUrl))
+		})
+		msg := fmt.Sprintf("Proxy %s rejected the xDS config of type %s: %s", gwNacks[0].nodeID, gwNacks[0].TypeUrl, gwNacks[0].Message)
+		if len(gwNacks) > 1 {
+			msg += fmt.Sprintf(" (and %d more rejections)", len(gwNacks)-1)
+		}
+
+		// the report is shared with the xDS snapshot of the Gateway, so it is cloned before being modified
+		gwReport = gwReport.Clone()
+		gwReport.SetCondition(reporter.GatewayCondition{
+			Type:    gwv1.GatewayConditionProgrammed,
+			Status:  metav1.ConditionFalse,
+			Reason:  reporter.GatewayRejectedReason,
+			Message: msg,
+		})
+		merged.Gateways[gw] = gwReport
+	}
+}
+
+// reportXdsAcks sets the Programmed condition of the Gateways to True once any or all of their
+// proxies accepted the config of their latest xDS snapshot, and to False until then. The Gateways
+// already reported as not programmed are left as is.
+func reportXdsAcks(merged reports.ReportMap, proxies []GatewayXdsResources, streams []krtcollections.XdsStream, allProxies bool) {
+	// the versions accepted by the proxies of each Gateway, by node ID; a proxy not using ADS
+	// has a stream per type of resource
+	versionsByGateway := make(map[types.NamespacedName]map[string]map[string]string)
+	for _, s := range streams {
+		if versionsByGateway[s.Gateway] == nil {
+			versionsByGateway[s.Gateway] = make(map[string]map[string]string)
+		}
+		if versionsByGateway[s.Gateway][s.NodeID] == nil {
+			versionsByGateway[s.Gateway][s.NodeID] = make(map[string]string)
+		}
+		maps.Copy(versionsByGateway[s.Gateway][s.NodeID], s.Versions)
+	}
+
+	for _, p := range proxies {
+		gwReport := merged.Gateways[p.NamespacedName]
+		if gwReport == nil || meta.FindStatusCondition(gwReport.GetConditions(), string(gwv1.GatewayConditionProgrammed)) != nil {
+			continue
+		}
+
+		proxyVersions := versionsByGateway[p.NamespacedName]
+		var acks int
+		for _, versions := range proxyVersions {
+			if p.acceptedBy(versions) {
+				acks++
+			}
+		}
+
+		version := p.snapshotVersion()
+		cond := reporter.GatewayCondition{
+			Type:    gwv1.GatewayConditionProgrammed,
+			Status:  metav1.ConditionFalse,
+			Reason:  gwv1.GatewayReasonPending,
+			Message: fmt.Sprintf("Waiting for the proxies to accept xDS snapshot version %s: accepted by %d of %d proxies", version, acks, len(proxyVersions)),
+		}
+		if acks > 0 && (!allProxies || acks == len(proxyVersions)) {
+			cond.Status = metav1.ConditionTrue
+			cond.Reason = gwv1.GatewayReasonProgrammed
+			cond.Message = fmt.Sprintf("Gateway is programmed with xDS snapshot version %s: accepted by %d of %d proxies", version, acks, len(proxyVersions))
+		}
+
+		// the report is shared with the xDS snapshot of the Gateway, so it is cloned before being modified
+		gwReport = gwReport.Clone()
+		gwReport.SetCondition(cond)
+		merged.Gateways[p.NamespacedName] = gwReport
+	}
+}
+
+// snapshotVersion returns the version of the config of the Gateway in the xDS snapshots of its
+// proxies, i.e. the versions of its listeners and routes.
+func (r GatewayXdsResources) snapshotVersion() string {
+	return r.Listeners.Version + "-" + r.Routes.Version
+}
+
+// acceptedBy returns whether a proxy accepted the listeners and routes of the Gateway, given the
+// versions it accepted by type URL.
+func (r GatewayXdsResources) acceptedBy(versions map[string]string) bool {
+	if versions[envoyresourcev3.ListenerType] != r.Listeners.Version {
+		return false
+	}
+	// the proxy only requests the routes referenced by its listeners
+	return len(r.Routes.Items) == 0 || versions[envoyresourcev3.RouteType] == r.Routes.Version
+}
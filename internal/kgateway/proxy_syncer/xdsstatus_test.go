@@ -0,0 +1,163 @@
+package proxy_syncer
+
+import (
+	"maps"
+	"testing"
+
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyresourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+func TestReportXdsNacks(t *testing.T) {
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "ns"}}
+	otherGw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "other-gw", Namespace: "ns"}}
+	rm := reports.NewReportMap()
+	r := reports.NewReporter(&rm)
+	r.Gateway(gw)
+	r.Gateway(otherGw)
+	gwReport := rm.Gateway(gw)
+
+	nacks := map[string]krtcollections.XdsNack{
+		envoyresourcev3.ListenerType: {TypeUrl: envoyresourcev3.ListenerType, Message: "invalid listener"},
+	}
+	merged := reports.NewReportMap()
+	maps.Copy(merged.Gateways, rm.Gateways)
+	reportXdsNacks(merged, []krtcollections.XdsStream{
+		{Gateway: types.NamespacedName{Namespace: "ns", Name: "gw"}, NodeID: "pod-b.ns", Nacks: nacks},
+		{Gateway: types.NamespacedName{Namespace: "ns", Name: "gw"}, NodeID: "pod-a.ns", Nacks: nacks},
+		{Gateway: types.NamespacedName{Namespace: "ns", Name: "other-gw"}, NodeID: "pod-c.ns"},
+		{Gateway: types.NamespacedName{Namespace: "ns", Name: "deleted-gw"}, NodeID: "pod-d.ns", Nacks: nacks},
+	})
+
+	cond := meta.FindStatusCondition(merged.Gateway(gw).GetConditions(), string(gwv1.GatewayConditionProgrammed))
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, "Rejected", cond.Reason)
+		assert.Equal(t, "Proxy pod-a.ns rejected the xDS config of type type.googleapis.com/envoy.config.listener.v3.Listener: invalid listener (and 1 more rejections)", cond.Message)
+	}
+	// the report of the translation is not modified
+	assert.Empty(t, gwReport.GetConditions())
+	assert.Same(t, rm.Gateway(otherGw), merged.Gateway(otherGw))
+	assert.Len(t, merged.Gateways, 2)
+}
+
+func TestReportXdsAcks(t *testing.T) {
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "ns"}}
+	gwNN := types.NamespacedName{Namespace: "ns", Name: "gw"}
+	resources := GatewayXdsResources{
+		NamespacedName: gwNN,
+		Listeners:      sliceToResources([]*envoylistenerv3.Listener{{Name: "http"}}),
+		Routes:         sliceToResources([]*envoyroutev3.RouteConfiguration{{Name: "http"}}),
+	}
+	accepted := map[string]string{
+		envoyresourcev3.ListenerType: resources.Listeners.Version,
+		envoyresourcev3.RouteType:    resources.Routes.Version,
+		envoyresourcev3.ClusterType:  "1",
+	}
+	outdated := map[string]string{
+		envoyresourcev3.ListenerType: resources.Listeners.Version,
+		envoyresourcev3.RouteType:    "outdated",
+	}
+
+	testCases := []struct {
+		name       string
+		streams    []krtcollections.XdsStream
+		allProxies bool
+		status     metav1.ConditionStatus
+		message    string
+	}{
+		{
+			name:    "no proxy",
+			status:  metav1.ConditionFalse,
+			message: "Waiting for the proxies to accept xDS snapshot version " + resources.snapshotVersion() + ": accepted by 0 of 0 proxies",
+		},
+		{
+			name: "any proxy accepted",
+			streams: []krtcollections.XdsStream{
+				{Gateway: gwNN, NodeID: "pod-a.ns", Versions: accepted},
+				{Gateway: gwNN, NodeID: "pod-b.ns", Versions: outdated},
+			},
+			status:  metav1.ConditionTrue,
+			message: "Gateway is programmed with xDS snapshot version " + resources.snapshotVersion() + ": accepted by 1 of 2 proxies",
+		},
+		{
+			name: "not all proxies accepted",
+			streams: []krtcollections.XdsStream{
+				{Gateway: gwNN, NodeID: "pod-a.ns", Versions: accepted},
+				{Gateway: gwNN, NodeID: "pod-b.ns", Versions: outdated},
+			},
+			allProxies: true,
+			status:     metav1.ConditionFalse,
+			message:    "Waiting for the proxies to accept xDS snapshot version " + resources.snapshotVersion() + ": accepted by 1 of 2 proxies",
+		},
+		{
+			name: "all proxies accepted on streams per type",
+			streams: []krtcollections.XdsStream{
+				{Gateway: gwNN, NodeID: "pod-a.ns", Versions: accepted},
+				{Gateway: gwNN, NodeID: "pod-b.ns", Versions: map[string]string{envoyresourcev3.ListenerType: resources.Listeners.Version}},
+				{Gateway: gwNN, NodeID: "pod-b.ns", Versions: map[string]string{envoyresourcev3.RouteType: resources.Routes.Version}},
+				{Gateway: types.NamespacedName{Namespace: "ns", Name: "other-gw"}, NodeID: "pod-c.ns", Versions: outdated},
+			},
+			allProxies: true,
+			status:     metav1.ConditionTrue,
+			message:    "Gateway is programmed with xDS snapshot version " + resources.snapshotVersion() + ": accepted by 2 of 2 proxies",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rm := reports.NewReportMap()
+			reports.NewReporter(&rm).Gateway(gw)
+
+			merged := reports.NewReportMap()
+			maps.Copy(merged.Gateways, rm.Gateways)
+			reportXdsAcks(merged, []GatewayXdsResources{resources}, tc.streams, tc.allProxies)
+
+			cond := meta.FindStatusCondition(merged.Gateway(gw).GetConditions(), string(gwv1.GatewayConditionProgrammed))
+			if assert.NotNil(t, cond) {
+				assert.Equal(t, tc.status, cond.Status)
+				assert.Equal(t, tc.message, cond.Message)
+			}
+			assert.Empty(t, rm.Gateway(gw).GetConditions())
+		})
+	}
+}
+
+func TestReportXdsAcksKeepsNotProgrammed(t *testing.T) {
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "ns"}}
+	resources := GatewayXdsResources{
+		NamespacedName: types.NamespacedName{Namespace: "ns", Name: "gw"},
+		Listeners:      sliceToResources([]*envoylistenerv3.Listener{{Name: "tcp"}}),
+		Routes:         sliceToResources([]*envoyroutev3.RouteConfiguration{}),
+	}
+	rm := reports.NewReportMap()
+	reports.NewReporter(&rm).Gateway(gw).SetCondition(reporter.GatewayCondition{
+		Type:   gwv1.GatewayConditionProgrammed,
+		Status: metav1.ConditionFalse,
+		Reason: gwv1.GatewayReasonInvalid,
+	})
+
+	reportXdsAcks(rm, []GatewayXdsResources{resources}, []krtcollections.XdsStream{
+		{
+			Gateway:  resources.NamespacedName,
+			NodeID:   "pod-a.ns",
+			Versions: map[string]string{envoyresourcev3.ListenerType: resources.Listeners.Version},
+		},
+	}, false)
+
+	cond := meta.FindStatusCondition(rm.Gateway(gw).GetConditions(), string(gwv1.GatewayConditionProgrammed))
+	if assert.NotNil(t, cond) {
+		assert.Equal(t, metav1.ConditionFalse, cond.Status)
+		assert.Equal(t, string(gwv1.GatewayReasonInvalid), cond.Reason)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+	kgwutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
 	delegationutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils/delegation"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 )
@@ -372,6 +373,14 @@ func (r *gatewayQueries) GetRoutesForGateway(kctx krt.HandlerContext, ctx contex
 		routes.merge(lsRoutes)
 	}
 
+	for _, mergedGw := range gw.MergedGateways {
+		mergedRoutes, err := r.GetRoutesForResource(kctx, ctx, mergedGw)
+		if err != nil {
+			return nil, err
+		}
+		routes.merge(mergedRoutes)
+	}
+
 	return routes, nil
 }
 
@@ -379,7 +388,9 @@ func GenerateRouteKey(parent client.Object, listenerName string) string {
 	if parent == nil {
 		return listenerName
 	}
-	if _, ok := parent.(*gwv1.Gateway); ok {
+	// the listeners of gateways merged into another one share its proxy, so they
+	// are qualified by the gateway like the listeners of ListenerSets
+	if gw, ok := parent.(*gwv1.Gateway); ok && kgwutils.MergeIntoGatewayName(gw) == "" {
 		return listenerName
 	}
 	return fmt.Sprintf("%s/%s/%s", parent.GetNamespace(), parent.GetName(), listenerName)
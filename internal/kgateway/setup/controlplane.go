@@ -19,9 +19,16 @@ import (
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+	"github.com/kgateway-dev/kgateway/v2/pkg/settings"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/namespaces"
 )
 
 // slogAdapterForEnvoy adapts *slog.Logger to envoylog.Logger interface
@@ -56,7 +63,9 @@ func (s *slogAdapterForEnvoy) Errorf(format string, args ...interface{}) {
 	}
 }
 
-func NewControlPlane(ctx context.Context, lis net.Listener, callbacks xdsserver.Callbacks) envoycache.SnapshotCache {
+// NewControlPlane starts the xDS server on the listener. The extra server options are applied after
+// the default ones, e.g. to serve over TLS or to chain more stream interceptors.
+func NewControlPlane(ctx context.Context, lis net.Listener, callbacks xdsserver.Callbacks, extraOpts ...grpc.ServerOption) envoycache.SnapshotCache {
 	baseLogger := slog.Default().With("component", "envoy-controlplane")
 	envoyLoggerAdapter := &slogAdapterForEnvoy{logger: baseLogger}
 
@@ -72,6 +81,7 @@ func NewControlPlane(ctx context.Context, lis net.Listener, callbacks xdsserver.
 				},
 			)),
 	}
+	serverOpts = append(serverOpts, extraOpts...)
 	grpcServer := grpc.NewServer(serverOpts...)
 
 	snapshotCache := envoycache.NewSnapshotCache(true, xds.NewNodeRoleHasher(), envoyLoggerAdapter)
@@ -94,3 +104,58 @@ func NewControlPlane(ctx context.Context, lis net.Listener, callbacks xdsserver.
 
 	return snapshotCache
 }
+
+// newXdsSecurityOptions returns the server options serving the xDS server over TLS and
+// authenticating the proxies, as configured by the settings, and the provider of the
+// certificate of the xDS server. It returns no options and a nil provider when TLS is disabled.
+func newXdsSecurityOptions(ctx context.Context, restConfig *rest.Config, s *settings.Settings) ([]grpc.ServerOption, xds.CertProvider, error) {
+	if !s.EnableXdsTls {
+		return nil, nil, nil
+	}
+
+	kube, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	namespace := namespaces.GetPodNamespace()
+	var provider xds.CertProvider
+	if s.XdsTlsSecretName != "" {
+		provider, err = xds.NewSecretCertProvider(ctx, kube, namespace, s.XdsTlsSecretName)
+	} else {
+		provider, err = xds.NewSelfSignedCertProvider(ctx, kube, namespace, s.XdsServiceName+"-xds-ca", xdsServerDNSNames(s, namespace))
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load the certificate of the xDS server: %w", err)
+	}
+
+	var clientCA xds.CAProvider
+	if s.EnableXdsAuth && s.XdsClientCaSecretName != "" {
+		clientCA, err = xds.NewSecretCAProvider(ctx, kube, namespace, s.XdsClientCaSecretName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load the CA of the client certificates of the proxies: %w", err)
+		}
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(xds.ServerTLSConfig(provider, clientCA))),
+	}
+	if s.EnableXdsAuth {
+		opts = append(opts, grpc.ChainStreamInterceptor(xds.NewAuthenticator(kube, s.XdsTrustDomain).StreamServerInterceptor()))
+	}
+	return opts, provider, nil
+}
+
+// xdsServerDNSNames returns the names the proxies may use to reach the xDS server.
+func xdsServerDNSNames(s *settings.Settings, namespace string) []string {
+	var names []string
+	if s.XdsServiceHost != "" {
+		names = append(names, s.XdsServiceHost)
+	}
+	return append(names,
+		kubeutils.ServiceFQDN(metav1.ObjectMeta{Name: s.XdsServiceName, Namespace: namespace}),
+		s.XdsServiceName+"."+namespace+".svc",
+		s.XdsServiceName+"."+namespace,
+		s.XdsServiceName,
+	)
+}
@@ -2,6 +2,8 @@ package setup
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net"
 
@@ -173,6 +175,27 @@ func New(opts ...func(*setup)) (*setup, error) {
 		}
 	}
 
+	if s.globalSettings.EnableXdsAuth && !s.globalSettings.EnableXdsTls {
+		err := errors.New("xDS authentication requires xDS TLS to be enabled")
+		slog.Error("invalid settings", "error", err)
+		return nil, err
+	}
+
+	if s.globalSettings.EnableXdsTls && s.globalSettings.EnableAgentGateway {
+		// agentgateway connects to the xDS server in plaintext and without a token
+		err := errors.New("xDS TLS can't be enabled with agentgateway, which doesn't support TLS to the xDS server")
+		slog.Error("invalid settings", "error", err)
+		return nil, err
+	}
+
+	if mode := s.globalSettings.GatewayProgrammedMode; (mode == settings.GatewayProgrammedAnyProxyAck || mode == settings.GatewayProgrammedAllProxiesAck) &&
+		!s.globalSettings.DisableLeaderElection && s.globalSettings.Replicas > 1 {
+		// the leader writes the status, but only knows the ACKs of the proxies connected to it
+		err := fmt.Errorf("gateway programmed mode %s can't be used with leader election and %d replicas", mode, s.globalSettings.Replicas)
+		slog.Error("invalid settings", "error", err)
+		return nil, err
+	}
+
 	if s.restConfig == nil {
 		s.restConfig = ctrl.GetConfigOrDie()
 	}
@@ -230,13 +253,18 @@ func (s *setup) Start(ctx context.Context) error {
 		return err
 	}
 
-	uniqueClientCallbacks, uccBuilder := krtcollections.NewUniquelyConnectedClients(s.extraXDSCallbacks)
-	cache := NewControlPlane(ctx, s.xdsListener, uniqueClientCallbacks)
+	uniqueClientCallbacks, uccBuilder, xdsStreamsBuilder := krtcollections.NewUniquelyConnectedClients(s.extraXDSCallbacks)
+	xdsSecurityOpts, xdsCertProvider, err := newXdsSecurityOptions(ctx, s.restConfig, s.globalSettings)
+	if err != nil {
+		return err
+	}
+	cache := NewControlPlane(ctx, s.xdsListener, uniqueClientCallbacks, xdsSecurityOpts...)
 
 	setupOpts := &controller.SetupOpts{
-		Cache:          cache,
-		KrtDebugger:    s.krtDebugger,
-		GlobalSettings: s.globalSettings,
+		Cache:           cache,
+		KrtDebugger:     s.krtDebugger,
+		GlobalSettings:  s.globalSettings,
+		XdsCertProvider: xdsCertProvider,
 	}
 
 	istioClient, err := CreateKubeClient(s.restConfig)
@@ -283,7 +311,7 @@ func (s *setup) Start(ctx context.Context) error {
 
 	BuildKgatewayWithConfig(
 		ctx, mgr, s.gatewayControllerName, s.gatewayClassName, s.waypointClassName,
-		s.agentGatewayClassName, setupOpts, s.restConfig, istioClient, commoncol, agwCollections, uccBuilder, s.extraPlugins, s.extraAgentgatewayPlugins, s.extraGatewayParameters)
+		s.agentGatewayClassName, setupOpts, s.restConfig, istioClient, commoncol, agwCollections, uccBuilder, xdsStreamsBuilder, s.extraPlugins, s.extraAgentgatewayPlugins, s.extraGatewayParameters)
 
 	slog.Info("starting admin server")
 	go admin.RunAdminServer(ctx, setupOpts)
@@ -310,6 +338,7 @@ func BuildKgatewayWithConfig(
 	commonCollections *collections.CommonCollections,
 	agwCollections *agentgatewayplugins.AgwCollections,
 	uccBuilder krtcollections.UniquelyConnectedClientsBulider,
+	xdsStreamsBuilder krtcollections.XdsStreamsBuilder,
 	extraPlugins func(ctx context.Context, commoncol *common.CommonCollections) []sdk.Plugin,
 	extraAgentgatewayPlugins func(ctx context.Context, agw *agentgatewayplugins.AgwCollections) []agentgatewayplugins.AgentgatewayPlugin,
 	extraGatewayParameters func(cli client.Client, inputs *deployer.Inputs) []deployer.ExtraGatewayParameters,
@@ -324,6 +353,7 @@ func BuildKgatewayWithConfig(
 	}
 
 	ucc := uccBuilder(ctx, krtOpts, augmentedPodsForUcc)
+	xdsStreams := xdsStreamsBuilder(krtOpts)
 
 	slog.Info("initializing controller")
 	c, err := controller.NewControllerBuilder(ctx, controller.StartConfig{
@@ -340,6 +370,7 @@ func BuildKgatewayWithConfig(
 		Client:                   kubeClient,
 		AugmentedPods:            augmentedPods,
 		UniqueClients:            ucc,
+		XdsStreams:               xdsStreams,
 		Dev:                      logging.MustGetLevel(logging.DefaultComponent) <= logging.LevelTrace,
 		KrtOptions:               krtOpts,
 		CommonCollections:        commonCollections,
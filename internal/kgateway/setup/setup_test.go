@@ -46,6 +46,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/setup"
 	"github.com/kgateway-dev/kgateway/v2/pkg/settings"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/envutils"
 	"github.com/kgateway-dev/kgateway/v2/test/envtestutil"
@@ -217,6 +218,43 @@ func TestWithInferenceAPI(t *testing.T) {
 	runScenario(t, "testdata/inference_api", st)
 }
 
+func TestNewRejectsInvalidSettings(t *testing.T) {
+	testCases := []struct {
+		name        string
+		settings    settings.Settings
+		expectedErr string
+	}{
+		{
+			name:        "xDS authentication without TLS",
+			settings:    settings.Settings{EnableXdsAuth: true},
+			expectedErr: "xDS authentication requires xDS TLS to be enabled",
+		},
+		{
+			name:        "xDS TLS with agentgateway",
+			settings:    settings.Settings{EnableXdsTls: true, EnableAgentGateway: true},
+			expectedErr: "xDS TLS can't be enabled with agentgateway",
+		},
+		{
+			name:        "xDS TLS and authentication with agentgateway",
+			settings:    settings.Settings{EnableXdsTls: true, EnableXdsAuth: true, EnableAgentGateway: true},
+			expectedErr: "xDS TLS can't be enabled with agentgateway",
+		},
+		{
+			name:        "proxy ACK gateway programmed mode with leader election and several replicas",
+			settings:    settings.Settings{GatewayProgrammedMode: settings.GatewayProgrammedAnyProxyAck, Replicas: 2},
+			expectedErr: "gateway programmed mode ANY_PROXY_ACK can't be used with leader election and 2 replicas",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := setup.New(setup.WithGlobalSettings(&tc.settings))
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Fatalf("expected error %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
 func TestPolicyUpdate(t *testing.T) {
 	st, err := settings.BuildSettings()
 	if err != nil {
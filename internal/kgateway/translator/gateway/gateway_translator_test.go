@@ -386,6 +386,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("TrafficPolicy with model routing attached to route rule", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/model-routing.yaml",
+			outputFile: "traffic-policy/model-routing.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy with header modifiers attached to gateway", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/header-modifiers-gateway.yaml",
@@ -1222,6 +1233,91 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("gateway merged into another gateway", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "merged-gateways/basic.yaml",
+			outputFile: "merged-gateways/basic.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				assert.NoError(t, translatortest.AreReportsSuccess(gwNN, reportsMap))
+				mergedGw := gwv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "team-gateway",
+						Namespace: "default",
+					},
+					Spec: gwv1.GatewaySpec{
+						Listeners: []gwv1.Listener{{Name: "http", Protocol: gwv1.HTTPProtocolType, Port: 9090}},
+					},
+				}
+				translatortest.AssertGatewayCondition(t, reportsMap, mergedGw, metav1.Condition{
+					Type:   string(gwv1.GatewayConditionAccepted),
+					Status: metav1.ConditionTrue,
+					Reason: string(gwv1.GatewayReasonAccepted),
+				})
+				translatortest.AssertListenerCondition(t, reportsMap, mergedGw, "http", metav1.Condition{
+					Type:   string(gwv1.ListenerConditionAccepted),
+					Status: metav1.ConditionTrue,
+					Reason: string(gwv1.ListenerReasonAccepted),
+				})
+			},
+		})
+	})
+
+	t.Run("gateway merged into another gateway with a conflicting listener", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "merged-gateways/conflict.yaml",
+			outputFile: "merged-gateways/conflict.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+			assertReports: func(gwNN types.NamespacedName, reportsMap reports.ReportMap) {
+				mergedGw := gwv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "team-gateway",
+						Namespace: "default",
+					},
+					Spec: gwv1.GatewaySpec{
+						Listeners: []gwv1.Listener{{Name: "tcp", Protocol: gwv1.TCPProtocolType, Port: 8080}},
+					},
+				}
+				translatortest.AssertListenerCondition(t, reportsMap, mergedGw, "tcp", metav1.Condition{
+					Type:   string(gwv1.ListenerConditionConflicted),
+					Status: metav1.ConditionTrue,
+					Reason: string(gwv1.ListenerReasonProtocolConflict),
+				})
+				translatortest.AssertGatewayCondition(t, reportsMap, mergedGw, metav1.Condition{
+					Type:   string(gwv1.GatewayConditionAccepted),
+					Status: metav1.ConditionFalse,
+					Reason: string(gwv1.GatewayReasonListenersNotValid),
+				})
+				// the listener of the gateway the other one is merged into takes precedence
+				gw := gwv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      gwNN.Name,
+						Namespace: gwNN.Namespace,
+					},
+					Spec: gwv1.GatewaySpec{
+						Listeners: []gwv1.Listener{{Name: "http", Protocol: gwv1.HTTPProtocolType, Port: 8080}},
+					},
+				}
+				translatortest.AssertGatewayCondition(t, reportsMap, gw, metav1.Condition{
+					Type:   string(gwv1.GatewayConditionAccepted),
+					Status: metav1.ConditionTrue,
+					Reason: string(gwv1.GatewayReasonAccepted),
+				})
+				translatortest.AssertListenerCondition(t, reportsMap, gw, "http", metav1.Condition{
+					Type:   string(gwv1.ListenerConditionConflicted),
+					Status: metav1.ConditionFalse,
+					Reason: string(gwv1.ListenerReasonNoConflicts),
+				})
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy: rate limit", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/rate-limit.yaml",
@@ -139,6 +139,7 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 	}
 
 	validListeners := validateSupportedRoutes(gw.Listeners, reporter)
+	validListeners = rejectMergedListenerConflicts(gw, validListeners, reporter)
 
 	portListeners := map[gwv1.PortNumber]*portProtocol{}
 	for _, listener := range validListeners {
@@ -229,6 +230,23 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 		})
 	}
 
+	// the gateways merged into gw are only rejected when none of their own listeners is valid
+	for _, mergedGw := range gw.MergedGateways {
+		if slices.ContainsFunc(validListeners, func(l ir.Listener) bool { return l.Parent == mergedGw }) {
+			continue
+		}
+		reporter.Gateway(mergedGw).SetCondition(reports.GatewayCondition{
+			Type:   gwv1.GatewayConditionAccepted,
+			Status: metav1.ConditionFalse,
+			Reason: gwv1.GatewayReasonListenersNotValid,
+		})
+		reporter.Gateway(mergedGw).SetCondition(reports.GatewayCondition{
+			Type:   gwv1.GatewayConditionProgrammed,
+			Status: metav1.ConditionFalse,
+			Reason: gwv1.GatewayReasonInvalid,
+		})
+	}
+
 	if len(validListeners) == 0 {
 		reporter.Gateway(gw.Obj).SetCondition(reports.GatewayCondition{
 			Type:   gwv1.GatewayConditionAccepted,
@@ -267,6 +285,70 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter) []ir.Listener
 	return validListeners
 }
 
+// rejectMergedListenerConflicts rejects the listeners of the Gateways merged into gw that conflict
+// with the listeners of another Gateway. The listeners of gw take precedence, followed by those of
+// the earliest merged Gateway, so a merged Gateway can't take a port away from gw. The listeners
+// are expected in that order.
+func rejectMergedListenerConflicts(gw *ir.Gateway, listeners []ir.Listener, reporter reports.Reporter) []ir.Listener {
+	if len(gw.MergedGateways) == 0 {
+		return listeners
+	}
+
+	ret := make([]ir.Listener, 0, len(listeners))
+	for _, listener := range listeners {
+		mergedGw, ok := listener.Parent.(*gwv1.Gateway)
+		if !ok || !slices.Contains(gw.MergedGateways, mergedGw) {
+			ret = append(ret, listener)
+			continue
+		}
+
+		var conflict *reports.ListenerCondition
+		for _, other := range ret {
+			if other.Parent == listener.Parent || other.Port != listener.Port {
+				continue
+			}
+			if normalizeProtocol(other.Protocol) != normalizeProtocol(listener.Protocol) {
+				conflict = &reports.ListenerCondition{
+					Type:    gwv1.ListenerConditionConflicted,
+					Status:  metav1.ConditionTrue,
+					Reason:  gwv1.ListenerReasonProtocolConflict,
+					Message: fmt.Sprintf("Found conflicting protocols with listener %s on port %d of another Gateway", other.Name, listener.Port),
+				}
+				break
+			}
+			if listenerHostname(other) == listenerHostname(listener) {
+				conflict = &reports.ListenerCondition{
+					Type:    gwv1.ListenerConditionConflicted,
+					Status:  metav1.ConditionTrue,
+					Reason:  gwv1.ListenerReasonHostnameConflict,
+					Message: fmt.Sprintf("Found conflicting hostnames with listener %s on port %d of another Gateway", other.Name, listener.Port),
+				}
+				break
+			}
+		}
+		if conflict != nil {
+			listener.GetParentReporter(reporter).ListenerName(string(listener.Name)).SetCondition(*conflict)
+			continue
+		}
+		ret = append(ret, listener)
+	}
+	return ret
+}
+
+func normalizeProtocol(protocol gwv1.ProtocolType) gwv1.ProtocolType {
+	if protocol == gwv1.HTTPSProtocolType || protocol == gwv1.TLSProtocolType {
+		return NormalizedHTTPSTLSType
+	}
+	return protocol
+}
+
+func listenerHostname(listener ir.Listener) gwv1.Hostname {
+	if listener.Hostname == nil {
+		return DefaultHostname
+	}
+	return *listener.Hostname
+}
+
 func validateGateway(consolidatedGateway *ir.Gateway, reporter reports.Reporter) []ir.Listener {
 	rejectDeniedListenerSets(consolidatedGateway, reporter)
 	validatedListeners := validateListeners(consolidatedGateway, reporter)
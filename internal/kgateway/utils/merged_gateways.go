@@ -0,0 +1,27 @@
+package utils
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
+)
+
+// MergeIntoGatewayName returns the name of the Gateway whose proxy serves the listeners of gw,
+// as set by the merge-into-gateway annotation, or an empty string if gw isn't annotated.
+func MergeIntoGatewayName(gw *gwv1.Gateway) string {
+	return gw.GetAnnotations()[apiannotations.MergeIntoGateway]
+}
+
+// CanMergeInto returns whether gw is merged into target, i.e. whether the listeners of gw are
+// served by the proxy of target. Only a single level of merging is allowed, so a Gateway that is
+// merged into another one can't be the target of a merge itself.
+func CanMergeInto(gw, target *gwv1.Gateway) bool {
+	if gw == nil || target == nil {
+		return false
+	}
+	return MergeIntoGatewayName(gw) == target.GetName() &&
+		gw.GetName() != target.GetName() &&
+		gw.GetNamespace() == target.GetNamespace() &&
+		gw.Spec.GatewayClassName == target.Spec.GatewayClassName &&
+		MergeIntoGatewayName(target) == ""
+}
@@ -26,6 +26,10 @@ const (
 	// they are associated with.
 	GatewayNameLabel = "gateway.networking.k8s.io/gateway-name"
 
+	// XdsTokenAudience is the audience of the ServiceAccount tokens the proxies authenticate
+	// to the xDS server with.
+	XdsTokenAudience = "kgateway.dev/xds"
+
 	// LeaderElectionID is the name of the lease that leader election will use for holding the leader lock.
 	LeaderElectionID = "kgateway"
 )
@@ -2,7 +2,10 @@ package wellknown
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
@@ -16,4 +19,9 @@ var (
 	ClusterRoleBindingGVK = rbacv1.SchemeGroupVersion.WithKind("ClusterRoleBinding")
 
 	DeploymentGVK = appsv1.SchemeGroupVersion.WithKind("Deployment")
+	DaemonSetGVK  = appsv1.SchemeGroupVersion.WithKind("DaemonSet")
+
+	PodDisruptionBudgetGVK     = policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget")
+	HorizontalPodAutoscalerGVK = autoscalingv2.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler")
+	NetworkPolicyGVK           = networkingv1.SchemeGroupVersion.WithKind("NetworkPolicy")
 )
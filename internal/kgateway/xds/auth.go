@@ -0,0 +1,180 @@
+package xds
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+const (
+	// podNameExtraKey is the key of the pod name in the extra info of the user of a token
+	// bound to a pod.
+	podNameExtraKey = "authentication.kubernetes.io/pod-name"
+
+	serviceAccountUsernamePrefix = "system:serviceaccount:"
+)
+
+// Identity is the authenticated identity of a proxy connected to the xDS server.
+type Identity struct {
+	Namespace      string
+	ServiceAccount string
+	// PodName is the name of the pod of the proxy, when its identity is bound to a pod.
+	PodName string
+}
+
+func (i Identity) String() string {
+	return serviceAccountUsernamePrefix + i.Namespace + ":" + i.ServiceAccount
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a copy of ctx carrying the identity of the proxy.
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// IdentityFromContext returns the identity of the proxy carried by ctx, if it was authenticated.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
+
+// AuthorizeNode returns an error unless the proxy with the identity may receive the config of
+// the role of the node. The proxies deployed for a Gateway run with a ServiceAccount named after
+// the Gateway, so a proxy may only receive the config of the Gateway of the same name in the
+// namespace of its ServiceAccount; a proxy whose identity is bound to a pod must also use the
+// name of the pod in its node ID.
+func AuthorizeNode(id Identity, node *envoycorev3.Node) error {
+	role := node.GetMetadata().GetFields()[RoleKey].GetStringValue()
+	parts := strings.SplitN(role, KeyDelimiter, 4)
+	if len(parts) < 3 || parts[0] != wellknown.GatewayApiProxyValue {
+		return fmt.Errorf("%s may not receive the config of role %q", id, role)
+	}
+	if parts[1] != id.Namespace || parts[2] != id.ServiceAccount {
+		return fmt.Errorf("%s may not receive the config of Gateway %s/%s", id, parts[1], parts[2])
+	}
+	if id.PodName != "" && node.GetId() != id.PodName+"."+id.Namespace {
+		return fmt.Errorf("%s of pod %s may not use node ID %q", id, id.PodName, node.GetId())
+	}
+	return nil
+}
+
+// Authenticator authenticates the proxies connecting to the xDS server, either with the client
+// certificate they presented, verified by the TLS config of the server, or with a projected
+// ServiceAccount token sent in the authorization header.
+type Authenticator struct {
+	kube kubernetes.Interface
+	// trustDomain is the SPIFFE trust domain of the client certificates.
+	trustDomain string
+}
+
+func NewAuthenticator(kube kubernetes.Interface, trustDomain string) *Authenticator {
+	return &Authenticator{
+		kube:        kube,
+		trustDomain: trustDomain,
+	}
+}
+
+// StreamServerInterceptor rejects the streams of the proxies that are not authenticated, and
+// adds the identity of the proxy to the context of the stream of the others.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id, err := a.Authenticate(ss.Context())
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = WithIdentity(ss.Context(), id)
+		return handler(srv, wrapped)
+	}
+}
+
+// Authenticate returns the identity of the proxy of the gRPC request carried by ctx.
+func (a *Authenticator) Authenticate(ctx context.Context) (Identity, error) {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) > 0 {
+			return identityFromCert(tlsInfo.State.VerifiedChains[0][0], a.trustDomain)
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	authz := md.Get("authorization")
+	if len(authz) == 0 {
+		return Identity{}, errors.New("no client certificate or token")
+	}
+	token, ok := strings.CutPrefix(authz[0], "Bearer ")
+	if !ok || token == "" {
+		return Identity{}, errors.New("invalid authorization header")
+	}
+	return a.authenticateToken(ctx, token)
+}
+
+func (a *Authenticator) authenticateToken(ctx context.Context, token string) (Identity, error) {
+	review, err := a.kube.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: []string{wellknown.XdsTokenAudience},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return Identity{}, fmt.Errorf("failed to review token: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return Identity{}, fmt.Errorf("invalid token: %s", review.Status.Error)
+	}
+	if !slices.Contains(review.Status.Audiences, wellknown.XdsTokenAudience) {
+		return Identity{}, errors.New("invalid token audience")
+	}
+	sa, ok := strings.CutPrefix(review.Status.User.Username, serviceAccountUsernamePrefix)
+	namespace, name, _ := strings.Cut(sa, ":")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, ":") {
+		return Identity{}, fmt.Errorf("token of %s is not a ServiceAccount token", review.Status.User.Username)
+	}
+	id := Identity{
+		Namespace:      namespace,
+		ServiceAccount: name,
+	}
+	if podName := review.Status.User.Extra[podNameExtraKey]; len(podName) == 1 {
+		id.PodName = podName[0]
+	}
+	return id, nil
+}
+
+// identityFromCert returns the identity in the SPIFFE ID of the certificate, i.e. a URI SAN
+// of the form spiffe://<trust domain>/ns/<namespace>/sa/<service account>, which must be in
+// the trust domain.
+func identityFromCert(cert *x509.Certificate, trustDomain string) (Identity, error) {
+	for _, uri := range cert.URIs {
+		if uri.Scheme != "spiffe" {
+			continue
+		}
+		if uri.Host != trustDomain {
+			return Identity{}, fmt.Errorf("SPIFFE ID %s of the client certificate is not in trust domain %s", uri, trustDomain)
+		}
+		parts := strings.Split(strings.TrimPrefix(uri.Path, "/"), "/")
+		if len(parts) == 4 && parts[0] == "ns" && parts[2] == "sa" && parts[1] != "" && parts[3] != "" {
+			return Identity{
+				Namespace:      parts[1],
+				ServiceAccount: parts[3],
+			}, nil
+		}
+	}
+	return Identity{}, errors.New("client certificate has no SPIFFE ID")
+}
@@ -0,0 +1,173 @@
+package xds
+
+import (
+	"context"
+	"crypto/x509"
+	"net/url"
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+func TestAuthorizeNode(t *testing.T) {
+	node := func(id, role string) *envoycorev3.Node {
+		return &envoycorev3.Node{
+			Id: id,
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					RoleKey: structpb.NewStringValue(role),
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		id      Identity
+		node    *envoycorev3.Node
+		wantErr bool
+	}{
+		{
+			name: "gateway of the service account",
+			id:   Identity{Namespace: "ns", ServiceAccount: "gw"},
+			node: node("pod.ns", "kgateway-kube-gateway-api~ns~gw"),
+		},
+		{
+			name: "role augmented with the pod locality",
+			id:   Identity{Namespace: "ns", ServiceAccount: "gw", PodName: "pod"},
+			node: node("pod.ns", "kgateway-kube-gateway-api~ns~gw~123~ns"),
+		},
+		{
+			name:    "gateway of another service account",
+			id:      Identity{Namespace: "ns", ServiceAccount: "gw"},
+			node:    node("pod.ns", "kgateway-kube-gateway-api~ns~other-gw"),
+			wantErr: true,
+		},
+		{
+			name:    "gateway in another namespace",
+			id:      Identity{Namespace: "ns", ServiceAccount: "gw"},
+			node:    node("pod.other-ns", "kgateway-kube-gateway-api~other-ns~gw"),
+			wantErr: true,
+		},
+		{
+			name:    "not a gateway",
+			id:      Identity{Namespace: "ns", ServiceAccount: "gw"},
+			node:    node("pod.ns", "gloo-system~gw"),
+			wantErr: true,
+		},
+		{
+			name:    "node of another pod",
+			id:      Identity{Namespace: "ns", ServiceAccount: "gw", PodName: "pod"},
+			node:    node("other-pod.ns", "kgateway-kube-gateway-api~ns~gw"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := AuthorizeNode(tc.id, tc.node)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	g := NewWithT(t)
+
+	kube := fake.NewClientset()
+	kube.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch review.Spec.Token {
+		case "pod-token":
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				Audiences:     review.Spec.Audiences,
+				User: authenticationv1.UserInfo{
+					Username: "system:serviceaccount:ns:gw",
+					Extra: map[string]authenticationv1.ExtraValue{
+						podNameExtraKey: {"pod"},
+					},
+				},
+			}
+		case "user-token":
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				Audiences:     review.Spec.Audiences,
+				User:          authenticationv1.UserInfo{Username: "alice"},
+			}
+		default:
+			review.Status = authenticationv1.TokenReviewStatus{Error: "invalid token"}
+		}
+		return true, review, nil
+	})
+	a := NewAuthenticator(kube, "cluster.local")
+
+	withToken := func(authz string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", authz))
+	}
+
+	id, err := a.Authenticate(withToken("Bearer pod-token"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(id).To(Equal(Identity{Namespace: "ns", ServiceAccount: "gw", PodName: "pod"}))
+
+	_, err = a.Authenticate(withToken("Bearer user-token"))
+	g.Expect(err).To(MatchError(ContainSubstring("not a ServiceAccount token")))
+
+	_, err = a.Authenticate(withToken("Bearer bad-token"))
+	g.Expect(err).To(MatchError(ContainSubstring("invalid token")))
+
+	_, err = a.Authenticate(withToken("Basic pod-token"))
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = a.Authenticate(context.Background())
+	g.Expect(err).To(HaveOccurred())
+
+	reviews := 0
+	for _, action := range kube.Actions() {
+		if action.GetResource().Resource == "tokenreviews" {
+			reviews++
+			review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+			g.Expect(review.Spec.Audiences).To(Equal([]string{wellknown.XdsTokenAudience}))
+		}
+	}
+	g.Expect(reviews).To(Equal(3))
+}
+
+func TestIdentityFromCert(t *testing.T) {
+	g := NewWithT(t)
+
+	id, err := identityFromCert(&x509.Certificate{
+		URIs: []*url.URL{{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/ns/sa/gw"}},
+	}, "cluster.local")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(id).To(Equal(Identity{Namespace: "ns", ServiceAccount: "gw"}))
+
+	_, err = identityFromCert(&x509.Certificate{
+		URIs: []*url.URL{{Scheme: "https", Host: "cluster.local", Path: "/ns/ns/sa/gw"}},
+	}, "cluster.local")
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = identityFromCert(&x509.Certificate{
+		URIs: []*url.URL{{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/ns"}},
+	}, "cluster.local")
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = identityFromCert(&x509.Certificate{
+		URIs: []*url.URL{{Scheme: "spiffe", Host: "other.domain", Path: "/ns/ns/sa/gw"}},
+	}, "cluster.local")
+	g.Expect(err).To(MatchError(ContainSubstring("not in trust domain cluster.local")))
+}
@@ -0,0 +1,375 @@
+package xds
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// CACertKey is the key of the CA certificate in the Secrets holding the certificates of the xDS
+	// server and the CA of the client certificates of the proxies.
+	CACertKey = "ca.crt"
+	// CAKeyKey is the key of the CA private key in the Secret holding the self-signed CA.
+	CAKeyKey = "ca.key"
+
+	selfSignedCAValidity     = 10 * 365 * 24 * time.Hour
+	selfSignedServerValidity = 24 * time.Hour
+	secretRefreshInterval    = time.Minute
+)
+
+// CertProvider provides the certificate of the xDS server and the CA it is issued by.
+type CertProvider interface {
+	// GetCertificate returns the current certificate of the xDS server.
+	GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error)
+	// CACert returns the PEM-encoded CA bundle that validates the certificate of the xDS server.
+	CACert() []byte
+}
+
+// CAProvider provides the CA of the client certificates of the proxies.
+type CAProvider interface {
+	// CACert returns the PEM-encoded CA bundle that validates the client certificates of the proxies.
+	CACert() []byte
+}
+
+// ServerTLSConfig returns the TLS config of the xDS server. When clientCA is not nil, the client
+// certificates presented by the proxies are verified with it; the proxies that don't present one
+// must authenticate with a token instead. The CA of the xDS server is not trusted for client
+// certificates, as it may issue certificates to other workloads.
+func ServerTLSConfig(p CertProvider, clientCA CAProvider) *tls.Config {
+	cfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: p.GetCertificate,
+	}
+	if clientCA != nil {
+		// the CA can change, so build the client CA pool for each connection
+		cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(clientCA.CACert()) {
+				return nil, errors.New("no valid CA certificate for the client certificates")
+			}
+			clientCfg := cfg.Clone()
+			clientCfg.GetConfigForClient = nil
+			clientCfg.ClientCAs = pool
+			clientCfg.ClientAuth = tls.VerifyClientCertIfGiven
+			return clientCfg, nil
+		}
+	}
+	return cfg
+}
+
+// secretCertProvider provides the certificate of the xDS server from a Secret, which is
+// reloaded periodically so the certificate can be rotated by the issuer of the Secret.
+type secretCertProvider struct {
+	kube      kubernetes.Interface
+	namespace string
+	name      string
+
+	mu     sync.RWMutex
+	cert   *tls.Certificate
+	caCert []byte
+}
+
+// NewSecretCertProvider returns a CertProvider serving the certificate in the tls.crt and tls.key
+// keys of the Secret, issued by the CA in its ca.crt key. The Secret must exist.
+func NewSecretCertProvider(ctx context.Context, kube kubernetes.Interface, namespace, name string) (CertProvider, error) {
+	p := &secretCertProvider{
+		kube:      kube,
+		namespace: namespace,
+		name:      name,
+	}
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := p.refresh(ctx); err != nil {
+			slog.Error("failed to refresh the certificate of the xDS server", "secret", name, "error", err)
+		}
+	}, secretRefreshInterval)
+	return p, nil
+}
+
+func (p *secretCertProvider) refresh(ctx context.Context) error {
+	secret, err := p.kube.CoreV1().Secrets(p.namespace).Get(ctx, p.name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get Secret %s/%s: %w", p.namespace, p.name, err)
+	}
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("invalid certificate in Secret %s/%s: %w", p.namespace, p.name, err)
+	}
+	caCert := secret.Data[CACertKey]
+	if len(caCert) == 0 {
+		return fmt.Errorf("missing %s in Secret %s/%s", CACertKey, p.namespace, p.name)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cert = &cert
+	p.caCert = caCert
+	return nil
+}
+
+func (p *secretCertProvider) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.cert, nil
+}
+
+func (p *secretCertProvider) CACert() []byte {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.caCert
+}
+
+// secretCAProvider provides the CA of the client certificates of the proxies from a Secret, which
+// is reloaded periodically so the CA can be rotated.
+type secretCAProvider struct {
+	kube      kubernetes.Interface
+	namespace string
+	name      string
+
+	mu     sync.RWMutex
+	caCert []byte
+}
+
+// NewSecretCAProvider returns a CAProvider serving the CA in the ca.crt key of the Secret. The
+// Secret must exist.
+func NewSecretCAProvider(ctx context.Context, kube kubernetes.Interface, namespace, name string) (CAProvider, error) {
+	p := &secretCAProvider{
+		kube:      kube,
+		namespace: namespace,
+		name:      name,
+	}
+	if err := p.refresh(ctx); err != nil {
+		return nil, err
+	}
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := p.refresh(ctx); err != nil {
+			slog.Error("failed to refresh the CA of the client certificates", "secret", name, "error", err)
+		}
+	}, secretRefreshInterval)
+	return p, nil
+}
+
+func (p *secretCAProvider) refresh(ctx context.Context) error {
+	secret, err := p.kube.CoreV1().Secrets(p.namespace).Get(ctx, p.name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get Secret %s/%s: %w", p.namespace, p.name, err)
+	}
+	caCert := secret.Data[CACertKey]
+	if !x509.NewCertPool().AppendCertsFromPEM(caCert) {
+		return fmt.Errorf("invalid or missing %s in Secret %s/%s", CACertKey, p.namespace, p.name)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.caCert = caCert
+	return nil
+}
+
+func (p *secretCAProvider) CACert() []byte {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.caCert
+}
+
+// selfSignedCertProvider issues the certificate of the xDS server from a self-signed CA, and
+// issues a new one when two thirds of its validity have elapsed.
+type selfSignedCertProvider struct {
+	dnsNames []string
+	ca       *x509.Certificate
+	caKey    *ecdsa.PrivateKey
+	caCert   []byte
+	now      func() time.Time
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	renewAt time.Time
+}
+
+// NewSelfSignedCertProvider returns a CertProvider serving certificates for the DNS names, issued
+// by a self-signed CA. The CA is stored in the Secret, which is created if it doesn't exist, so it
+// is shared by the replicas of the control plane and survives restarts; the proxies keep
+// validating the xDS server with the CA they were deployed with.
+func NewSelfSignedCertProvider(ctx context.Context, kube kubernetes.Interface, namespace, name string, dnsNames []string) (CertProvider, error) {
+	caCert, caKeyPEM, err := loadOrCreateCA(ctx, kube, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return newSelfSignedCertProvider(caCert, caKeyPEM, dnsNames, time.Now)
+}
+
+func newSelfSignedCertProvider(caCert, caKeyPEM []byte, dnsNames []string, now func() time.Time) (*selfSignedCertProvider, error) {
+	caBlock, _ := pem.Decode(caCert)
+	if caBlock == nil {
+		return nil, errors.New("invalid CA certificate")
+	}
+	ca, err := x509.ParseCertificate(caBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CA certificate: %w", err)
+	}
+	keyBlock, _ := pem.Decode(caKeyPEM)
+	if keyBlock == nil {
+		return nil, errors.New("invalid CA key")
+	}
+	caKey, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CA key: %w", err)
+	}
+	return &selfSignedCertProvider{
+		dnsNames: dnsNames,
+		ca:       ca,
+		caKey:    caKey,
+		caCert:   caCert,
+		now:      now,
+	}, nil
+}
+
+func (p *selfSignedCertProvider) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if p.cert != nil && now.Before(p.renewAt) {
+		return p.cert, nil
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	notAfter := now.Add(selfSignedServerValidity)
+	if notAfter.After(p.ca.NotAfter) {
+		notAfter = p.ca.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: p.dnsNames[0]},
+		DNSNames:     p.dnsNames,
+		NotBefore:    now.Add(-5 * time.Minute),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, p.ca, &key.PublicKey, p.caKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue the certificate of the xDS server: %w", err)
+	}
+
+	p.cert = &tls.Certificate{
+		Certificate: [][]byte{der, p.ca.Raw},
+		PrivateKey:  key,
+	}
+	p.renewAt = now.Add(notAfter.Sub(now) * 2 / 3)
+	return p.cert, nil
+}
+
+func (p *selfSignedCertProvider) CACert() []byte {
+	return p.caCert
+}
+
+// loadOrCreateCA returns the PEM-encoded certificate and key of the CA stored in the Secret,
+// generating and storing a new CA if the Secret doesn't exist.
+func loadOrCreateCA(ctx context.Context, kube kubernetes.Interface, namespace, name string) ([]byte, []byte, error) {
+	secrets := kube.CoreV1().Secrets(namespace)
+	secret, err := secrets.Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		return caFromSecret(secret)
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("failed to get Secret %s/%s: %w", namespace, name, err)
+	}
+
+	caCert, caKey, err := generateCA(time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			CACertKey: caCert,
+			CAKeyKey:  caKey,
+		},
+	}
+	created, err := secrets.Create(ctx, secret, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		// another replica created the CA first
+		created, err = secrets.Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to store the CA of the xDS server in Secret %s/%s: %w", namespace, name, err)
+	}
+	return caFromSecret(created)
+}
+
+func caFromSecret(secret *corev1.Secret) ([]byte, []byte, error) {
+	caCert, caKey := secret.Data[CACertKey], secret.Data[CAKeyKey]
+	if len(caCert) == 0 || len(caKey) == 0 {
+		return nil, nil, fmt.Errorf("missing %s or %s in Secret %s/%s", CACertKey, CAKeyKey, secret.Namespace, secret.Name)
+	}
+	return caCert, caKey, nil
+}
+
+func generateCA(now time.Time) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "kgateway xDS CA"},
+		NotBefore:             now.Add(-5 * time.Minute),
+		NotAfter:              now.Add(selfSignedCAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate the CA of the xDS server: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var certPEM, keyPEM bytes.Buffer
+	if err := pem.Encode(&certPEM, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		return nil, nil, err
+	}
+	if err := pem.Encode(&keyPEM, &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}); err != nil {
+		return nil, nil, err
+	}
+	return certPEM.Bytes(), keyPEM.Bytes(), nil
+}
@@ -0,0 +1,175 @@
+package xds
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSelfSignedCertProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	kube := fake.NewClientset()
+	p, err := NewSelfSignedCertProvider(context.Background(), kube, "kgateway-system", "kgateway-xds-ca", []string{"kgateway.kgateway-system.svc"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the CA is stored, so the other replicas of the control plane use the same one
+	secret, err := kube.CoreV1().Secrets("kgateway-system").Get(context.Background(), "kgateway-xds-ca", metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(secret.Data[CACertKey]).To(Equal(p.CACert()))
+	other, err := NewSelfSignedCertProvider(context.Background(), kube, "kgateway-system", "kgateway-xds-ca", []string{"kgateway.kgateway-system.svc"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(other.CACert()).To(Equal(p.CACert()))
+
+	cert, err := p.GetCertificate(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	g.Expect(err).NotTo(HaveOccurred())
+	roots := x509.NewCertPool()
+	g.Expect(roots.AppendCertsFromPEM(p.CACert())).To(BeTrue())
+	_, err = leaf.Verify(x509.VerifyOptions{
+		DNSName: "kgateway.kgateway-system.svc",
+		Roots:   roots,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestSelfSignedCertProviderRotation(t *testing.T) {
+	g := NewWithT(t)
+
+	caCert, caKey, err := generateCA(time.Now())
+	g.Expect(err).NotTo(HaveOccurred())
+	now := time.Now()
+	p, err := newSelfSignedCertProvider(caCert, caKey, []string{"kgateway"}, func() time.Time { return now })
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cert, err := p.GetCertificate(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	now = now.Add(selfSignedServerValidity / 2)
+	same, err := p.GetCertificate(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(same).To(BeIdenticalTo(cert))
+
+	now = now.Add(selfSignedServerValidity / 4)
+	renewed, err := p.GetCertificate(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(renewed).NotTo(BeIdenticalTo(cert))
+	leaf, err := x509.ParseCertificate(renewed.Certificate[0])
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(leaf.NotAfter).To(BeTemporally(">", now.Add(selfSignedServerValidity/2)))
+}
+
+func TestSecretCertProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	caCert, caKey, err := generateCA(time.Now())
+	g.Expect(err).NotTo(HaveOccurred())
+	issuer, err := newSelfSignedCertProvider(caCert, caKey, []string{"kgateway"}, time.Now)
+	g.Expect(err).NotTo(HaveOccurred())
+	cert, err := issuer.GetCertificate(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	certPEM, keyPEM := encodeCertificate(t, cert)
+
+	kube := fake.NewClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "xds-tls", Namespace: "kgateway-system"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+			CACertKey:               caCert,
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p, err := NewSecretCertProvider(ctx, kube, "kgateway-system", "xds-tls")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p.CACert()).To(Equal(caCert))
+	served, err := p.GetCertificate(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(served.Certificate[0]).To(Equal(cert.Certificate[0]))
+
+	_, err = NewSecretCertProvider(ctx, kube, "kgateway-system", "missing")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	caCert, caKey, err := generateCA(time.Now())
+	g.Expect(err).NotTo(HaveOccurred())
+	p, err := newSelfSignedCertProvider(caCert, caKey, []string{"kgateway"}, time.Now)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cfg := ServerTLSConfig(p, nil)
+	g.Expect(cfg.GetConfigForClient).To(BeNil())
+	g.Expect(cfg.ClientAuth).To(Equal(tls.NoClientCert))
+
+	clientCACert, _, err := generateCA(time.Now())
+	g.Expect(err).NotTo(HaveOccurred())
+	cfg = ServerTLSConfig(p, staticCAProvider(clientCACert))
+	clientCfg, err := cfg.GetConfigForClient(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clientCfg.ClientAuth).To(Equal(tls.VerifyClientCertIfGiven))
+	// the client certificates are verified with the client CA only, not with the CA of the xDS server
+	clientCAs := x509.NewCertPool()
+	g.Expect(clientCAs.AppendCertsFromPEM(clientCACert)).To(BeTrue())
+	g.Expect(clientCfg.ClientCAs.Equal(clientCAs)).To(BeTrue())
+
+	cfg = ServerTLSConfig(p, staticCAProvider(nil))
+	_, err = cfg.GetConfigForClient(nil)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestSecretCAProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	caCert, _, err := generateCA(time.Now())
+	g.Expect(err).NotTo(HaveOccurred())
+	kube := fake.NewClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "xds-client-ca", Namespace: "kgateway-system"},
+			Data:       map[string][]byte{CACertKey: caCert},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-ca", Namespace: "kgateway-system"},
+			Data:       map[string][]byte{corev1.TLSCertKey: []byte("not a CA")},
+		},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p, err := NewSecretCAProvider(ctx, kube, "kgateway-system", "xds-client-ca")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(p.CACert()).To(Equal(caCert))
+
+	_, err = NewSecretCAProvider(ctx, kube, "kgateway-system", "no-ca")
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = NewSecretCAProvider(ctx, kube, "kgateway-system", "missing")
+	g.Expect(err).To(HaveOccurred())
+}
+
+type staticCAProvider []byte
+
+func (p staticCAProvider) CACert() []byte {
+	return p
+}
+
+func encodeCertificate(t *testing.T, cert *tls.Certificate) ([]byte, []byte) {
+	t.Helper()
+	keyDER, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM
+}
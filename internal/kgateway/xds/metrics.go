@@ -0,0 +1,103 @@
+package xds
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+)
+
+const (
+	xdsServerSubsystem = "xds_server"
+	protocolLabel      = "protocol"
+	typeLabel          = "type"
+	gatewayLabel       = "gateway"
+	namespaceLabel     = "namespace"
+
+	// ProtocolSotw is the state-of-the-world xDS protocol, where each response contains all the
+	// resources of its type.
+	ProtocolSotw = "sotw"
+	// ProtocolDelta is the incremental xDS protocol, where each response only contains the
+	// resources that changed.
+	ProtocolDelta = "delta"
+)
+
+var (
+	xdsResponsesTotal = metrics.NewCounter(
+		metrics.CounterOpts{
+			Subsystem: xdsServerSubsystem,
+			Name:      "responses_total",
+			Help:      "Total number of xDS responses pushed to the proxies",
+		},
+		[]string{protocolLabel, typeLabel},
+	)
+	xdsResponseBytesTotal = metrics.NewCounter(
+		metrics.CounterOpts{
+			Subsystem: xdsServerSubsystem,
+			Name:      "response_bytes_total",
+			Help:      "Total size in bytes of the xDS responses pushed to the proxies",
+		},
+		[]string{protocolLabel, typeLabel},
+	)
+	xdsResponseResourcesTotal = metrics.NewCounter(
+		metrics.CounterOpts{
+			Subsystem: xdsServerSubsystem,
+			Name:      "response_resources_total",
+			Help:      "Total number of resources in the xDS responses pushed to the proxies",
+		},
+		[]string{protocolLabel, typeLabel},
+	)
+	xdsNacksTotal = metrics.NewCounter(
+		metrics.CounterOpts{
+			Subsystem: xdsServerSubsystem,
+			Name:      "nacks_total",
+			Help:      "Total number of xDS responses rejected by the proxies",
+		},
+		[]string{protocolLabel, gatewayLabel, namespaceLabel, typeLabel},
+	)
+)
+
+// RecordResponse records the metrics of an xDS response of the protocol with the number of
+// resources it contains.
+func RecordResponse(protocol, typeURL string, resources int, resp proto.Message) {
+	if !metrics.Active() {
+		return
+	}
+
+	labels := []metrics.Label{
+		{Name: protocolLabel, Value: protocol},
+		{Name: typeLabel, Value: shortTypeName(typeURL)},
+	}
+	xdsResponsesTotal.Inc(labels...)
+	xdsResponseBytesTotal.Add(float64(proto.Size(resp)), labels...)
+	xdsResponseResourcesTotal.Add(float64(resources), labels...)
+}
+
+// RecordNack records an xDS response of the type rejected by a proxy of the Gateway.
+func RecordNack(protocol, namespace, gateway, typeURL string) {
+	if !metrics.Active() {
+		return
+	}
+
+	xdsNacksTotal.Inc([]metrics.Label{
+		{Name: protocolLabel, Value: protocol},
+		{Name: gatewayLabel, Value: gateway},
+		{Name: namespaceLabel, Value: namespace},
+		{Name: typeLabel, Value: shortTypeName(typeURL)},
+	}...)
+}
+
+// shortTypeName returns the name of the message of the type URL, e.g. Cluster for
+// type.googleapis.com/envoy.config.cluster.v3.Cluster.
+func shortTypeName(typeURL string) string {
+	return typeURL[strings.LastIndex(typeURL, ".")+1:]
+}
+
+// ResetMetrics resets the metrics of the xDS server.
+func ResetMetrics() {
+	xdsResponsesTotal.Reset()
+	xdsResponseBytesTotal.Reset()
+	xdsResponseResourcesTotal.Reset()
+	xdsNacksTotal.Reset()
+}
@@ -0,0 +1,64 @@
+package xds
+
+import (
+	"testing"
+
+	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics/metricstest"
+)
+
+func TestRecordResponse(t *testing.T) {
+	ResetMetrics()
+
+	const clusterType = "type.googleapis.com/envoy.config.cluster.v3.Cluster"
+	sotwResp := &envoy_service_discovery_v3.DiscoveryResponse{
+		TypeUrl:   clusterType,
+		Resources: []*anypb.Any{{TypeUrl: clusterType, Value: []byte("a")}, {TypeUrl: clusterType, Value: []byte("b")}},
+	}
+	deltaResp := &envoy_service_discovery_v3.DeltaDiscoveryResponse{
+		TypeUrl:   clusterType,
+		Resources: []*envoy_service_discovery_v3.Resource{{Name: "b", Resource: &anypb.Any{TypeUrl: clusterType, Value: []byte("b")}}},
+	}
+	RecordResponse(ProtocolSotw, clusterType, len(sotwResp.GetResources()), sotwResp)
+	RecordResponse(ProtocolSotw, clusterType, len(sotwResp.GetResources()), sotwResp)
+	RecordResponse(ProtocolDelta, clusterType, len(deltaResp.GetResources()), deltaResp)
+
+	currentMetrics := metricstest.MustGatherMetrics(t)
+
+	sotwLabels := []metrics.Label{{Name: "protocol", Value: "sotw"}, {Name: "type", Value: "Cluster"}}
+	deltaLabels := []metrics.Label{{Name: "protocol", Value: "delta"}, {Name: "type", Value: "Cluster"}}
+	currentMetrics.AssertMetrics("kgateway_xds_server_responses_total", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetric{Labels: deltaLabels, Value: 1},
+		&metricstest.ExpectedMetric{Labels: sotwLabels, Value: 2},
+	})
+	currentMetrics.AssertMetrics("kgateway_xds_server_response_bytes_total", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetric{Labels: deltaLabels, Value: float64(proto.Size(deltaResp))},
+		&metricstest.ExpectedMetric{Labels: sotwLabels, Value: float64(2 * proto.Size(sotwResp))},
+	})
+	currentMetrics.AssertMetrics("kgateway_xds_server_response_resources_total", []metricstest.ExpectMetric{
+		&metricstest.ExpectedMetric{Labels: deltaLabels, Value: 1},
+		&metricstest.ExpectedMetric{Labels: sotwLabels, Value: 4},
+	})
+}
+
+func TestRecordNack(t *testing.T) {
+	ResetMetrics()
+
+	RecordNack(ProtocolSotw, "ns", "gw", "type.googleapis.com/envoy.config.listener.v3.Listener")
+	RecordNack(ProtocolSotw, "ns", "gw", "type.googleapis.com/envoy.config.listener.v3.Listener")
+
+	currentMetrics := metricstest.MustGatherMetrics(t)
+	currentMetrics.AssertMetric("kgateway_xds_server_nacks_total", &metricstest.ExpectedMetric{
+		Labels: []metrics.Label{
+			{Name: "gateway", Value: "gw"},
+			{Name: "namespace", Value: "ns"},
+			{Name: "protocol", Value: "sotw"},
+			{Name: "type", Value: "Listener"},
+		},
+		Value: 2,
+	})
+}
@@ -1,19 +1,31 @@
 package plugins
 
 import (
+	"cmp"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/agentgateway/agentgateway/go/api"
 	"istio.io/istio/pkg/kube/krt"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
 )
 
 const (
 	a2aProtocol = "kgateway.dev/a2a"
+
+	defaultA2ACatalogPathPrefix = "/agents"
+	a2aAgentCardPath            = "/.well-known/agent.json"
 )
 
 // NewA2APlugin creates a new A2A policy plugin
@@ -33,7 +45,11 @@ func NewA2APlugin(agw *AgwCollections) AgentgatewayPlugin {
 	}
 }
 
-// translatePoliciesForService generates A2A policies for a single service
+// translatePoliciesForService generates A2A policies for a single service.
+// The A2A policy makes agentgateway rewrite the url of the agent cards served by the
+// service at /.well-known/agent.json to the URL the card was requested through, before
+// any URL rewrite of the route, so A2A clients keep talking to the agent through the
+// gateway, e.g. under the path of the agent in an A2A catalog.
 func translatePoliciesForService(svc *corev1.Service) []ADPPolicy {
 	logger := logging.New("agentgateway/plugins/a2a")
 	var a2aPolicies []ADPPolicy
@@ -42,7 +58,9 @@ func translatePoliciesForService(svc *corev1.Service) []ADPPolicy {
 		if port.AppProtocol != nil && *port.AppProtocol == a2aProtocol {
 			logger.Debug("found A2A service", "service", svc.Name, "namespace", svc.Namespace, "port", port.Port)
 
-			svcRef := fmt.Sprintf("%v/%v:%d", svc.Namespace, svc.Name, port.Port)
+			// 'service/{namespace}/{hostname}:{port}', the name agentgateway gives to the
+			// backend of the routes to the service
+			svcRef := fmt.Sprintf("service/%v/%v:%d", svc.Namespace, kubeutils.GetServiceHostname(svc.Name, svc.Namespace), port.Port)
 			policy := &api.Policy{
 				Name:   fmt.Sprintf("a2a/%s/%s/%d", svc.Namespace, svc.Name, port.Port),
 				Target: &api.PolicyTarget{Kind: &api.PolicyTarget_Backend{Backend: svcRef}},
@@ -57,3 +75,107 @@ func translatePoliciesForService(svc *corev1.Service) []ADPPolicy {
 
 	return a2aPolicies
 }
+
+// A2ACatalogEntry is a single agent listed in an A2A catalog.
+type A2ACatalogEntry struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// URL of the agent, relative to the gateway.
+	URL string `json:"url"`
+	// AgentCard is the URL of the agent card, relative to the gateway.
+	AgentCard string `json:"agentCard"`
+}
+
+// A2ACatalogDocument is the document served for an A2A catalog.
+type A2ACatalogDocument struct {
+	Agents []A2ACatalogEntry `json:"agents"`
+}
+
+// A2ACatalogAgent is an A2A agent selected by an A2A catalog.
+type A2ACatalogAgent struct {
+	Service *corev1.Service
+	// Port is the port of the Service using the A2A app protocol.
+	Port int32
+	// Path is the path prefix, relative to the gateway, under which the agent is routed.
+	Path string
+}
+
+// SelectA2ACatalogAgents returns the A2A agents selected by the given catalog, defined in the
+// given namespace. Agents are sorted by namespace and name.
+func SelectA2ACatalogAgents(
+	krtctx krt.HandlerContext,
+	services krt.Collection[*corev1.Service],
+	namespaces krt.Collection[*corev1.Namespace],
+	namespace string,
+	catalog *v1alpha1.A2ACatalog,
+) ([]A2ACatalogAgent, error) {
+	matchesNamespace := func(ns string) bool { return ns == namespace }
+	if catalog.NamespaceSelector != nil {
+		nsSelector, err := metav1.LabelSelectorAsSelector(catalog.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector: %w", err)
+		}
+		selected := make(map[string]bool)
+		for _, ns := range krt.Fetch(krtctx, namespaces) {
+			if nsSelector.Matches(labels.Set(ns.Labels)) {
+				selected[ns.Name] = true
+			}
+		}
+		matchesNamespace = func(ns string) bool { return selected[ns] }
+	}
+
+	svcSelector := labels.Everything()
+	if catalog.Selector != nil {
+		var err error
+		svcSelector, err = metav1.LabelSelectorAsSelector(catalog.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector: %w", err)
+		}
+	}
+
+	prefix := strings.TrimSuffix(ptr.Deref(catalog.PathPrefix, defaultA2ACatalogPathPrefix), "/")
+	var agents []A2ACatalogAgent
+	selected := krt.Fetch(krtctx, services, krt.FilterGeneric(func(obj any) bool {
+		svc := obj.(*corev1.Service)
+		return matchesNamespace(svc.Namespace) && svcSelector.Matches(labels.Set(svc.Labels))
+	}))
+	for _, svc := range selected {
+		port, ok := a2aPort(svc)
+		if !ok {
+			continue
+		}
+		agents = append(agents, A2ACatalogAgent{
+			Service: svc,
+			Port:    port,
+			Path:    prefix + "/" + svc.Namespace + "/" + svc.Name,
+		})
+	}
+	slices.SortFunc(agents, func(a, b A2ACatalogAgent) int {
+		return cmp.Or(cmp.Compare(a.Service.Namespace, b.Service.Namespace), cmp.Compare(a.Service.Name, b.Service.Name))
+	})
+	return agents, nil
+}
+
+// BuildA2ACatalog returns the JSON catalog of the given A2A agents.
+func BuildA2ACatalog(agents []A2ACatalogAgent) ([]byte, error) {
+	doc := A2ACatalogDocument{Agents: []A2ACatalogEntry{}}
+	for _, agent := range agents {
+		doc.Agents = append(doc.Agents, A2ACatalogEntry{
+			Name:      agent.Service.Name,
+			Namespace: agent.Service.Namespace,
+			URL:       agent.Path,
+			AgentCard: agent.Path + a2aAgentCardPath,
+		})
+	}
+	return json.Marshal(doc)
+}
+
+// a2aPort returns the first port of the service using the A2A app protocol.
+func a2aPort(svc *corev1.Service) (int32, bool) {
+	for _, port := range svc.Spec.Ports {
+		if port.AppProtocol != nil && *port.AppProtocol == a2aProtocol {
+			return port.Port, true
+		}
+	}
+	return 0, false
+}
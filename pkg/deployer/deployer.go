@@ -15,10 +15,12 @@ import (
 	"helm.sh/helm/v3/pkg/storage/driver"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +34,22 @@ var logger = logging.New("deployer")
 type ControlPlaneInfo struct {
 	XdsHost string
 	XdsPort uint32
+	// XdsNamespace is the namespace of the xDS Service, empty when the xDS host is not
+	// a Service of the cluster.
+	XdsNamespace string
+	// XdsTLS is set when the xDS server is served over TLS.
+	XdsTLS *XdsTLSInfo
+	// XdsDelta is set when the proxies use the incremental (delta) xDS protocol.
+	XdsDelta bool
+}
+
+// XdsTLSInfo is how the proxies connect to an xDS server served over TLS.
+type XdsTLSInfo struct {
+	// CACert returns the PEM-encoded CA bundle validating the certificate of the xDS server.
+	CACert func() []byte
+	// Auth is set when the proxies must authenticate to the xDS server with a projected
+	// ServiceAccount token.
+	Auth bool
 }
 
 // InferenceExtInfo defines the runtime state of Gateway API inference extensions.
@@ -127,6 +145,8 @@ func (d *Deployer) Render(name, ns string, vals map[string]any) ([]client.Object
 //
 // * use those helm values to render the helm chart the deployer was instantiated with into k8s objects
 //
+// * applies the overlays of the parameters to the rendered objects, if the HelmValuesGenerator is an OverlaysGetter
+//
 // * sets ownerRefs on all generated objects
 //
 // * returns the objects to be deployed by the caller
@@ -155,9 +175,67 @@ func (d *Deployer) GetObjsToDeploy(ctx context.Context, obj client.Object) ([]cl
 		return nil, fmt.Errorf("failed to get objects to deploy %s.%s: %w", obj.GetNamespace(), obj.GetName(), err)
 	}
 
+	if og, ok := d.helmValues.(OverlaysGetter); ok {
+		return d.applyOverlays(ctx, og, obj, objs)
+	}
+
 	return objs, nil
 }
 
+func (d *Deployer) applyOverlays(ctx context.Context, og OverlaysGetter, obj client.Object, objs []client.Object) ([]client.Object, error) {
+	allOverlays, err := og.GetOverlays(ctx, obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overlays %s.%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+	for _, overlays := range allOverlays {
+		objs, err = ApplyOverlays(d.cli.Scheme(), objs, overlays.Overlays)
+		if err != nil {
+			return nil, &OverlayError{Source: overlays.Source, Err: err}
+		}
+	}
+	return objs, nil
+}
+
+// CheckOverlays renders the objects for obj and applies the overlays of its parameters to them,
+// without deploying them, and returns whether the overlays of each source could be applied.
+// The overlays of a source that can't be applied are skipped, so that the overlays of the
+// following sources are still checked. It returns nil if the HelmValuesGenerator is not an
+// OverlaysGetter or if there is nothing to render for obj.
+func (d *Deployer) CheckOverlays(ctx context.Context, obj client.Object) ([]OverlaysResult, error) {
+	og, ok := d.helmValues.(OverlaysGetter)
+	if !ok {
+		return nil, nil
+	}
+	allOverlays, err := og.GetOverlays(ctx, obj)
+	if err != nil || len(allOverlays) == 0 {
+		return nil, err
+	}
+
+	vals, err := d.helmValues.GetValues(ctx, obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get helm values %s.%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+	if vals == nil {
+		return nil, nil
+	}
+	rname, rns := d.helmReleaseNameAndNamespaceGenerator(obj)
+	objs, err := d.Render(rname, rns, vals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get objects to deploy %s.%s: %w", obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	results := make([]OverlaysResult, 0, len(allOverlays))
+	for _, overlays := range allOverlays {
+		// ApplyOverlays patches the slice in place, so it gets a copy to keep objs as is on errors
+		patched, err := ApplyOverlays(d.cli.Scheme(), slices.Clone(objs), overlays.Overlays)
+		if err == nil {
+			objs = patched
+		}
+		results = append(results, OverlaysResult{Source: overlays.Source, Err: err})
+	}
+	return results, nil
+}
+
 func (d *Deployer) SetNamespaceAndOwner(owner client.Object, objs []client.Object) []client.Object {
 	// Ensure that each namespaced rendered object has its namespace and ownerRef set.
 	for _, renderedObj := range objs {
@@ -236,6 +314,48 @@ func (d *Deployer) DeployObjs(ctx context.Context, objs []client.Object) error {
 	return nil
 }
 
+// DeleteStaleObjs deletes the objects of the given GVKs that are controlled by the owner
+// but were not rendered in objs, such as the PodDisruptionBudget of a Gateway whose
+// GatewayParameters no longer configure one.
+func (d *Deployer) DeleteStaleObjs(ctx context.Context, owner client.Object, objs []client.Object, gvks []schema.GroupVersionKind) error {
+	for _, gvk := range gvks {
+		rendered := sets.New[string]()
+		for _, obj := range objs {
+			if obj.GetObjectKind().GroupVersionKind() == gvk {
+				rendered.Insert(obj.GetName())
+			}
+		}
+
+		list, err := d.cli.Scheme().New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err != nil {
+			return fmt.Errorf("failed to create list for %s: %w", gvk.String(), err)
+		}
+		objList, ok := list.(client.ObjectList)
+		if !ok {
+			return fmt.Errorf("list for %s is not a client.ObjectList", gvk.String())
+		}
+		if err := d.cli.List(ctx, objList, client.InNamespace(owner.GetNamespace())); err != nil {
+			return fmt.Errorf("failed to list %s in %s: %w", gvk.String(), owner.GetNamespace(), err)
+		}
+
+		items, err := meta.ExtractList(objList)
+		if err != nil {
+			return fmt.Errorf("failed to extract list of %s: %w", gvk.String(), err)
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok || rendered.Has(obj.GetName()) || !metav1.IsControlledBy(obj, owner) {
+				continue
+			}
+			logger.Info("deleting stale object", "kind", gvk.String(), "namespace", obj.GetNamespace(), "name", obj.GetName())
+			if err := d.cli.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to delete object %s %s: %w", gvk.String(), obj.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
+
 func (d *Deployer) GetGvksToWatch(ctx context.Context, vals map[string]any) ([]schema.GroupVersionKind, error) {
 	// The deployer watches all resources (Deployment, Service, ServiceAccount, and ConfigMap)
 	// that it creates via the deployer helm chart.
@@ -243,8 +363,7 @@ func (d *Deployer) GetGvksToWatch(ctx context.Context, vals map[string]any) ([]s
 	// In order to get the GVKs for the resources to watch, we need:
 	// - a placeholder Gateway (only the name and namespace are used, but the actual values don't matter,
 	//   as we only care about the GVKs of the rendered resources)
-	// - the minimal values that render all the proxy resources (HPA is not included because it's not
-	//   fully integrated/working at the moment)
+	// - the minimal values that render all the proxy resources
 	//
 	// Note: another option is to hardcode the GVKs here, but rendering the helm chart is a
 	// _slightly_ more dynamic way of getting the GVKs. It isn't a perfect solution since if
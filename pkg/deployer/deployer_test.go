@@ -9,7 +9,10 @@ import (
 	"time"
 
 	envoybootstrapv3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoygrpccredentialv3 "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	_ "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
 	"github.com/ghodss/yaml"
 	. "github.com/onsi/ginkgo/v2"
@@ -20,10 +23,14 @@ import (
 	"istio.io/istio/pkg/kube/krt/krttest"
 	"istio.io/istio/pkg/test"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -89,6 +96,17 @@ func (objs *clientObjects) findDeployment(namespace, name string) *appsv1.Deploy
 	return nil
 }
 
+func (objs *clientObjects) findDaemonSet(namespace, name string) *appsv1.DaemonSet {
+	for _, obj := range *objs {
+		if ds, ok := obj.(*appsv1.DaemonSet); ok {
+			if ds.Name == name && ds.Namespace == namespace {
+				return ds
+			}
+		}
+	}
+	return nil
+}
+
 func (objs *clientObjects) findServiceAccount(namespace, name string) *corev1.ServiceAccount {
 	for _, obj := range *objs {
 		if sa, ok := obj.(*corev1.ServiceAccount); ok {
@@ -122,6 +140,39 @@ func (objs *clientObjects) findConfigMap(namespace, name string) *corev1.ConfigM
 	return nil
 }
 
+func (objs *clientObjects) findPodDisruptionBudget(namespace, name string) *policyv1.PodDisruptionBudget {
+	for _, obj := range *objs {
+		if pdb, ok := obj.(*policyv1.PodDisruptionBudget); ok {
+			if pdb.Name == name && pdb.Namespace == namespace {
+				return pdb
+			}
+		}
+	}
+	return nil
+}
+
+func (objs *clientObjects) findHorizontalPodAutoscaler(namespace, name string) *autoscalingv2.HorizontalPodAutoscaler {
+	for _, obj := range *objs {
+		if hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler); ok {
+			if hpa.Name == name && hpa.Namespace == namespace {
+				return hpa
+			}
+		}
+	}
+	return nil
+}
+
+func (objs *clientObjects) findNetworkPolicy(namespace, name string) *networkingv1.NetworkPolicy {
+	for _, obj := range *objs {
+		if np, ok := obj.(*networkingv1.NetworkPolicy); ok {
+			if np.Name == name && np.Namespace == namespace {
+				return np
+			}
+		}
+	}
+	return nil
+}
+
 func (objs *clientObjects) getEnvoyConfig(namespace, name string) *envoybootstrapv3.Bootstrap {
 	cm := objs.findConfigMap(namespace, name).Data
 	var bootstrapCfg envoybootstrapv3.Bootstrap
@@ -484,6 +535,129 @@ var _ = Describe("Deployer", func() {
 		})
 	})
 
+	Context("overlays", func() {
+		var (
+			gwc    *api.GatewayClass
+			gwp    *gw2_v1alpha1.GatewayParameters
+			gw     *api.Gateway
+			cli    client.Client
+			inputs func() *deployer.Inputs
+		)
+		BeforeEach(func() {
+			gwc = defaultGatewayClassWithParamsRef()
+			gwp = defaultGatewayParams()
+			gw = defaultGateway()
+			inputs = func() *deployer.Inputs {
+				return &deployer.Inputs{
+					CommonCollections: newCommonCols(GinkgoT(), gwc, gw),
+					ControlPlane: deployer.ControlPlaneInfo{
+						XdsHost: "something.cluster.local",
+						XdsPort: 1234,
+					},
+					ImageInfo: &deployer.ImageInfo{
+						Registry: "foo",
+						Tag:      "bar",
+					},
+					GatewayClassName:         wellknown.DefaultGatewayClassName,
+					WaypointGatewayClassName: wellknown.DefaultWaypointClassName,
+					AgentGatewayClassName:    wellknown.DefaultAgentGatewayClassName,
+				}
+			}
+		})
+
+		newDeployer := func() *deployer.Deployer {
+			GinkgoHelper()
+			cli = fake.NewClientBuilder().
+				WithScheme(schemes.GatewayScheme()).
+				WithObjects(gwc, gwp).
+				Build()
+			chart, err := internaldeployer.LoadGatewayChart()
+			Expect(err).NotTo(HaveOccurred())
+			return deployer.NewDeployer(wellknown.DefaultGatewayControllerName, cli, chart,
+				internaldeployer.NewGatewayParameters(cli, inputs()),
+				internaldeployer.GatewayReleaseNameAndNamespace)
+		}
+
+		getObjsToDeploy := func() (clientObjects, error) {
+			GinkgoHelper()
+			return newDeployer().GetObjsToDeploy(context.Background(), gw)
+		}
+
+		It("patches the rendered objects", func() {
+			gwp.Spec.Kube.Overlays = []gw2_v1alpha1.ObjectOverlay{
+				{
+					Kind: "Deployment",
+					Patches: []gw2_v1alpha1.OverlayPatch{{
+						Patch: `
+spec:
+  template:
+    spec:
+      volumes:
+      - name: extra
+        emptyDir: {}
+`,
+					}},
+				},
+				{
+					Kind: "ConfigMap",
+					Name: ptr.To(gw.Name),
+					Patches: []gw2_v1alpha1.OverlayPatch{{
+						Type:  ptr.To(gw2_v1alpha1.OverlayPatchTypeJSONPatch),
+						Patch: `[{"op": "add", "path": "/metadata/annotations", "value": {"foo": "bar"}}]`,
+					}},
+				},
+			}
+
+			objs, err := getObjsToDeploy()
+			Expect(err).NotTo(HaveOccurred())
+
+			deployment := objs.findDeployment("", gw.Name)
+			Expect(deployment).NotTo(BeNil())
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", "extra")))
+			// the volumes rendered by the chart are kept
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", "envoy-config")))
+			Expect(objs.findConfigMap("", gw.Name).Annotations).To(HaveKeyWithValue("foo", "bar"))
+
+			results, err := newDeployer().CheckOverlays(context.Background(), gw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Source.GetName()).To(Equal(gwp.Name))
+			Expect(results[0].Err).NotTo(HaveOccurred())
+		})
+
+		It("reports the overlay that can't be applied", func() {
+			gwp.Spec.Kube.Overlays = []gw2_v1alpha1.ObjectOverlay{{
+				Kind: "Deployment",
+				Patches: []gw2_v1alpha1.OverlayPatch{{
+					Type:  ptr.To(gw2_v1alpha1.OverlayPatchTypeJSONPatch),
+					Patch: `[{"op": "remove", "path": "/spec/doesNotExist"}]`,
+				}},
+			}}
+
+			_, err := getObjsToDeploy()
+			var overlayErr *deployer.OverlayError
+			Expect(errors.As(err, &overlayErr)).To(BeTrue())
+			Expect(overlayErr.Source.GetName()).To(Equal(gwp.Name))
+
+			results, err := newDeployer().CheckOverlays(context.Background(), gw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Source.GetName()).To(Equal(gwp.Name))
+			Expect(results[0].Err).To(MatchError(ContainSubstring("overlay 0 for Deployment foo")))
+
+			// rendering doesn't report the overlays in the status of the GatewayParameters
+			var latest gw2_v1alpha1.GatewayParameters
+			Expect(cli.Get(context.Background(), client.ObjectKeyFromObject(gwp), &latest)).To(Succeed())
+			Expect(latest.Status.Conditions).To(BeEmpty())
+		})
+
+		It("has no overlays to check when the GatewayParameters has none", func() {
+			results, err := newDeployer().CheckOverlays(context.Background(), gw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+	})
+
 	Context("agentgateway", func() {
 		var (
 			gwp *gw2_v1alpha1.GatewayParameters
@@ -583,6 +757,48 @@ var _ = Describe("Deployer", func() {
 			cm := objs.findConfigMap(defaultNamespace, "agent-gateway")
 			Expect(cm).ToNot(BeNil())
 		})
+
+		It("does not deploy agentgateway when xDS TLS is enabled", func() {
+			gw := &api.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "agent-gateway",
+					Namespace: defaultNamespace,
+				},
+				Spec: api.GatewaySpec{
+					GatewayClassName: "agentgateway",
+					Listeners: []api.Listener{{
+						Name: "listener-1",
+						Port: 80,
+					}},
+				},
+			}
+			gwParams := internaldeployer.NewGatewayParameters(newFakeClientWithObjs(gwc, gwp), &deployer.Inputs{
+				CommonCollections: newCommonCols(GinkgoT(), gwc, gw),
+				ControlPlane: deployer.ControlPlaneInfo{
+					XdsHost: "something.cluster.local",
+					XdsPort: 1234,
+					XdsTLS: &deployer.XdsTLSInfo{
+						CACert: func() []byte { return []byte("xds-ca-cert") },
+						Auth:   true,
+					},
+				},
+				ImageInfo: &deployer.ImageInfo{
+					Registry: "foo",
+					Tag:      "bar",
+				},
+				GatewayClassName:         wellknown.DefaultGatewayClassName,
+				WaypointGatewayClassName: wellknown.DefaultWaypointClassName,
+				AgentGatewayClassName:    wellknown.DefaultAgentGatewayClassName,
+			})
+			chart, err := internaldeployer.LoadGatewayChart()
+			Expect(err).NotTo(HaveOccurred())
+			d := deployer.NewDeployer(wellknown.DefaultGatewayControllerName, newFakeClientWithObjs(gwc, gwp), chart,
+				gwParams,
+				internaldeployer.GatewayReleaseNameAndNamespace)
+
+			_, err = d.GetObjsToDeploy(context.Background(), gw)
+			Expect(err).To(MatchError(deployer.AgentGatewayXdsTLSErr))
+		})
 	})
 
 	Context("special cases", func() {
@@ -2277,6 +2493,347 @@ var _ = Describe("Deployer", func() {
 					return nil
 				},
 			}),
+			Entry("PodDisruptionBudget and HorizontalPodAutoscaler are set", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGateway(),
+				defaultGwp: &gw2_v1alpha1.GatewayParameters{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       wellknown.GatewayParametersGVK.Kind,
+						APIVersion: gw2_v1alpha1.GroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      wellknown.DefaultGatewayParametersName,
+						Namespace: defaultNamespace,
+						UID:       "1237",
+					},
+					Spec: gw2_v1alpha1.GatewayParametersSpec{
+						Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+							Deployment: &gw2_v1alpha1.ProxyDeployment{
+								Replicas: ptr.To(uint32(3)),
+							},
+							PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+								MinAvailable: ptr.To(intstr.FromString("50%")),
+								ExtraLabels: map[string]string{
+									"pdb-label": "foo",
+								},
+							},
+							HorizontalPodAutoscaler: &gw2_v1alpha1.HorizontalPodAutoscaler{
+								MinReplicas:                       ptr.To[int32](2),
+								MaxReplicas:                       5,
+								TargetMemoryUtilizationPercentage: ptr.To[int32](60),
+								ExtraAnnotations: map[string]string{
+									"hpa-annotation": "bar",
+								},
+							},
+						},
+					},
+				},
+				overrideGwp: &gw2_v1alpha1.GatewayParameters{},
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					deployment := objs.findDeployment(defaultNamespace, defaultServiceName)
+					Expect(deployment).NotTo(BeNil())
+					// the HPA manages the replicas
+					Expect(deployment.Spec.Replicas).To(BeNil())
+
+					pdb := objs.findPodDisruptionBudget(defaultNamespace, defaultServiceName)
+					Expect(pdb).NotTo(BeNil())
+					Expect(pdb.Spec.MinAvailable).To(Equal(ptr.To(intstr.FromString("50%"))))
+					Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+					Expect(pdb.Spec.Selector.MatchLabels).To(Equal(deployment.Spec.Selector.MatchLabels))
+					Expect(pdb.Labels).To(HaveKeyWithValue("pdb-label", "foo"))
+
+					hpa := objs.findHorizontalPodAutoscaler(defaultNamespace, defaultServiceName)
+					Expect(hpa).NotTo(BeNil())
+					Expect(hpa.Annotations).To(HaveKeyWithValue("hpa-annotation", "bar"))
+					Expect(hpa.Spec.ScaleTargetRef).To(Equal(autoscalingv2.CrossVersionObjectReference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       deployment.Name,
+					}))
+					Expect(hpa.Spec.MinReplicas).To(Equal(ptr.To[int32](2)))
+					Expect(hpa.Spec.MaxReplicas).To(Equal(int32(5)))
+					Expect(hpa.Spec.Metrics).To(HaveLen(1))
+					Expect(hpa.Spec.Metrics[0].Resource.Name).To(Equal(corev1.ResourceMemory))
+					Expect(hpa.Spec.Metrics[0].Resource.Target.AverageUtilization).To(Equal(ptr.To[int32](60)))
+					return nil
+				},
+			}),
+			Entry("DaemonSet with host network and host ports", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGateway(),
+				defaultGwp: &gw2_v1alpha1.GatewayParameters{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       wellknown.GatewayParametersGVK.Kind,
+						APIVersion: gw2_v1alpha1.GroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      wellknown.DefaultGatewayParametersName,
+						Namespace: defaultNamespace,
+						UID:       "1237",
+					},
+					Spec: gw2_v1alpha1.GatewayParametersSpec{
+						Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+							WorkloadKind: ptr.To(gw2_v1alpha1.WorkloadKindDaemonSet),
+							Deployment: &gw2_v1alpha1.ProxyDeployment{
+								Replicas: ptr.To(uint32(3)),
+							},
+							DaemonSet: &gw2_v1alpha1.ProxyDaemonSet{
+								HostNetwork: ptr.To(true),
+								HostPort:    ptr.To(true),
+							},
+						},
+					},
+				},
+				overrideGwp: &gw2_v1alpha1.GatewayParameters{},
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					Expect(objs.findDeployment(defaultNamespace, defaultServiceName)).To(BeNil())
+					ds := objs.findDaemonSet(defaultNamespace, defaultServiceName)
+					Expect(ds).NotTo(BeNil())
+					podSpec := ds.Spec.Template.Spec
+					Expect(podSpec.HostNetwork).To(BeTrue())
+					Expect(podSpec.DNSPolicy).To(Equal(corev1.DNSClusterFirstWithHostNet))
+					// network sysctls can't be set with the host network, so the proxy
+					// runs as root to bind the privileged listener port
+					if podSpec.SecurityContext != nil {
+						Expect(podSpec.SecurityContext.Sysctls).To(BeEmpty())
+					}
+					securityContext := podSpec.Containers[0].SecurityContext
+					Expect(securityContext.RunAsUser).To(Equal(ptr.To[int64](0)))
+					Expect(securityContext.RunAsNonRoot).To(Equal(ptr.To(false)))
+					Expect(securityContext.Capabilities).To(Equal(&corev1.Capabilities{
+						Drop: []corev1.Capability{"ALL"},
+						Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+					}))
+					ports := podSpec.Containers[0].Ports
+					Expect(ports).NotTo(BeEmpty())
+					for _, p := range ports {
+						if p.Name == "http-monitoring" {
+							continue
+						}
+						Expect(p.HostPort).To(Equal(p.ContainerPort))
+					}
+					return nil
+				},
+			}),
+			Entry("DaemonSet does not deploy an HPA", &input{
+				dInputs: defaultDeployerInputs(),
+				gw:      defaultGateway(),
+				defaultGwp: &gw2_v1alpha1.GatewayParameters{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       wellknown.GatewayParametersGVK.Kind,
+						APIVersion: gw2_v1alpha1.GroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      wellknown.DefaultGatewayParametersName,
+						Namespace: defaultNamespace,
+						UID:       "1237",
+					},
+					Spec: gw2_v1alpha1.GatewayParametersSpec{
+						Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+							WorkloadKind: ptr.To(gw2_v1alpha1.WorkloadKindDaemonSet),
+							HorizontalPodAutoscaler: &gw2_v1alpha1.HorizontalPodAutoscaler{
+								MaxReplicas: 5,
+							},
+						},
+					},
+				},
+				overrideGwp: &gw2_v1alpha1.GatewayParameters{},
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					ds := objs.findDaemonSet(defaultNamespace, defaultServiceName)
+					Expect(ds).NotTo(BeNil())
+					Expect(ds.Spec.Template.Spec.HostNetwork).To(BeFalse())
+					Expect(ds.Spec.Template.Spec.SecurityContext.Sysctls).To(ContainElement(corev1.Sysctl{
+						Name:  "net.ipv4.ip_unprivileged_port_start",
+						Value: "0",
+					}))
+					Expect(objs.findHorizontalPodAutoscaler(defaultNamespace, defaultServiceName)).To(BeNil())
+					return nil
+				},
+			}),
+			Entry("xDS TLS is set", func() *input {
+				inp := defaultInput()
+				inp.dInputs.ControlPlane.XdsTLS = &deployer.XdsTLSInfo{
+					CACert: func() []byte { return []byte("xds-ca-cert") },
+				}
+				return inp
+			}(), &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					bootstrapCfg := objs.getEnvoyConfig(defaultNamespace, defaultConfigMapName)
+					var xdsCluster *envoyclusterv3.Cluster
+					for _, c := range bootstrapCfg.GetStaticResources().GetClusters() {
+						if c.GetName() == "xds_cluster" {
+							xdsCluster = c
+						}
+					}
+					Expect(xdsCluster).NotTo(BeNil())
+					var tlsContext envoytlsv3.UpstreamTlsContext
+					Expect(xdsCluster.GetTransportSocket().GetTypedConfig().UnmarshalTo(&tlsContext)).To(Succeed())
+					Expect(tlsContext.GetSni()).To(Equal("something.cluster.local"))
+					Expect(tlsContext.GetCommonTlsContext().GetValidationContext().GetTrustedCa().GetInlineString()).To(Equal("xds-ca-cert"))
+
+					grpcServices := bootstrapCfg.GetDynamicResources().GetAdsConfig().GetGrpcServices()
+					Expect(grpcServices).To(HaveLen(1))
+					Expect(grpcServices[0].GetEnvoyGrpc().GetClusterName()).To(Equal("xds_cluster"))
+
+					deployment := objs.findDeployment(defaultNamespace, defaultDeploymentName)
+					Expect(deployment).NotTo(BeNil())
+					for _, v := range deployment.Spec.Template.Spec.Volumes {
+						Expect(v.Name).NotTo(Equal("xds-token"))
+					}
+					return nil
+				},
+			}),
+			Entry("xDS TLS and authentication are set", func() *input {
+				inp := defaultInput()
+				inp.dInputs.ControlPlane.XdsTLS = &deployer.XdsTLSInfo{
+					CACert: func() []byte { return []byte("xds-ca-cert") },
+					Auth:   true,
+				}
+				return inp
+			}(), &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					bootstrapCfg := objs.getEnvoyConfig(defaultNamespace, defaultConfigMapName)
+					grpcServices := bootstrapCfg.GetDynamicResources().GetAdsConfig().GetGrpcServices()
+					Expect(grpcServices).To(HaveLen(1))
+					googleGrpc := grpcServices[0].GetGoogleGrpc()
+					Expect(googleGrpc).NotTo(BeNil())
+					Expect(googleGrpc.GetTargetUri()).To(Equal("something.cluster.local:1234"))
+					Expect(googleGrpc.GetChannelCredentials().GetSslCredentials().GetRootCerts().GetInlineString()).To(Equal("xds-ca-cert"))
+					Expect(googleGrpc.GetCallCredentials()).To(HaveLen(1))
+					var metadataConfig envoygrpccredentialv3.FileBasedMetadataConfig
+					Expect(googleGrpc.GetCallCredentials()[0].GetFromPlugin().GetTypedConfig().UnmarshalTo(&metadataConfig)).To(Succeed())
+					Expect(metadataConfig.GetSecretData().GetFilename()).To(Equal("/var/run/secrets/tokens/xds-token"))
+					Expect(metadataConfig.GetHeaderKey()).To(Equal("authorization"))
+					Expect(metadataConfig.GetHeaderPrefix()).To(Equal("Bearer "))
+
+					deployment := objs.findDeployment(defaultNamespace, defaultDeploymentName)
+					Expect(deployment).NotTo(BeNil())
+					podSpec := deployment.Spec.Template.Spec
+					Expect(podSpec.Volumes).To(ContainElement(corev1.Volume{
+						Name: "xds-token",
+						VolumeSource: corev1.VolumeSource{
+							Projected: &corev1.ProjectedVolumeSource{
+								Sources: []corev1.VolumeProjection{{
+									ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+										Audience:          wellknown.XdsTokenAudience,
+										ExpirationSeconds: ptr.To[int64](3600),
+										Path:              "xds-token",
+									},
+								}},
+							},
+						},
+					}))
+					Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+						Name:      "xds-token",
+						MountPath: "/var/run/secrets/tokens",
+						ReadOnly:  true,
+					}))
+					return nil
+				},
+			}),
+			Entry("delta xDS is set", func() *input {
+				inp := defaultInput()
+				inp.dInputs.ControlPlane.XdsDelta = true
+				return inp
+			}(), &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					bootstrapCfg := objs.getEnvoyConfig(defaultNamespace, defaultConfigMapName)
+					Expect(bootstrapCfg.GetDynamicResources().GetAdsConfig().GetApiType()).To(Equal(envoycorev3.ApiConfigSource_DELTA_GRPC))
+					return nil
+				},
+			}),
+			Entry("NetworkPolicy is set", &input{
+				dInputs: func() *deployer.Inputs {
+					inputs := defaultDeployerInputs()
+					inputs.ControlPlane.XdsNamespace = "kgateway-system"
+					return inputs
+				}(),
+				gw: defaultGateway(),
+				defaultGwp: &gw2_v1alpha1.GatewayParameters{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       wellknown.GatewayParametersGVK.Kind,
+						APIVersion: gw2_v1alpha1.GroupVersion.String(),
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      wellknown.DefaultGatewayParametersName,
+						Namespace: defaultNamespace,
+						UID:       "1237",
+					},
+					Spec: gw2_v1alpha1.GatewayParametersSpec{
+						Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+							NetworkPolicy: &gw2_v1alpha1.NetworkPolicy{
+								ExtraEgressNamespaces: []string{"auth"},
+								ExtraEgressCIDRs:      []string{"0.0.0.0/0"},
+								ExtraLabels: map[string]string{
+									"np-label": "foo",
+								},
+							},
+						},
+					},
+				},
+				overrideGwp: &gw2_v1alpha1.GatewayParameters{},
+			}, &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					deployment := objs.findDeployment(defaultNamespace, defaultServiceName)
+					Expect(deployment).NotTo(BeNil())
+
+					np := objs.findNetworkPolicy(defaultNamespace, defaultServiceName)
+					Expect(np).NotTo(BeNil())
+					Expect(np.Labels).To(HaveKeyWithValue("np-label", "foo"))
+					Expect(np.Spec.PodSelector.MatchLabels).To(Equal(deployment.Spec.Selector.MatchLabels))
+					Expect(np.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+
+					// ingress is only allowed on the ports of the proxy container
+					Expect(np.Spec.Ingress).To(HaveLen(1))
+					var containerPorts []int32
+					for _, p := range deployment.Spec.Template.Spec.Containers[0].Ports {
+						containerPorts = append(containerPorts, p.ContainerPort)
+					}
+					var ingressPorts []int32
+					for _, p := range np.Spec.Ingress[0].Ports {
+						ingressPorts = append(ingressPorts, p.Port.IntVal)
+					}
+					Expect(ingressPorts).To(ConsistOf(containerPorts))
+
+					// egress to the xDS server, DNS, the extra namespaces and the extra CIDRs
+					namespacePeer := func(ns string) networkingv1.NetworkPolicyPeer {
+						return networkingv1.NetworkPolicyPeer{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"kubernetes.io/metadata.name": ns},
+							},
+						}
+					}
+					Expect(np.Spec.Egress).To(HaveLen(4))
+					Expect(np.Spec.Egress[0].Ports).To(HaveLen(1))
+					Expect(np.Spec.Egress[0].Ports[0].Port.IntVal).To(Equal(int32(1234)))
+					Expect(np.Spec.Egress[0].To).To(ConsistOf(namespacePeer("kgateway-system")))
+					Expect(np.Spec.Egress[1].Ports).To(HaveLen(2))
+					Expect(np.Spec.Egress[1].To).To(BeEmpty())
+					Expect(np.Spec.Egress[2].Ports).To(BeEmpty())
+					Expect(np.Spec.Egress[2].To).To(ConsistOf(namespacePeer("auth")))
+					Expect(np.Spec.Egress[3].Ports).To(BeEmpty())
+					Expect(np.Spec.Egress[3].To).To(ConsistOf(networkingv1.NetworkPolicyPeer{
+						IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"},
+					}))
+					return nil
+				},
+			}),
+			Entry("NetworkPolicy is not set (default)", defaultInput(), &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					Expect(objs.findNetworkPolicy(defaultNamespace, defaultServiceName)).To(BeNil())
+					return nil
+				},
+			}),
+			Entry("PodDisruptionBudget and HorizontalPodAutoscaler are not set (default)", defaultInput(), &expectedOutput{
+				validationFunc: func(objs clientObjects, inp *input) error {
+					Expect(objs.findPodDisruptionBudget(defaultNamespace, defaultServiceName)).To(BeNil())
+					Expect(objs.findHorizontalPodAutoscaler(defaultNamespace, defaultServiceName)).To(BeNil())
+					return nil
+				},
+			}),
 		)
 	})
 
@@ -2851,6 +3408,51 @@ var _ = Describe("DeployObjs", func() {
 	})
 })
 
+var _ = Describe("DeleteStaleObjs", func() {
+	var (
+		ns  = "test-ns"
+		ctx = context.Background()
+	)
+
+	It("deletes the objects of the owner that are no longer rendered", func() {
+		gw := &api.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: ns, UID: "1234"}}
+		gw.SetGroupVersionKind(wellknown.GatewayGVK)
+		otherGw := &api.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "other-gw", Namespace: ns, UID: "5678"}}
+		otherGw.SetGroupVersionKind(wellknown.GatewayGVK)
+		ownedBy := func(owner *api.Gateway, obj client.Object) client.Object {
+			obj.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(owner, wellknown.GatewayGVK)})
+			return obj
+		}
+
+		rendered := ownedBy(gw, &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "rendered", Namespace: ns}})
+		rendered.GetObjectKind().SetGroupVersionKind(wellknown.PodDisruptionBudgetGVK)
+		stale := ownedBy(gw, &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "stale", Namespace: ns}})
+		other := ownedBy(otherGw, &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: ns}})
+		unowned := &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "unowned", Namespace: ns}}
+
+		cli := newFakeClientWithObjs(rendered.DeepCopyObject().(client.Object), stale, other, unowned)
+		chart, err := internaldeployer.LoadGatewayChart()
+		Expect(err).ToNot(HaveOccurred())
+		d := deployer.NewDeployer(wellknown.DefaultGatewayControllerName, cli, chart,
+			nil,
+			internaldeployer.GatewayReleaseNameAndNamespace)
+
+		err = d.DeleteStaleObjs(ctx, gw, []client.Object{rendered}, []schema.GroupVersionKind{
+			wellknown.PodDisruptionBudgetGVK,
+			wellknown.HorizontalPodAutoscalerGVK,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		var pdbs policyv1.PodDisruptionBudgetList
+		Expect(cli.List(ctx, &pdbs, client.InNamespace(ns))).To(Succeed())
+		Expect(pdbs.Items).To(HaveLen(2))
+		var hpas autoscalingv2.HorizontalPodAutoscalerList
+		Expect(cli.List(ctx, &hpas, client.InNamespace(ns))).To(Succeed())
+		Expect(hpas.Items).To(HaveLen(1))
+		Expect(hpas.Items[0].Name).To(Equal("other"))
+	})
+})
+
 type fakeClient struct {
 	client.Client
 	getFunc   func(ctx context.Context, key client.ObjectKey, obj client.Object) error
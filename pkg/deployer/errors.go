@@ -3,6 +3,8 @@ package deployer
 import (
 	"errors"
 	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var (
@@ -12,4 +14,22 @@ var (
 			gwpNamespace, gwpName, resourceType, gwNamespace, gwName, fmt.Errorf("%s: %w", GatewayParametersError.Error(), err))
 	}
 	NilDeployerInputsErr = errors.New("nil inputs to NewDeployer")
+	// AgentGatewayXdsTLSErr is returned when agentgateway would be deployed while the xDS server is
+	// served over TLS, as agentgateway connects to it in plaintext and without a token.
+	AgentGatewayXdsTLSErr = errors.New("agentgateway doesn't support TLS to the xDS server, so it can't be deployed while xDS TLS is enabled")
 )
+
+// OverlayError is returned when an overlay can't be applied to the rendered objects.
+type OverlayError struct {
+	// Source is the object that defines the overlay.
+	Source client.Object
+	Err    error
+}
+
+func (e *OverlayError) Error() string {
+	return fmt.Sprintf("invalid overlay in %s/%s: %v", e.Source.GetNamespace(), e.Source.GetName(), e.Err)
+}
+
+func (e *OverlayError) Unwrap() error {
+	return e.Err
+}
@@ -1,6 +1,8 @@
 package deployer
 
 import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	api "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
@@ -10,6 +12,8 @@ import (
 
 var GetGatewayIR = DefaultGatewayIRGetter
 
+var GetServiceBackendNamespaces = DefaultServiceBackendNamespacesGetter
+
 func DefaultGatewayIRGetter(gw *api.Gateway, commonCollections *common.CommonCollections) *ir.Gateway {
 	gwKey := ir.ObjectSource{
 		Group:     wellknown.GatewayGVK.GroupKind().Group,
@@ -46,3 +50,30 @@ func GatewayIRFrom(gw *api.Gateway) *ir.Gateway {
 	}
 	return out
 }
+
+// DefaultServiceBackendNamespacesGetter returns the sorted namespaces of the Service backends
+// of the routes attached to the Gateway, to its allowed ListenerSets and to the Gateways merged
+// into it.
+func DefaultServiceBackendNamespacesGetter(irGW *ir.Gateway, commonCollections *common.CommonCollections) []string {
+	if commonCollections.Routes == nil {
+		return nil
+	}
+
+	namespaces := commonCollections.Routes.ServiceBackendNamespaces(
+		types.NamespacedName{Namespace: irGW.Namespace, Name: irGW.Name},
+		wellknown.GatewayGVK.Group, wellknown.GatewayGVK.Kind,
+	)
+	for _, ls := range irGW.AllowedListenerSets {
+		namespaces = namespaces.Union(commonCollections.Routes.ServiceBackendNamespaces(
+			types.NamespacedName{Namespace: ls.Namespace, Name: ls.Name},
+			wellknown.XListenerSetGVK.Group, wellknown.XListenerSetGVK.Kind,
+		))
+	}
+	for _, merged := range irGW.MergedGateways {
+		namespaces = namespaces.Union(commonCollections.Routes.ServiceBackendNamespaces(
+			types.NamespacedName{Namespace: merged.Namespace, Name: merged.Name},
+			wellknown.GatewayGVK.Group, wellknown.GatewayGVK.Kind,
+		))
+	}
+	return sets.List(namespaces)
+}
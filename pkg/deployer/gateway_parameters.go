@@ -31,18 +31,38 @@ type ExtraGatewayParameters struct {
 }
 
 // UpdateSecurityContexts updates the security contexts in the gateway parameters.
-// It applies the floating user ID if it is set and adds the sysctl to allow the privileged ports if the gateway uses them.
+// It applies the floating user ID if it is set and allows the privileged ports if the gateway uses them.
 func UpdateSecurityContexts(cfg *v1alpha1.KubernetesProxyConfig, ports []HelmPort) {
 	// If the floating user ID is set, unset the RunAsUser field from all security contexts
 	if cfg.GetFloatingUserId() != nil && *cfg.GetFloatingUserId() {
 		applyFloatingUserId(cfg)
 	}
 
-	if usesPrivilegedPorts(ports) {
+	if !usesPrivilegedPorts(ports) {
+		return
+	}
+	// Network sysctls can't be set on pods that use the host network, and the NET_BIND_SERVICE
+	// capability is only effective for root, as Kubernetes does not grant ambient capabilities,
+	// so those proxies run as root with the NET_BIND_SERVICE capability only.
+	if usesHostNetwork(cfg) {
+		runProxyAsRoot(cfg)
+	} else {
 		allowPrivilegedPorts(cfg)
 	}
 }
 
+// IsDaemonSet returns true if the proxy runs in a DaemonSet rather than a Deployment.
+func IsDaemonSet(cfg *v1alpha1.KubernetesProxyConfig) bool {
+	workloadKind := cfg.GetWorkloadKind()
+	return workloadKind != nil && *workloadKind == v1alpha1.WorkloadKindDaemonSet
+}
+
+// usesHostNetwork checks if the proxy pods run in the network namespace of their node
+func usesHostNetwork(cfg *v1alpha1.KubernetesProxyConfig) bool {
+	hostNetwork := cfg.GetDaemonSet().GetHostNetwork()
+	return IsDaemonSet(cfg) && hostNetwork != nil && *hostNetwork
+}
+
 // usesPrivilegedPorts checks the helm ports to see if any of them are less than 1024
 func usesPrivilegedPorts(ports []HelmPort) bool {
 	for _, p := range ports {
@@ -80,6 +100,33 @@ func allowPrivilegedPorts(cfg *v1alpha1.KubernetesProxyConfig) {
 	})
 }
 
+// runProxyAsRoot runs the proxy containers as root, dropping all the capabilities but
+// NET_BIND_SERVICE, so that they can bind the privileged ports of the host network.
+func runProxyAsRoot(cfg *v1alpha1.KubernetesProxyConfig) {
+	if cfg.EnvoyContainer == nil {
+		cfg.EnvoyContainer = &v1alpha1.EnvoyContainer{}
+	}
+	cfg.EnvoyContainer.SecurityContext = rootSecurityContext(cfg.EnvoyContainer.SecurityContext)
+	if cfg.AgentGateway != nil {
+		cfg.AgentGateway.SecurityContext = rootSecurityContext(cfg.AgentGateway.SecurityContext)
+	}
+}
+
+// rootSecurityContext returns the security context updated to run as root with the
+// NET_BIND_SERVICE capability only.
+func rootSecurityContext(securityContext *corev1.SecurityContext) *corev1.SecurityContext {
+	if securityContext == nil {
+		securityContext = &corev1.SecurityContext{}
+	}
+	securityContext.RunAsUser = ptr.To[int64](0)
+	securityContext.RunAsNonRoot = ptr.To(false)
+	securityContext.Capabilities = &corev1.Capabilities{
+		Drop: []corev1.Capability{"ALL"},
+		Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+	}
+	return securityContext
+}
+
 // applyFloatingUserId will set the RunAsUser field from all security contexts to null if the floatingUserId field is set
 func applyFloatingUserId(dstKube *v1alpha1.KubernetesProxyConfig) {
 	floatingUserId := dstKube.GetFloatingUserId()
@@ -0,0 +1,70 @@
+package deployer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	gw2_v1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+func TestUpdateSecurityContexts(t *testing.T) {
+	unprivilegedPortStart := corev1.Sysctl{Name: "net.ipv4.ip_unprivileged_port_start", Value: "0"}
+	nonRoot := func() *corev1.SecurityContext {
+		return &corev1.SecurityContext{
+			RunAsNonRoot: ptr.To(true),
+			RunAsUser:    ptr.To[int64](10101),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+				Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+			},
+		}
+	}
+	root := &corev1.SecurityContext{
+		RunAsNonRoot: ptr.To(false),
+		RunAsUser:    ptr.To[int64](0),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+			Add:  []corev1.Capability{"NET_BIND_SERVICE"},
+		},
+	}
+	config := func(hostNetwork bool) *gw2_v1alpha1.KubernetesProxyConfig {
+		cfg := &gw2_v1alpha1.KubernetesProxyConfig{
+			EnvoyContainer: &gw2_v1alpha1.EnvoyContainer{SecurityContext: nonRoot()},
+			AgentGateway:   &gw2_v1alpha1.AgentGateway{SecurityContext: nonRoot()},
+		}
+		if hostNetwork {
+			cfg.WorkloadKind = ptr.To(gw2_v1alpha1.WorkloadKindDaemonSet)
+			cfg.DaemonSet = &gw2_v1alpha1.ProxyDaemonSet{HostNetwork: ptr.To(true)}
+		}
+		return cfg
+	}
+	ports := func(port uint16) []HelmPort {
+		return []HelmPort{{Port: ptr.To(port)}}
+	}
+
+	t.Run("privileged ports are allowed with a sysctl", func(t *testing.T) {
+		cfg := config(false)
+		UpdateSecurityContexts(cfg, ports(80))
+		assert.Equal(t, []corev1.Sysctl{unprivilegedPortStart}, cfg.GetPodTemplate().GetSecurityContext().Sysctls)
+		assert.Equal(t, nonRoot(), cfg.GetEnvoyContainer().GetSecurityContext())
+	})
+
+	t.Run("host network proxies run as root to bind privileged ports", func(t *testing.T) {
+		cfg := config(true)
+		UpdateSecurityContexts(cfg, ports(443))
+		assert.Nil(t, cfg.GetPodTemplate().GetSecurityContext())
+		assert.Equal(t, root, cfg.GetEnvoyContainer().GetSecurityContext())
+		assert.Equal(t, root, cfg.GetAgentGateway().GetSecurityContext())
+	})
+
+	t.Run("host network proxies without privileged ports run as non root", func(t *testing.T) {
+		cfg := config(true)
+		UpdateSecurityContexts(cfg, ports(8080))
+		assert.Nil(t, cfg.GetPodTemplate().GetSecurityContext())
+		assert.Equal(t, nonRoot(), cfg.GetEnvoyContainer().GetSecurityContext())
+		assert.Equal(t, nonRoot(), cfg.GetAgentGateway().GetSecurityContext())
+	})
+}
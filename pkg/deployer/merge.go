@@ -29,17 +29,23 @@ func DeepMergeGatewayParameters(dst, src *v1alpha1.GatewayParameters) *v1alpha1.
 	dstKube := dst.Spec.Kube
 	srcKube := src.Spec.Kube
 
+	dstKube.WorkloadKind = MergePointers(dstKube.GetWorkloadKind(), srcKube.GetWorkloadKind())
 	dstKube.Deployment = deepMergeDeployment(dstKube.GetDeployment(), srcKube.GetDeployment())
+	dstKube.DaemonSet = deepMergeDaemonSet(dstKube.GetDaemonSet(), srcKube.GetDaemonSet())
 	dstKube.EnvoyContainer = deepMergeEnvoyContainer(dstKube.GetEnvoyContainer(), srcKube.GetEnvoyContainer())
 	dstKube.SdsContainer = deepMergeSdsContainer(dstKube.GetSdsContainer(), srcKube.GetSdsContainer())
 	dstKube.PodTemplate = deepMergePodTemplate(dstKube.GetPodTemplate(), srcKube.GetPodTemplate())
 	dstKube.Service = deepMergeService(dstKube.GetService(), srcKube.GetService())
 	dstKube.ServiceAccount = deepMergeServiceAccount(dstKube.GetServiceAccount(), srcKube.GetServiceAccount())
+	dstKube.PodDisruptionBudget = deepMergePodDisruptionBudget(dstKube.GetPodDisruptionBudget(), srcKube.GetPodDisruptionBudget())
+	dstKube.HorizontalPodAutoscaler = deepMergeHorizontalPodAutoscaler(dstKube.GetHorizontalPodAutoscaler(), srcKube.GetHorizontalPodAutoscaler())
+	dstKube.NetworkPolicy = deepMergeNetworkPolicy(dstKube.GetNetworkPolicy(), srcKube.GetNetworkPolicy())
 	dstKube.Istio = deepMergeIstioIntegration(dstKube.GetIstio(), srcKube.GetIstio())
 	dstKube.Stats = deepMergeStatsConfig(dstKube.GetStats(), srcKube.GetStats())
 	dstKube.AiExtension = deepMergeAIExtension(dstKube.GetAiExtension(), srcKube.GetAiExtension())
 	dstKube.FloatingUserId = MergePointers(dstKube.GetFloatingUserId(), srcKube.GetFloatingUserId())
 	dstKube.AgentGateway = deepMergeAgentGateway(dstKube.GetAgentGateway(), srcKube.GetAgentGateway())
+	dstKube.Overlays = DeepMergeSlices(dstKube.GetOverlays(), srcKube.GetOverlays())
 
 	return dst
 }
@@ -466,6 +472,66 @@ func deepMergeServiceAccount(dst, src *v1alpha1.ServiceAccount) *v1alpha1.Servic
 	return dst
 }
 
+func deepMergePodDisruptionBudget(dst, src *v1alpha1.PodDisruptionBudget) *v1alpha1.PodDisruptionBudget {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	// minAvailable and maxUnavailable are mutually exclusive, so an override of either replaces both
+	if src.GetMinAvailable() != nil || src.GetMaxUnavailable() != nil {
+		dst.MinAvailable = src.GetMinAvailable()
+		dst.MaxUnavailable = src.GetMaxUnavailable()
+	}
+
+	dst.ExtraLabels = DeepMergeMaps(dst.GetExtraLabels(), src.GetExtraLabels())
+	dst.ExtraAnnotations = DeepMergeMaps(dst.GetExtraAnnotations(), src.GetExtraAnnotations())
+
+	return dst
+}
+
+func deepMergeHorizontalPodAutoscaler(dst, src *v1alpha1.HorizontalPodAutoscaler) *v1alpha1.HorizontalPodAutoscaler {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.MinReplicas = MergePointers(dst.GetMinReplicas(), src.GetMinReplicas())
+	dst.MaxReplicas = MergeComparable(dst.GetMaxReplicas(), src.GetMaxReplicas())
+	dst.TargetCPUUtilizationPercentage = MergePointers(dst.GetTargetCPUUtilizationPercentage(), src.GetTargetCPUUtilizationPercentage())
+	dst.TargetMemoryUtilizationPercentage = MergePointers(dst.GetTargetMemoryUtilizationPercentage(), src.GetTargetMemoryUtilizationPercentage())
+	dst.ExtraLabels = DeepMergeMaps(dst.GetExtraLabels(), src.GetExtraLabels())
+	dst.ExtraAnnotations = DeepMergeMaps(dst.GetExtraAnnotations(), src.GetExtraAnnotations())
+
+	return dst
+}
+
+func deepMergeNetworkPolicy(dst, src *v1alpha1.NetworkPolicy) *v1alpha1.NetworkPolicy {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.ExtraEgressNamespaces = DeepMergeSlices(dst.GetExtraEgressNamespaces(), src.GetExtraEgressNamespaces())
+	dst.ExtraEgressCIDRs = DeepMergeSlices(dst.GetExtraEgressCIDRs(), src.GetExtraEgressCIDRs())
+	dst.ExtraLabels = DeepMergeMaps(dst.GetExtraLabels(), src.GetExtraLabels())
+	dst.ExtraAnnotations = DeepMergeMaps(dst.GetExtraAnnotations(), src.GetExtraAnnotations())
+
+	return dst
+}
+
 func deepMergeSdsContainer(dst, src *v1alpha1.SdsContainer) *v1alpha1.SdsContainer {
 	// nil src override means just use dst
 	if src == nil {
@@ -713,6 +779,22 @@ func deepMergeDeployment(dst, src *v1alpha1.ProxyDeployment) *v1alpha1.ProxyDepl
 	return dst
 }
 
+func deepMergeDaemonSet(dst, src *v1alpha1.ProxyDaemonSet) *v1alpha1.ProxyDaemonSet {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.HostNetwork = MergePointers(dst.GetHostNetwork(), src.GetHostNetwork())
+	dst.HostPort = MergePointers(dst.GetHostPort(), src.GetHostPort())
+
+	return dst
+}
+
 func deepMergeAIExtension(dst, src *v1alpha1.AiExtension) *v1alpha1.AiExtension {
 	// nil src override means just use dst
 	if src == nil {
@@ -760,6 +842,8 @@ func deepMergeAIExtensionStats(dst, src *v1alpha1.AiExtensionStats) *v1alpha1.Ai
 	}
 
 	dst.CustomLabels = DeepMergeSlices(dst.GetCustomLabels(), src.GetCustomLabels())
+	dst.ClientHeader = MergePointers(dst.GetClientHeader(), src.GetClientHeader())
+	dst.Clients = OverrideSlices(dst.GetClients(), src.GetClients())
 
 	return dst
 }
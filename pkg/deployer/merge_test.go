@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	gw2_v1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -67,6 +68,108 @@ func TestDeepMergeGatewayParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should replace pod disruption budget maxUnavailable with minAvailable override",
+			dst: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+							MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+							ExtraLabels:    map[string]string{"a": "aaa"},
+						},
+					},
+				},
+			},
+			src: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+							MinAvailable: ptr.To(intstr.FromString("50%")),
+							ExtraLabels:  map[string]string{"b": "bbb"},
+						},
+					},
+				},
+			},
+			want: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						PodDisruptionBudget: &gw2_v1alpha1.PodDisruptionBudget{
+							MinAvailable: ptr.To(intstr.FromString("50%")),
+							ExtraLabels:  map[string]string{"a": "aaa", "b": "bbb"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "should override horizontal pod autoscaler replicas",
+			dst: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						HorizontalPodAutoscaler: &gw2_v1alpha1.HorizontalPodAutoscaler{
+							MinReplicas:                    ptr.To[int32](2),
+							MaxReplicas:                    5,
+							TargetCPUUtilizationPercentage: ptr.To[int32](70),
+						},
+					},
+				},
+			},
+			src: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						HorizontalPodAutoscaler: &gw2_v1alpha1.HorizontalPodAutoscaler{
+							MaxReplicas: 10,
+						},
+					},
+				},
+			},
+			want: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						HorizontalPodAutoscaler: &gw2_v1alpha1.HorizontalPodAutoscaler{
+							MinReplicas:                    ptr.To[int32](2),
+							MaxReplicas:                    10,
+							TargetCPUUtilizationPercentage: ptr.To[int32](70),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "should merge network policy extra egress namespaces and CIDRs",
+			dst: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						NetworkPolicy: &gw2_v1alpha1.NetworkPolicy{
+							ExtraEgressNamespaces: []string{"auth"},
+							ExtraEgressCIDRs:      []string{"10.0.0.0/8"},
+						},
+					},
+				},
+			},
+			src: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						NetworkPolicy: &gw2_v1alpha1.NetworkPolicy{
+							ExtraEgressNamespaces: []string{"tracing"},
+							ExtraEgressCIDRs:      []string{"192.168.0.0/16"},
+							ExtraLabels:           map[string]string{"a": "aaa"},
+						},
+					},
+				},
+			},
+			want: &gw2_v1alpha1.GatewayParameters{
+				Spec: gw2_v1alpha1.GatewayParametersSpec{
+					Kube: &gw2_v1alpha1.KubernetesProxyConfig{
+						NetworkPolicy: &gw2_v1alpha1.NetworkPolicy{
+							ExtraEgressNamespaces: []string{"auth", "tracing"},
+							ExtraEgressCIDRs:      []string{"10.0.0.0/8", "192.168.0.0/16"},
+							ExtraLabels:           map[string]string{"a": "aaa"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "should override kube deployment omitReplicas",
 			dst: &gw2_v1alpha1.GatewayParameters{
@@ -0,0 +1,118 @@
+package deployer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+)
+
+// Overlays are the overlays defined by a parameters object, such as a GatewayParameters.
+type Overlays struct {
+	// Source is the object that defines the overlays.
+	Source   client.Object
+	Overlays []v1alpha1.ObjectOverlay
+}
+
+// OverlaysGetter is implemented by the HelmValuesGenerators whose parameters can define
+// overlays that patch the objects rendered for obj before they are deployed.
+type OverlaysGetter interface {
+	// GetOverlays returns the overlays for obj, in the order they are applied.
+	GetOverlays(ctx context.Context, obj client.Object) ([]Overlays, error)
+}
+
+// OverlaysResult is the result of applying the overlays of a source to the objects rendered for an object.
+type OverlaysResult struct {
+	Source client.Object
+	// Err is the error of the overlays, or nil if they could be applied.
+	Err error
+}
+
+// ApplyOverlays patches the objects with the overlays, in order, and returns the patched objects.
+// An overlay without a name patches all the objects of its kind, and an overlay that doesn't
+// match any object is ignored, as the overlays of a GatewayClass apply to all of its Gateways.
+func ApplyOverlays(scheme *runtime.Scheme, objs []client.Object, overlays []v1alpha1.ObjectOverlay) ([]client.Object, error) {
+	for i, overlay := range overlays {
+		for j, obj := range objs {
+			if obj.GetObjectKind().GroupVersionKind().Kind != overlay.Kind {
+				continue
+			}
+			if name := overlay.GetName(); name != nil && *name != obj.GetName() {
+				continue
+			}
+			patched, err := applyOverlay(scheme, obj, overlay)
+			if err != nil {
+				return nil, fmt.Errorf("overlay %d for %s %s: %w", i, overlay.Kind, obj.GetName(), err)
+			}
+			objs[j] = patched
+		}
+	}
+	return objs, nil
+}
+
+func applyOverlay(scheme *runtime.Scheme, obj client.Object, overlay v1alpha1.ObjectOverlay) (client.Object, error) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	// strategic merge patches need the typed object to look up the patch strategies,
+	// objects of kinds that aren't registered in the scheme fall back to a JSON merge patch
+	var typed runtime.Object
+	if scheme.Recognizes(gvk) {
+		typed, err = scheme.New(gvk)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for k, patch := range overlay.Patches {
+		raw, err := yaml.YAMLToJSON([]byte(patch.Patch))
+		if err != nil {
+			return nil, fmt.Errorf("invalid patch %d: %w", k, err)
+		}
+		switch patch.GetType() {
+		case v1alpha1.OverlayPatchTypeJSONPatch:
+			var p jsonpatch.Patch
+			p, err = jsonpatch.DecodePatch(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON patch %d: %w", k, err)
+			}
+			data, err = p.Apply(data)
+		default:
+			if typed != nil {
+				data, err = strategicpatch.StrategicMergePatch(data, raw, typed)
+			} else {
+				data, err = jsonpatch.MergePatch(data, raw)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply patch %d: %w", k, err)
+		}
+	}
+
+	var patched client.Object
+	if typed, ok := typed.(client.Object); ok {
+		patched = typed
+	} else {
+		patched = &unstructured.Unstructured{}
+	}
+	if err := json.Unmarshal(data, patched); err != nil {
+		return nil, fmt.Errorf("patched object is invalid: %w", err)
+	}
+
+	// the overlays customize the objects, they can't replace them with other ones
+	if patched.GetObjectKind().GroupVersionKind() != gvk || patched.GetName() != obj.GetName() || patched.GetNamespace() != obj.GetNamespace() {
+		return nil, fmt.Errorf("patches must not change the apiVersion, kind, name or namespace of the object")
+	}
+	return patched, nil
+}
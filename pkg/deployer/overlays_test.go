@@ -0,0 +1,182 @@
+package deployer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+)
+
+func TestApplyOverlays(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	newObjs := func() []client.Object {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "gw"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "kgateway-proxy", Image: "envoy"}},
+					},
+				},
+			},
+		}
+		deployment.SetGroupVersionKind(wellknown.DeploymentGVK)
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gw"}}
+		cm.SetGroupVersionKind(wellknown.ConfigMapGVK)
+		other := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+		other.SetGroupVersionKind(wellknown.ConfigMapGVK)
+		unknown := &unstructured.Unstructured{}
+		unknown.SetAPIVersion("example.com/v1")
+		unknown.SetKind("Widget")
+		unknown.SetName("gw")
+		return []client.Object{deployment, cm, other, unknown}
+	}
+
+	tests := []struct {
+		name     string
+		overlays []v1alpha1.ObjectOverlay
+		validate func(t *testing.T, objs []client.Object)
+		wantErr  string
+	}{
+		{
+			name: "strategic merge patch merges containers by name",
+			overlays: []v1alpha1.ObjectOverlay{{
+				Kind: "Deployment",
+				Patches: []v1alpha1.OverlayPatch{{
+					Patch: `
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: busybox
+      containers:
+      - name: kgateway-proxy
+        args: ["--foo"]
+`,
+				}},
+			}},
+			validate: func(t *testing.T, objs []client.Object) {
+				deployment := objs[0].(*appsv1.Deployment)
+				podSpec := deployment.Spec.Template.Spec
+				assert.Len(t, podSpec.InitContainers, 1)
+				assert.Len(t, podSpec.Containers, 1)
+				assert.Equal(t, "envoy", podSpec.Containers[0].Image)
+				assert.Equal(t, []string{"--foo"}, podSpec.Containers[0].Args)
+			},
+		},
+		{
+			name: "overlay with a name only patches that object",
+			overlays: []v1alpha1.ObjectOverlay{{
+				Kind: "ConfigMap",
+				Name: ptr.To("gw"),
+				Patches: []v1alpha1.OverlayPatch{{
+					Patch: `{"metadata": {"annotations": {"foo": "bar"}}}`,
+				}},
+			}},
+			validate: func(t *testing.T, objs []client.Object) {
+				assert.Equal(t, map[string]string{"foo": "bar"}, objs[1].GetAnnotations())
+				assert.Empty(t, objs[2].GetAnnotations())
+			},
+		},
+		{
+			name: "JSON patch",
+			overlays: []v1alpha1.ObjectOverlay{{
+				Kind: "Deployment",
+				Patches: []v1alpha1.OverlayPatch{{
+					Type:  ptr.To(v1alpha1.OverlayPatchTypeJSONPatch),
+					Patch: `[{"op": "replace", "path": "/spec/template/spec/containers/0/image", "value": "custom"}]`,
+				}},
+			}},
+			validate: func(t *testing.T, objs []client.Object) {
+				deployment := objs[0].(*appsv1.Deployment)
+				assert.Equal(t, "custom", deployment.Spec.Template.Spec.Containers[0].Image)
+			},
+		},
+		{
+			name: "unknown kinds are patched with a merge patch",
+			overlays: []v1alpha1.ObjectOverlay{{
+				Kind: "Widget",
+				Patches: []v1alpha1.OverlayPatch{{
+					Patch: `spec: {size: 3}`,
+				}},
+			}},
+			validate: func(t *testing.T, objs []client.Object) {
+				size, found, err := unstructured.NestedInt64(objs[3].(*unstructured.Unstructured).Object, "spec", "size")
+				assert.NoError(t, err)
+				assert.True(t, found)
+				assert.Equal(t, int64(3), size)
+			},
+		},
+		{
+			name: "overlay without a matching object is ignored",
+			overlays: []v1alpha1.ObjectOverlay{{
+				Kind: "Service",
+				Patches: []v1alpha1.OverlayPatch{{
+					Patch: `{"spec": {"type": "NodePort"}}`,
+				}},
+			}},
+			validate: func(t *testing.T, objs []client.Object) {
+				assert.Len(t, objs, 4)
+			},
+		},
+		{
+			name: "JSON patch on a missing path fails",
+			overlays: []v1alpha1.ObjectOverlay{{
+				Kind: "Deployment",
+				Patches: []v1alpha1.OverlayPatch{{
+					Type:  ptr.To(v1alpha1.OverlayPatchTypeJSONPatch),
+					Patch: `[{"op": "replace", "path": "/spec/template/spec/volumes/0/name", "value": "foo"}]`,
+				}},
+			}},
+			wantErr: "overlay 0 for Deployment gw: failed to apply patch 0",
+		},
+		{
+			name: "patch renaming the object fails",
+			overlays: []v1alpha1.ObjectOverlay{{
+				Kind: "ConfigMap",
+				Name: ptr.To("gw"),
+				Patches: []v1alpha1.OverlayPatch{{
+					Patch: `{"metadata": {"name": "renamed"}}`,
+				}},
+			}},
+			wantErr: "patches must not change the apiVersion, kind, name or namespace of the object",
+		},
+		{
+			name: "invalid patch fails",
+			overlays: []v1alpha1.ObjectOverlay{{
+				Kind: "Deployment",
+				Patches: []v1alpha1.OverlayPatch{{
+					Type:  ptr.To(v1alpha1.OverlayPatchTypeJSONPatch),
+					Patch: `{"op": "remove"}`,
+				}},
+			}},
+			wantErr: "invalid JSON patch 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyOverlays(scheme, newObjs(), tt.overlays)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.validate(t, got)
+		})
+	}
+}
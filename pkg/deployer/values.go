@@ -3,6 +3,7 @@ package deployer
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1"
@@ -23,14 +24,26 @@ type HelmGateway struct {
 	FullnameOverride *string `json:"fullnameOverride,omitempty"`
 
 	// deployment/service values
+	WorkloadKind   *string      `json:"workloadKind,omitempty"`
 	ReplicaCount   *uint32      `json:"replicaCount,omitempty"`
 	Ports          []HelmPort   `json:"ports,omitempty"`
 	Service        *HelmService `json:"service,omitempty"`
 	FloatingUserId *bool        `json:"floatingUserId,omitempty"`
 
+	// daemonset values
+	HostNetwork *bool `json:"hostNetwork,omitempty"`
+	HostPort    *bool `json:"hostPort,omitempty"`
+
 	// serviceaccount values
 	ServiceAccount *HelmServiceAccount `json:"serviceAccount,omitempty"`
 
+	// poddisruptionbudget and horizontalpodautoscaler values
+	PodDisruptionBudget     *HelmPodDisruptionBudget     `json:"podDisruptionBudget,omitempty"`
+	HorizontalPodAutoscaler *HelmHorizontalPodAutoscaler `json:"horizontalPodAutoscaler,omitempty"`
+
+	// networkpolicy values
+	NetworkPolicy *HelmNetworkPolicy `json:"networkPolicy,omitempty"`
+
 	// pod template values
 	ExtraPodAnnotations           map[string]string                 `json:"extraPodAnnotations,omitempty"`
 	ExtraPodLabels                map[string]string                 `json:"extraPodLabels,omitempty"`
@@ -104,11 +117,52 @@ type HelmServiceAccount struct {
 	ExtraLabels      map[string]string `json:"extraLabels,omitempty"`
 }
 
+type HelmPodDisruptionBudget struct {
+	MinAvailable     *intstr.IntOrString `json:"minAvailable,omitempty"`
+	MaxUnavailable   *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	ExtraAnnotations map[string]string   `json:"extraAnnotations,omitempty"`
+	ExtraLabels      map[string]string   `json:"extraLabels,omitempty"`
+}
+
+type HelmHorizontalPodAutoscaler struct {
+	MinReplicas                       *int32            `json:"minReplicas,omitempty"`
+	MaxReplicas                       *int32            `json:"maxReplicas,omitempty"`
+	TargetCPUUtilizationPercentage    *int32            `json:"targetCPUUtilizationPercentage,omitempty"`
+	TargetMemoryUtilizationPercentage *int32            `json:"targetMemoryUtilizationPercentage,omitempty"`
+	ExtraAnnotations                  map[string]string `json:"extraAnnotations,omitempty"`
+	ExtraLabels                       map[string]string `json:"extraLabels,omitempty"`
+}
+
+type HelmNetworkPolicy struct {
+	// XdsNamespace is the namespace of the xDS Service, when unset the egress to the
+	// xDS port is allowed to any destination.
+	XdsNamespace     *string           `json:"xdsNamespace,omitempty"`
+	EgressNamespaces []string          `json:"egressNamespaces,omitempty"`
+	EgressCIDRs      []string          `json:"egressCIDRs,omitempty"`
+	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
+	ExtraLabels      map[string]string `json:"extraLabels,omitempty"`
+}
+
 // helmXds represents the xds host and port to which envoy will connect
 // to receive xds config updates
 type HelmXds struct {
-	Host *string `json:"host,omitempty"`
-	Port *uint32 `json:"port,omitempty"`
+	Host *string      `json:"host,omitempty"`
+	Port *uint32      `json:"port,omitempty"`
+	Tls  *HelmXdsTls  `json:"tls,omitempty"`
+	Auth *HelmXdsAuth `json:"auth,omitempty"`
+	// use the incremental (delta) xDS protocol
+	Delta *bool `json:"delta,omitempty"`
+}
+
+type HelmXdsTls struct {
+	// PEM-encoded CA bundle validating the certificate of the xDS server
+	CaCert *string `json:"caCert,omitempty"`
+}
+
+type HelmXdsAuth struct {
+	// the proxy authenticates to the xDS server with a projected ServiceAccount token
+	// with this audience
+	TokenAudience *string `json:"tokenAudience,omitempty"`
 }
 
 type HelmIstio struct {
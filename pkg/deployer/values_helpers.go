@@ -10,6 +10,8 @@ import (
 
 	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -123,6 +125,66 @@ func GetServiceAccountValues(svcAccountConfig *v1alpha1.ServiceAccount) *HelmSer
 	}
 }
 
+// Convert pod disruption budget values from GatewayParameters into helm values to be used by the deployer.
+// A nil config means that no PodDisruptionBudget is deployed.
+func GetPodDisruptionBudgetValues(pdbConfig *v1alpha1.PodDisruptionBudget) *HelmPodDisruptionBudget {
+	if pdbConfig == nil {
+		return nil
+	}
+	vals := &HelmPodDisruptionBudget{
+		MinAvailable:     pdbConfig.GetMinAvailable(),
+		MaxUnavailable:   pdbConfig.GetMaxUnavailable(),
+		ExtraAnnotations: pdbConfig.GetExtraAnnotations(),
+		ExtraLabels:      pdbConfig.GetExtraLabels(),
+	}
+	if vals.MinAvailable == nil && vals.MaxUnavailable == nil {
+		// evict the proxy pods one at a time
+		vals.MaxUnavailable = ptr.To(intstr.FromInt32(1))
+	}
+	return vals
+}
+
+// Convert horizontal pod autoscaler values from GatewayParameters into helm values to be used by the deployer.
+// A nil config means that no HorizontalPodAutoscaler is deployed.
+func GetHorizontalPodAutoscalerValues(hpaConfig *v1alpha1.HorizontalPodAutoscaler) *HelmHorizontalPodAutoscaler {
+	if hpaConfig == nil {
+		return nil
+	}
+	vals := &HelmHorizontalPodAutoscaler{
+		MinReplicas:                       hpaConfig.GetMinReplicas(),
+		MaxReplicas:                       ptr.To(hpaConfig.GetMaxReplicas()),
+		TargetCPUUtilizationPercentage:    hpaConfig.GetTargetCPUUtilizationPercentage(),
+		TargetMemoryUtilizationPercentage: hpaConfig.GetTargetMemoryUtilizationPercentage(),
+		ExtraAnnotations:                  hpaConfig.GetExtraAnnotations(),
+		ExtraLabels:                       hpaConfig.GetExtraLabels(),
+	}
+	if vals.MinReplicas == nil {
+		vals.MinReplicas = ptr.To[int32](1)
+	}
+	if vals.TargetCPUUtilizationPercentage == nil && vals.TargetMemoryUtilizationPercentage == nil {
+		vals.TargetCPUUtilizationPercentage = ptr.To[int32](80)
+	}
+	return vals
+}
+
+// Convert network policy values from GatewayParameters into helm values to be used by the deployer.
+// A nil config means that no NetworkPolicy is deployed.
+func GetNetworkPolicyValues(npConfig *v1alpha1.NetworkPolicy, xdsNamespace string, backendNamespaces []string) *HelmNetworkPolicy {
+	if npConfig == nil {
+		return nil
+	}
+	vals := &HelmNetworkPolicy{
+		EgressNamespaces: sets.List(sets.New(backendNamespaces...).Insert(npConfig.GetExtraEgressNamespaces()...)),
+		EgressCIDRs:      npConfig.GetExtraEgressCIDRs(),
+		ExtraAnnotations: npConfig.GetExtraAnnotations(),
+		ExtraLabels:      npConfig.GetExtraLabels(),
+	}
+	if xdsNamespace != "" {
+		vals.XdsNamespace = &xdsNamespace
+	}
+	return vals
+}
+
 // Convert sds values from GatewayParameters into helm values to be used by the deployer.
 func GetSdsContainerValues(sdsContainerConfig *v1alpha1.SdsContainer) *HelmSdsContainer {
 	if sdsContainerConfig == nil {
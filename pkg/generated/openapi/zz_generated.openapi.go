@@ -16,10 +16,17 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.A2ACatalog":                                schema_kgateway_v2_api_v1alpha1_A2ACatalog(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIBackend":                                 schema_kgateway_v2_api_v1alpha1_AIBackend(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AILocalTokenRateLimit":                     schema_kgateway_v2_api_v1alpha1_AILocalTokenRateLimit(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIMemoryVectorStore":                       schema_kgateway_v2_api_v1alpha1_AIMemoryVectorStore(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPolicy":                                  schema_kgateway_v2_api_v1alpha1_AIPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptEnrichment":                        schema_kgateway_v2_api_v1alpha1_AIPromptEnrichment(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptGuard":                             schema_kgateway_v2_api_v1alpha1_AIPromptGuard(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCache":                           schema_kgateway_v2_api_v1alpha1_AISemanticCache(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCacheEmbedding":                  schema_kgateway_v2_api_v1alpha1_AISemanticCacheEmbedding(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCacheStore":                      schema_kgateway_v2_api_v1alpha1_AISemanticCacheStore(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AITokenRateLimit":                          schema_kgateway_v2_api_v1alpha1_AITokenRateLimit(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AWSGuardrailConfig":                        schema_kgateway_v2_api_v1alpha1_AWSGuardrailConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AccessLog":                                 schema_kgateway_v2_api_v1alpha1_AccessLog(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AccessLogFilter":                           schema_kgateway_v2_api_v1alpha1_AccessLogFilter(ref),
@@ -104,6 +111,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HealthCheck":                               schema_kgateway_v2_api_v1alpha1_HealthCheck(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HealthCheckGrpc":                           schema_kgateway_v2_api_v1alpha1_HealthCheckGrpc(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HealthCheckHttp":                           schema_kgateway_v2_api_v1alpha1_HealthCheckHttp(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HorizontalPodAutoscaler":                   schema_kgateway_v2_api_v1alpha1_HorizontalPodAutoscaler(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Host":                                      schema_kgateway_v2_api_v1alpha1_Host(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Http1ProtocolOptions":                      schema_kgateway_v2_api_v1alpha1_Http1ProtocolOptions(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Http2ProtocolOptions":                      schema_kgateway_v2_api_v1alpha1_Http2ProtocolOptions(ref),
@@ -132,25 +140,34 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Message":                                   schema_kgateway_v2_api_v1alpha1_Message(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MetadataKey":                               schema_kgateway_v2_api_v1alpha1_MetadataKey(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MetadataPathSegment":                       schema_kgateway_v2_api_v1alpha1_MetadataPathSegment(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelRouting":                              schema_kgateway_v2_api_v1alpha1_ModelRouting(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Moderation":                                schema_kgateway_v2_api_v1alpha1_Moderation(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MultiPoolConfig":                           schema_kgateway_v2_api_v1alpha1_MultiPoolConfig(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MultiPoolFailover":                         schema_kgateway_v2_api_v1alpha1_MultiPoolFailover(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.NamespacedObjectReference":                 schema_kgateway_v2_api_v1alpha1_NamespacedObjectReference(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.NetworkPolicy":                             schema_kgateway_v2_api_v1alpha1_NetworkPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OTelTracesSampler":                         schema_kgateway_v2_api_v1alpha1_OTelTracesSampler(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ObjectOverlay":                             schema_kgateway_v2_api_v1alpha1_ObjectOverlay(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenAIConfig":                              schema_kgateway_v2_api_v1alpha1_OpenAIConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenTelemetryAccessLogService":             schema_kgateway_v2_api_v1alpha1_OpenTelemetryAccessLogService(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenTelemetryTracingConfig":                schema_kgateway_v2_api_v1alpha1_OpenTelemetryTracingConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OutlierDetection":                          schema_kgateway_v2_api_v1alpha1_OutlierDetection(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OverlayPatch":                              schema_kgateway_v2_api_v1alpha1_OverlayPatch(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Parameters":                                schema_kgateway_v2_api_v1alpha1_Parameters(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PathOverride":                              schema_kgateway_v2_api_v1alpha1_PathOverride(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Pod":                                       schema_kgateway_v2_api_v1alpha1_Pod(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PodDisruptionBudget":                       schema_kgateway_v2_api_v1alpha1_PodDisruptionBudget(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyAncestorStatus":                      schema_kgateway_v2_api_v1alpha1_PolicyAncestorStatus(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyDisable":                             schema_kgateway_v2_api_v1alpha1_PolicyDisable(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PolicyStatus":                              schema_kgateway_v2_api_v1alpha1_PolicyStatus(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Port":                                      schema_kgateway_v2_api_v1alpha1_Port(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Priority":                                  schema_kgateway_v2_api_v1alpha1_Priority(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ProcessingMode":                            schema_kgateway_v2_api_v1alpha1_ProcessingMode(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PromptTemplate":                            schema_kgateway_v2_api_v1alpha1_PromptTemplate(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PromptTemplateVariable":                    schema_kgateway_v2_api_v1alpha1_PromptTemplateVariable(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PromptguardRequest":                        schema_kgateway_v2_api_v1alpha1_PromptguardRequest(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PromptguardResponse":                       schema_kgateway_v2_api_v1alpha1_PromptguardResponse(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ProxyDaemonSet":                            schema_kgateway_v2_api_v1alpha1_ProxyDaemonSet(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ProxyDeployment":                           schema_kgateway_v2_api_v1alpha1_ProxyDeployment(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimit":                                 schema_kgateway_v2_api_v1alpha1_RateLimit(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimitDescriptor":                       schema_kgateway_v2_api_v1alpha1_RateLimitDescriptor(ref),
@@ -159,6 +176,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimitPolicy":                           schema_kgateway_v2_api_v1alpha1_RateLimitPolicy(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimitProvider":                         schema_kgateway_v2_api_v1alpha1_RateLimitProvider(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Regex":                                     schema_kgateway_v2_api_v1alpha1_Regex(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RegexMask":                                 schema_kgateway_v2_api_v1alpha1_RegexMask(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RegexMatch":                                schema_kgateway_v2_api_v1alpha1_RegexMatch(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ResourceDetector":                          schema_kgateway_v2_api_v1alpha1_ResourceDetector(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ResponseFlagFilter":                        schema_kgateway_v2_api_v1alpha1_ResponseFlagFilter(ref),
@@ -167,6 +185,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Sampler":                                   schema_kgateway_v2_api_v1alpha1_Sampler(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SdsBootstrap":                              schema_kgateway_v2_api_v1alpha1_SdsBootstrap(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SdsContainer":                              schema_kgateway_v2_api_v1alpha1_SdsContainer(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SelfHostedConfig":                          schema_kgateway_v2_api_v1alpha1_SelfHostedConfig(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SelfHostedService":                         schema_kgateway_v2_api_v1alpha1_SelfHostedService(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SelfManagedGateway":                        schema_kgateway_v2_api_v1alpha1_SelfManagedGateway(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Service":                                   schema_kgateway_v2_api_v1alpha1_Service(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ServiceAccount":                            schema_kgateway_v2_api_v1alpha1_ServiceAccount(ref),
@@ -176,6 +196,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StaticBackend":                             schema_kgateway_v2_api_v1alpha1_StaticBackend(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StatsConfig":                               schema_kgateway_v2_api_v1alpha1_StatsConfig(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StatusCodeFilter":                          schema_kgateway_v2_api_v1alpha1_StatusCodeFilter(ref),
+		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StreamingPromptguard":                      schema_kgateway_v2_api_v1alpha1_StreamingPromptguard(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StringMatcher":                             schema_kgateway_v2_api_v1alpha1_StringMatcher(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SupportedLLMProvider":                      schema_kgateway_v2_api_v1alpha1_SupportedLLMProvider(ref),
 		"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TCPKeepalive":                              schema_kgateway_v2_api_v1alpha1_TCPKeepalive(ref),
@@ -573,11 +594,46 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_A2ACatalog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "A2ACatalog defines which A2A agents are listed in a catalog and how they are reached.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces whose agents are listed. When unset, only the agents in the namespace of the DirectResponse are listed. The agents in other namespaces are only listed if a ReferenceGrant allows the HTTPRoutes of the namespace of the DirectResponse to reference their Service.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the Services of the agents that are listed by their labels. When unset, all the agents of the selected namespaces are listed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"pathPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathPrefix is the path, relative to the gateway, under which agents are routed. Each agent is listed with the URL `<pathPrefix>/<namespace>/<service>`, and its agent card with the URL `<pathPrefix>/<namespace>/<service>/.well-known/agent.json`. The HTTPRoute rule using the DirectResponse gets a route to each listed agent matching its URL, on the hostnames of the HTTPRoute. The URL is stripped from the requests forwarded to the agent. Defaults to `/agents`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_AIBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AIBackend routes requests to LLM providers.\n\nRequests are sent to the chat completions API of the provider, except for requests whose path ends with `/embeddings`, `/images/generations` or `/audio/transcriptions`, which are sent to the embeddings, image generation and audio transcription APIs in the OpenAI format:\n\n  - OpenAI, Azure OpenAI and self-hosted OpenAI-compatible providers serve all of them.\n  - Gemini serves embeddings and image generation with its OpenAI-compatible API.\n  - Self-hosted Ollama providers serve embeddings with the `/api/embed` API.\n\nRequests to endpoints that the provider does not serve are rejected with a 404 status.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"llm": {
 						SchemaProps: spec.SchemaProps{
@@ -599,6 +655,56 @@ func schema_kgateway_v2_api_v1alpha1_AIBackend(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_AILocalTokenRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AILocalTokenRateLimit configures a token budget enforced by each proxy replica.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tokenBucket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenBucket represents the token budget. The maximum number of tokens of the bucket is the largest number of tokens that can be consumed in a burst.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TokenBucket"),
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Header is the name of the request header whose value identifies the caller, such as a user or tenant id. Each distinct value gets its own budget. If not specified, all requests share a single budget.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tokenBucket"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TokenBucket"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AIMemoryVectorStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AIMemoryVectorStore configures an in-memory vector store.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxEntries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxEntries is the maximum number of cached responses, per policy. The oldest entries are evicted first.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_AIPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -639,11 +745,23 @@ func schema_kgateway_v2_api_v1alpha1_AIPolicy(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"tokenRateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rate limit requests to the LLM provider by the number of tokens they consume instead of the number of requests. Prompt and completion tokens reported by the provider response are debited from the budget.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AITokenRateLimit"),
+						},
+					},
+					"semanticCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Serve cached responses for prompts that are semantically similar to prior prompts, instead of sending them to the LLM provider. Only non-streaming responses are cached.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCache"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptEnrichment", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptGuard", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.FieldDefault"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptEnrichment", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPromptGuard", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCache", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AITokenRateLimit", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.FieldDefault"},
 	}
 }
 
@@ -682,11 +800,25 @@ func schema_kgateway_v2_api_v1alpha1_AIPromptEnrichment(ref common.ReferenceCall
 							},
 						},
 					},
+					"templates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prompt templates that are read from ConfigMaps in the namespace of the policy and added to the prompt sent by the client. Many policies can share the same templates, so that system prompts are rotated by updating the ConfigMaps instead of each policy. Template messages are added closest to the prompt of the client: the messages in `prepend` come before the prepended templates, and the messages in `append` come after the appended templates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PromptTemplate"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Message"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Message", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PromptTemplate"},
 	}
 }
 
@@ -717,6 +849,126 @@ func schema_kgateway_v2_api_v1alpha1_AIPromptGuard(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_AISemanticCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AISemanticCache configures the semantic caching of LLM responses.\n\nPrompts are converted to embeddings by an embedding model. If the embedding of a prompt is similar enough to the embedding of a prior prompt to the same model, the response to the prior prompt is returned without calling the LLM provider. Otherwise, the response of the LLM provider is stored in the cache.\n\nCached responses are only returned to requests with the same `Authorization` header as the request they were cached for. Requests without an `Authorization` header share their cached responses with each other.\n\nThe following example caches the responses of the `openai` HTTPRoute, using the embedding model of the `openai-embeddings` Backend. ```yaml\n\n\tai:\n\t  semanticCache:\n\t    embedding:\n\t      backendRef:\n\t        name: openai-embeddings\n\t      model: text-embedding-3-small\n\t    similarityThreshold: \"0.95\"\n\t    ttl: 1h\n\n```",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"embedding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Embedding configures the model used to embed prompts.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCacheEmbedding"),
+						},
+					},
+					"store": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Store configures the vector store in which embeddings and responses are cached. If not specified, an in-memory store is used.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCacheStore"),
+						},
+					},
+					"similarityThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SimilarityThreshold is the minimum cosine similarity, between 0 and 1, of the embeddings of two prompts for the cached response of one to be returned for the other.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTL is how long a response is cached. If not specified, responses are cached until they are evicted by the store.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"embedding"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCacheEmbedding", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AISemanticCacheStore", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AISemanticCacheEmbedding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AISemanticCacheEmbedding configures the embedding model of a semantic cache.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"backendRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackendRef references the AI Backend, in the same namespace as the policy, that serves the embedding model. Only the `openai` and `azureopenai` providers are supported.\n\nThe auth token of the Backend is resolved by the AI extension of the Gateway. A `SecretRef` token is read from the `Authorization` key of the Secret mounted at `/var/run/secrets/kgateway/ai/<secret name>` in the AI extension container, e.g. with a GatewayParameters overlay. If it is not mounted, the `OPENAI_API_KEY` or `AZURE_OPENAI_API_KEY` environment variable of the AI extension is used.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"model": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Model is the name of the embedding model, such as `text-embedding-3-large`. If not specified, the `openai` provider uses `text-embedding-3-small`, rather than the model configured in the Backend, which usually is a chat model, and the `azureopenai` provider uses the model of its deployment.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"backendRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AISemanticCacheStore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AISemanticCacheStore configures the vector store of a semantic cache.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory stores the cache in the memory of each proxy replica.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIMemoryVectorStore"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIMemoryVectorStore"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_AITokenRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AITokenRateLimit configures a token budget for requests sent to the LLM provider.\n\nPrompt tokens are debited when the request is sent and completion tokens are debited once the response, or the response stream, completes. A request is rejected with a 429 status code once the budget is exhausted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"local": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Local enforces the token budget in each proxy replica.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AILocalTokenRateLimit"),
+						},
+					},
+					"global": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Global enforces the token budget through an external rate limit service. The hits of each descriptor are the number of tokens consumed by the request. The descriptors are prefixed with the entry `generic_key: ai_tokens`, so the token budgets don't share counters with the request limits of rateLimit.global; the rate limit service must define the token budgets under that entry.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimitPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AILocalTokenRateLimit", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimitPolicy"},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_AWSGuardrailConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1124,7 +1376,7 @@ func schema_kgateway_v2_api_v1alpha1_AiExtension(ref common.ReferenceCallback) c
 					},
 					"stats": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Additional stats config for AI Extension. This config can be useful for adding custom labels to the request metrics.\n\nExample: ```yaml stats:\n  customLabels:\n    - name: \"subject\"\n      metadataNamespace: \"envoy.filters.http.jwt_authn\"\n      metadataKey: \"principal:sub\"\n    - name: \"issuer\"\n      metadataNamespace: \"envoy.filters.http.jwt_authn\"\n      metadataKey: \"principal:iss\"\n```",
+							Description: "Additional stats config for AI Extension. This config can be useful for adding custom labels to the request metrics.\n\nExample: ```yaml stats:\n  clientHeader: x-client-id\n  customLabels:\n    - name: \"subject\"\n      metadataNamespace: \"envoy.filters.http.jwt_authn\"\n      metadataKey: \"principal:sub\"\n    - name: \"issuer\"\n      metadataNamespace: \"envoy.filters.http.jwt_authn\"\n      metadataKey: \"principal:iss\"\n```",
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiExtensionStats"),
 						},
 					},
@@ -1146,7 +1398,8 @@ func schema_kgateway_v2_api_v1alpha1_AiExtensionStats(ref common.ReferenceCallba
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AiExtensionStats configures the usage metrics of the AI Extension.\n\nThe AI Extension reports the prompt and completion tokens, the time to first token and the streaming duration of each LLM request, labeled by provider (`llm`), model (`model`), route (`route`) and client (`client`), in addition to the custom labels. The same values are added to the `ai.kgateway.io` dynamic metadata, so that they can be added to access logs, e.g. `%DYNAMIC_METADATA(ai.kgateway.io:prompt_tokens)%`.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"customLabels": {
 						SchemaProps: spec.SchemaProps{
@@ -1162,6 +1415,33 @@ func schema_kgateway_v2_api_v1alpha1_AiExtensionStats(ref common.ReferenceCallba
 							},
 						},
 					},
+					"clientHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientHeader is the request header that identifies the client of a request, such as an application or a team. Its value is used as the `client` label of the request metrics if it is one of the Clients, and `other` otherwise, so that clients cannot create arbitrary metric series. If not specified, or if the request does not have the header, the `client` label is `unknown`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clients": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Clients are the values of the ClientHeader reported as the `client` label of the request metrics. Required when ClientHeader is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -2871,10 +3151,18 @@ func schema_kgateway_v2_api_v1alpha1_DirectResponseSpec(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"a2aCatalog": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A2ACatalog responds with a JSON catalog of the A2A agents discovered by the gateway, i.e. Services with a port using the `kgateway.dev/a2a` appProtocol. Only supported by agentgateway.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.A2ACatalog"),
+						},
+					},
 				},
 				Required: []string{"status"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.A2ACatalog"},
 	}
 }
 
@@ -3647,10 +3935,36 @@ func schema_kgateway_v2_api_v1alpha1_GatewayParametersStatus(ref common.Referenc
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "The current conditions of the GatewayParameters. This is not currently implemented.",
+				Description: "The current conditions of the GatewayParameters.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions describe the current conditions of the GatewayParameters, such as whether its overlays could be applied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
 	}
 }
 
@@ -4250,41 +4564,116 @@ func schema_kgateway_v2_api_v1alpha1_HealthCheckHttp(ref common.ReferenceCallbac
 	}
 }
 
-func schema_kgateway_v2_api_v1alpha1_Host(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kgateway_v2_api_v1alpha1_HorizontalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Host defines a static backend host.",
+				Description: "Configuration for a Kubernetes HorizontalPodAutoscaler. If neither target utilization is set, the pods are scaled on a CPU utilization of 80%.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"host": {
+					"minReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Host is the host name to use for the backend.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The lower limit for the number of replicas. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"port": {
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Port is the port to use for the backend.",
+							Description: "The upper limit for the number of replicas.",
 							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-				},
-				Required: []string{"host", "port"},
-			},
-		},
-	}
-}
-
-func schema_kgateway_v2_api_v1alpha1_Http1ProtocolOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-msg-config-core-v3-http1protocoloptions) for more details.",
+					"targetCPUUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The target average CPU utilization of the pods, as a percentage of the requested CPU.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetMemoryUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The target average memory utilization of the pods, as a percentage of the requested memory.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"extraLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional labels to add to the HorizontalPodAutoscaler object metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"extraAnnotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional annotations to add to the HorizontalPodAutoscaler object metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"maxReplicas"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_Host(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Host defines a static backend host.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host is the host name to use for the backend.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port to use for the backend.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"host", "port"},
+			},
+		},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_Http1ProtocolOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-msg-config-core-v3-http1protocoloptions) for more details.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"enableTrailers": {
@@ -4566,12 +4955,25 @@ func schema_kgateway_v2_api_v1alpha1_KubernetesProxyConfig(ref common.ReferenceC
 				Description: "KubernetesProxyConfig configures the set of Kubernetes resources that will be provisioned for a given Gateway.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"workloadKind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The kind of Kubernetes workload that runs the proxy pods. Defaults to Deployment. A DaemonSet runs one proxy pod on each node selected by the pod template, such as the ingress nodes of a bare-metal cluster without a cloud load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"deployment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Use a Kubernetes deployment as the proxy workload type. Currently, this is the only supported workload type.",
+							Description: "Configuration for the proxy deployment, used when the workload kind is Deployment.",
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ProxyDeployment"),
 						},
 					},
+					"daemonSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration for the proxy daemonset, used when the workload kind is DaemonSet.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ProxyDaemonSet"),
+						},
+					},
 					"envoyContainer": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Configuration for the container running Envoy. If AgentGateway is enabled, the EnvoyContainer values will be ignored.",
@@ -4602,6 +5004,24 @@ func schema_kgateway_v2_api_v1alpha1_KubernetesProxyConfig(ref common.ReferenceC
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ServiceAccount"),
 						},
 					},
+					"podDisruptionBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration for a PodDisruptionBudget that limits the number of proxy pods that are evicted at the same time, such as during node drains. If unset, no PodDisruptionBudget is created.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PodDisruptionBudget"),
+						},
+					},
+					"horizontalPodAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration for a HorizontalPodAutoscaler that scales the proxy deployment. When set, the replicas of the deployment are left to the autoscaler. If unset, no HorizontalPodAutoscaler is created.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HorizontalPodAutoscaler"),
+						},
+					},
+					"networkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration for a NetworkPolicy that restricts the traffic of the proxy pods to the traffic they need: ingress on the listener ports, and egress to the xDS server, DNS and the namespaces of the Service backends of the routes attached to the Gateway. The other destinations, such as the Services of GatewayExtensions and the hosts of Backends outside of the cluster, are not derived from the routes and must be allowed with extraEgressNamespaces or extraEgressCIDRs. If unset, no NetworkPolicy is created.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.NetworkPolicy"),
+						},
+					},
 					"istio": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Configuration for the Istio integration.",
@@ -4633,11 +5053,25 @@ func schema_kgateway_v2_api_v1alpha1_KubernetesProxyConfig(ref common.ReferenceC
 							Format:      "",
 						},
 					},
+					"overlays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patches applied to the objects generated for the Gateway before they are deployed, for settings that are not exposed by the fields above. The overlays of the GatewayParameters of the GatewayClass are applied before those of the GatewayParameters of the Gateway.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ObjectOverlay"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentGateway", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiExtension", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.EnvoyContainer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.IstioIntegration", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Pod", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ProxyDeployment", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SdsContainer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Service", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ServiceAccount", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StatsConfig"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AgentGateway", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AiExtension", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.EnvoyContainer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HorizontalPodAutoscaler", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.IstioIntegration", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.NetworkPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ObjectOverlay", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Pod", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PodDisruptionBudget", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ProxyDaemonSet", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ProxyDeployment", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SdsContainer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Service", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ServiceAccount", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StatsConfig"},
 	}
 }
 
@@ -4673,6 +5107,13 @@ func schema_kgateway_v2_api_v1alpha1_LLMProvider(ref common.ReferenceCallback) c
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AuthHeaderOverride"),
 						},
 					},
+					"apiFormat": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The API format that clients use to send requests to the LLM provider. If not specified, clients must use the API format of the LLM provider. Set to `OpenAI` to accept OpenAI chat completions requests, which are translated to the API format of the LLM provider, along with their responses, streaming responses and tool calls. This allows clients to use the same OpenAI SDK for every provider. Translation is only supported for the Anthropic, Gemini and Vertex AI providers, and the Backend is rejected if it is set for another provider. Agentgateway ignores this field, as it accepts OpenAI chat completions requests for every provider, including Bedrock. All the providers of a MultiPool must use the same API format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"provider"},
 			},
@@ -5326,6 +5767,33 @@ func schema_kgateway_v2_api_v1alpha1_MetadataPathSegment(ref common.ReferenceCal
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_ModelRouting(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ModelRouting copies the model of a JSON request body to a request header and selects the route of the request again, so that HTTPRoute rules matching the header can send each model to its own backend behind a single URL, e.g. `gpt-4o` to an AI Backend and `llama-3` to an InferencePool.\n\nThe policy must apply to the route that matches the request before the header is set, typically a rule without a header match or the Gateway listener. The header sent by the client is always replaced, and set to an empty value when the body has no model or is not JSON. The request body is buffered to read the model.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Header is the request header the model is copied to. Defaults to `x-gateway-model-name`, the header used by the body based routing of the Gateway API Inference Extension.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"field": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Field is the field of the JSON request body that holds the model. Nested fields are separated by dots. Defaults to `model`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_Moderation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5368,12 +5836,74 @@ func schema_kgateway_v2_api_v1alpha1_MultiPoolConfig(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"failover": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failover configures how requests fail over to the next priority when a provider is rate limited or unhealthy. If not specified, requests only fail over when the connection to a provider fails.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MultiPoolFailover"),
+						},
+					},
 				},
 				Required: []string{"priorities"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Priority"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.MultiPoolFailover", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Priority"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_MultiPoolFailover(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MultiPoolFailover configures the failover of requests across the priorities of a MultiPool.\n\nA request that fails with one of the failover status codes is retried on the next priority. Providers that fail repeatedly are ejected from the pool for the cooldown duration, so that subsequent requests go straight to the next priority until the provider recovers. Routes to a MultiPool with failover use the failover retries in place of their own retry policy.\n\nThe provider that served a request is returned in the `x-kgateway-ai-provider` response header, which can be added to access logs with `%RESP(x-kgateway-ai-provider)%`.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"statusCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StatusCodes are the HTTP status codes of provider responses that trigger a failover. Defaults to 429, 500, 502, 503 and 504.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetries is the maximum number of times a request fails over to another priority.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxRetryAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRetryAfter is the longest `Retry-After` of a failed response that is honored before failing over. A `Retry-After` longer than this, or a response without it, fails over without waiting. If not specified, `Retry-After` is ignored.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"consecutiveFailures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsecutiveFailures is the number of consecutive server errors after which a provider is ejected from the pool.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cooldown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cooldown is how long an ejected provider is kept out of the pool.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -5406,6 +5936,81 @@ func schema_kgateway_v2_api_v1alpha1_NamespacedObjectReference(ref common.Refere
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_NetworkPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Configuration for a Kubernetes NetworkPolicy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"extraEgressNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional namespaces the proxy pods can send traffic to, such as the namespaces of external auth, rate limit or tracing services.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"extraEgressCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional IP blocks, in CIDR notation, the proxy pods can send traffic to. The destinations outside of the cluster, such as the hosts of the static, AI and Lambda Backends, are not derived from the routes, so they must be allowed here, e.g. with `0.0.0.0/0` and `::/0` to allow all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"extraLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional labels to add to the NetworkPolicy object metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"extraAnnotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Additional annotations to add to the NetworkPolicy object metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_OTelTracesSampler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5433,6 +6038,51 @@ func schema_kgateway_v2_api_v1alpha1_OTelTracesSampler(ref common.ReferenceCallb
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_ObjectOverlay(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ObjectOverlay patches the objects of a kind generated for a Gateway, such as its Deployment or Service.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The kind of the objects to patch, such as Deployment, Service or ConfigMap.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the object to patch. If unset, all the objects of the kind are patched.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"patches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The patches to apply to the objects, in order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OverlayPatch"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kind", "patches"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OverlayPatch"},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_OpenAIConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5603,6 +6253,35 @@ func schema_kgateway_v2_api_v1alpha1_OutlierDetection(ref common.ReferenceCallba
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_OverlayPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OverlayPatch is a patch applied to the objects selected by an overlay.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The type of the patch. Defaults to StrategicMerge.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"patch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The patch, in YAML or JSON: a partial object for a strategic merge patch, or a list of operations for a JSON patch.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"patch"},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_Parameters(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5792,21 +6471,80 @@ func schema_kgateway_v2_api_v1alpha1_Pod(ref common.ReferenceCallback) common.Op
 							Ref:         ref("k8s.io/api/core/v1.Probe"),
 						},
 					},
-					"livenessProbe": {
+					"livenessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the pod's liveness probe. Periodic probe of container service readiness. Container will be restarted if the probe fails. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.26/#probe-v1-core for details.",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"topologySpreadConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the pod's topology spread constraints. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.26/#topologyspreadconstraint-v1-core for details.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GracefulShutdownSpec", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_PodDisruptionBudget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Configuration for a Kubernetes PodDisruptionBudget. If neither minAvailable nor maxUnavailable is set, at most one pod is unavailable at a time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minAvailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number or percentage of pods that must remain available during an eviction.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number or percentage of pods that can be unavailable during an eviction.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"extraLabels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the pod's liveness probe. Periodic probe of container service readiness. Container will be restarted if the probe fails. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.26/#probe-v1-core for details.",
-							Ref:         ref("k8s.io/api/core/v1.Probe"),
+							Description: "Additional labels to add to the PodDisruptionBudget object metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"topologySpreadConstraints": {
+					"extraAnnotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the pod's topology spread constraints. See https://kubernetes.io/docs/reference/generated/kubernetes-api/v1.26/#topologyspreadconstraint-v1-core for details.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Additional annotations to add to the PodDisruptionBudget object metadata.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.TopologySpreadConstraint"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
@@ -5816,7 +6554,7 @@ func schema_kgateway_v2_api_v1alpha1_Pod(ref common.ReferenceCallback) common.Op
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GracefulShutdownSpec", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.TopologySpreadConstraint"},
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -6044,6 +6782,107 @@ func schema_kgateway_v2_api_v1alpha1_ProcessingMode(ref common.ReferenceCallback
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_PromptTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PromptTemplate references a versioned prompt template that is stored in a ConfigMap. Each key of the ConfigMap holds one version of the template, and the optional `version` key names the version that is used when the policy does not pin one.\n\nTemplates reference variables as `{{ name }}`, which are substituted with the values of request headers or verified JWT claims when the request is processed. Values are substituted as JSON string literals, such as `\"acme\"`, so that a value cannot end its quotes or start a new line to pose as instructions of the template.\n\n```yaml apiVersion: v1 kind: ConfigMap metadata:\n\n\tname: support-prompt\n\ndata:\n\n\tversion: v2\n\tv1: \"You are a support assistant.\"\n\tv2: \"You are a support assistant for the tenant {{ tenant }}. Address the user as {{ user }}.\"\n\n```",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reference to the ConfigMap that holds the versions of the template. The ConfigMap must be in the same namespace as the policy.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The version of the template, which is the key of the ConfigMap that holds the template. If unset, the version is read from the `version` key of the ConfigMap.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role of the message that is built from the template. Defaults to `SYSTEM`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"position": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the message is prepended or appended to the prompt sent by the client. Defaults to `Prepend`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"variables": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Variables that are substituted in the template. Variables that are referenced by the template but not defined here, or that have no value and no default, are replaced with an empty string.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PromptTemplateVariable"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"configMapRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.PromptTemplateVariable", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_PromptTemplateVariable(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PromptTemplateVariable defines where the value of a template variable is read from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the variable, as referenced by the template.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Read the value from a request header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Read the value from a claim of a JWT verified by the Envoy JWT filter, which stores the payloads of the tokens it verified in the `envoy.filters.http.jwt_authn` dynamic metadata, under the `payload_in_metadata` key of their provider. The claim is the path of the value in this metadata, separated by dots, such as `principal.org.name`. The variable has no value if the request has no verified token.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"default": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value that is used when the header or claim is not present in the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_PromptguardRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6102,11 +6941,44 @@ func schema_kgateway_v2_api_v1alpha1_PromptguardResponse(ref common.ReferenceCal
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Webhook"),
 						},
 					},
+					"streaming": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Streaming configures how the regex and webhook prompt guards inspect streaming (SSE) responses.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StreamingPromptguard"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Regex", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Webhook"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Regex", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.StreamingPromptguard", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Webhook"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_ProxyDaemonSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProxyDaemonSet configures the Proxy daemonset in Kubernetes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hostNetwork": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, the proxy pods use the network namespace of their node, so the listeners bind directly to the addresses of the node. If the Gateway has listeners on ports below 1024, the proxy container runs as root, with the NET_BIND_SERVICE capability only, as the sysctl that allows unprivileged users to bind these ports cannot be set with the host network.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"hostPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, the listener ports of the proxy pods are exposed on their node with host ports. This is not needed when hostNetwork is enabled, as the listeners already bind to the addresses of the node.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -6384,11 +7256,52 @@ func schema_kgateway_v2_api_v1alpha1_Regex(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"mask": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How the matched data is replaced when the action is `MASK`. Defaults to redacting the matches with their type, such as `<EMAIL_ADDRESS>`.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RegexMask"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RegexMask", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RegexMatch"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_RegexMask(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegexMask configures the replacement of the data matched by a regex prompt guard.\n\nThis example masks email addresses in requests with tokens, which are replaced back with the email addresses in the response to the client. ```yaml regex:\n\n\tbuiltins:\n\t- EMAIL\n\taction: MASK\n\tmask:\n\t  strategy: Tokenize\n\n```",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The replacement strategy for the matched data. Defaults to `Redact`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The text that replaces the matched data with the `Redact` strategy. If not specified, the matches are replaced with their type, such as `<EMAIL_ADDRESS>`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hashKeySecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Secret that holds, in its `key` entry, the HMAC key of the `Hash` strategy. Use a different key for each policy, so that the hashes of a policy cannot be correlated with the hashes of another one. The Secret is read by the AI extension of the Gateway, and must be mounted at `/var/run/secrets/kgateway/ai/<secret name>` in the AI extension container, e.g. with a GatewayParameters overlay. The matches are redacted while it is not mounted.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RegexMatch"},
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -6638,6 +7551,79 @@ func schema_kgateway_v2_api_v1alpha1_SdsContainer(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_SelfHostedConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SelfHostedConfig settings for a self-hosted LLM server that runs in the cluster, such as [vLLM](https://docs.vllm.ai), [Ollama](https://ollama.com) or [TGI](https://huggingface.co/docs/text-generation-inference).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Service of the LLM server, in the same namespace as the Backend. Requests are sent over plaintext HTTP, unless the port is 443.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SelfHostedService"),
+						},
+					},
+					"api": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The API that the LLM server serves. Defaults to `OpenAI`. `OpenAI` is the OpenAI-compatible chat completions API of vLLM, TGI and Ollama, which requests are sent to at `/v1/chat/completions`. `Ollama` is the native chat and generate API of Ollama. Requests keep their `/api/chat` or `/api/generate` path, and the token usage is read from the Ollama responses.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: The authorization token that the AI gateway uses to access the LLM server. This token is automatically sent in the `Authorization` header of the request and prefixed with `Bearer`. If unset, requests are sent without an authorization header.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SingleAuthToken"),
+						},
+					},
+					"model": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Override the model name, such as `llama3.1`. If unset, the model name is taken from the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"service"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SelfHostedService", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SingleAuthToken"},
+	}
+}
+
+func schema_kgateway_v2_api_v1alpha1_SelfHostedService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SelfHostedService is a Service that a self-hosted LLM server listens on.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the Service.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The port of the Service.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "port"},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_SelfManagedGateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6954,6 +7940,33 @@ func schema_kgateway_v2_api_v1alpha1_StatusCodeFilter(ref common.ReferenceCallba
 	}
 }
 
+func schema_kgateway_v2_api_v1alpha1_StreamingPromptguard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StreamingPromptguard configures the incremental inspection of streaming responses. The content of the streamed chunks is held back until the window is full and ends on a sentence boundary, then it is inspected by the response prompt guards before the chunks are sent to the client.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"windowSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowSize is the minimum number of characters of content to hold back before it is inspected. Larger windows give the prompt guards more context, smaller windows send the tokens to the client sooner. Defaults to 50.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The action to take if a regex pattern is matched in a streaming response. `MASK` masks the matched data in flight, `REJECT` terminates the stream with an error event and drops the rest of the response. Defaults to `MASK`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kgateway_v2_api_v1alpha1_StringMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7048,11 +8061,16 @@ func schema_kgateway_v2_api_v1alpha1_SupportedLLMProvider(ref common.ReferenceCa
 							Ref: ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.BedrockConfig"),
 						},
 					},
+					"selfhosted": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SelfHostedConfig"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnthropicConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureOpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.BedrockConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GeminiConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.VertexAIConfig"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AnthropicConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AzureOpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.BedrockConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.GeminiConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.OpenAIConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.SelfHostedConfig", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.VertexAIConfig"},
 	}
 }
 
@@ -7575,11 +8593,17 @@ func schema_kgateway_v2_api_v1alpha1_TrafficPolicySpec(ref common.ReferenceCallb
 							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Retry"),
 						},
 					},
+					"modelRouting": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ModelRouting routes LLM requests based on the model set in their JSON request body. It is applicable to HTTPRoutes, Gateway listeners and XListenerSets, and ignored for other targeted kinds.",
+							Ref:         ref("github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelRouting"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Buffer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CSRFPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CorsPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtAuthPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtProcPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HeaderModifiers", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReferenceWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelectorWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimit", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Retry", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Timeouts", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TransformationPolicy"},
+			"github.com/kgateway-dev/kgateway/v2/api/v1alpha1.AIPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Buffer", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CSRFPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.CorsPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtAuthPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ExtProcPolicy", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.HeaderModifiers", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetReferenceWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.LocalPolicyTargetSelectorWithSectionName", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.ModelRouting", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.RateLimit", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Retry", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.Timeouts", "github.com/kgateway-dev/kgateway/v2/api/v1alpha1.TransformationPolicy"},
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	"istio.io/istio/pkg/kube/krt"
@@ -275,6 +276,9 @@ type Gateway struct {
 	AllowedListenerSets ListenerSets
 	DeniedListenerSets  ListenerSets
 	Obj                 *gwv1.Gateway
+	// MergedGateways are the Gateways merged into this one, whose listeners are included in
+	// Listeners so that they are served by the proxy of this Gateway.
+	MergedGateways []*gwv1.Gateway
 
 	AttachedListenerPolicies AttachedPolicies
 	AttachedHttpPolicies     AttachedPolicies
@@ -294,7 +298,10 @@ func (c Gateway) Equals(in Gateway) bool {
 		c.AttachedHttpPolicies.Equals(in.AttachedHttpPolicies) &&
 		c.Listeners.Equals(in.Listeners) &&
 		c.AllowedListenerSets.Equals(in.AllowedListenerSets) &&
-		c.DeniedListenerSets.Equals(in.DeniedListenerSets)
+		c.DeniedListenerSets.Equals(in.DeniedListenerSets) &&
+		slices.EqualFunc(c.MergedGateways, in.MergedGateways, func(a, b *gwv1.Gateway) bool {
+			return versionEquals(a, b)
+		})
 }
 
 // Equals returns true if the two BackendRefIR instances are equal in cluster name, weight, backend object equality, and error.
@@ -25,6 +25,10 @@ const (
 	// RouteRuleReplacedReason is used with the Accepted=False condition when the route rule is replaced
 	// with a direct response.
 	RouteRuleReplacedReason = "RouteRuleReplaced"
+
+	// GatewayRejectedReason is used with the Programmed=False condition when a proxy of the Gateway
+	// rejects its xDS config.
+	GatewayRejectedReason = "Rejected"
 )
 
 // PolicyAttachmentState represents the state of a policy attachment
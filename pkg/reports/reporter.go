@@ -3,6 +3,8 @@ package reports
 import (
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -189,6 +191,19 @@ func (g *GatewayReport) listener(listenerName string) *ListenerReport {
 	return lr
 }
 
+// Clone returns a copy of the report whose conditions can be set without modifying the report.
+// The listener reports are shared with the report.
+func (g *GatewayReport) Clone() *GatewayReport {
+	if g == nil {
+		return nil
+	}
+	return &GatewayReport{
+		conditions:         slices.Clone(g.conditions),
+		listeners:          maps.Clone(g.listeners),
+		observedGeneration: g.observedGeneration,
+	}
+}
+
 func (g *GatewayReport) GetConditions() []metav1.Condition {
 	if g == nil {
 		return []metav1.Condition{}
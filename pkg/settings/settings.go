@@ -73,6 +73,32 @@ func (m *DnsLookupFamily) Decode(value string) error {
 	}
 }
 
+// GatewayProgrammedMode determines when a Gateway is reported as programmed.
+type GatewayProgrammedMode string
+
+const (
+	// GatewayProgrammedTranslated reports a Gateway as programmed once its xDS config is translated.
+	GatewayProgrammedTranslated GatewayProgrammedMode = "TRANSLATED"
+	// GatewayProgrammedAnyProxyAck reports a Gateway as programmed once at least one of its proxies
+	// accepted (ACKed) its latest xDS config.
+	GatewayProgrammedAnyProxyAck GatewayProgrammedMode = "ANY_PROXY_ACK"
+	// GatewayProgrammedAllProxiesAck reports a Gateway as programmed once all of its proxies, and
+	// at least one, accepted (ACKed) its latest xDS config.
+	GatewayProgrammedAllProxiesAck GatewayProgrammedMode = "ALL_PROXIES_ACK"
+)
+
+// Decode implements envconfig.Decoder.
+func (m *GatewayProgrammedMode) Decode(value string) error {
+	mode := GatewayProgrammedMode(value)
+	switch mode {
+	case GatewayProgrammedTranslated, GatewayProgrammedAnyProxyAck, GatewayProgrammedAllProxiesAck:
+		*m = mode
+		return nil
+	default:
+		return fmt.Errorf("invalid gateway programmed mode: %q", value)
+	}
+}
+
 type Settings struct {
 	// Controls the DnsLookupFamily for all static clusters created via Backend resources.
 	// If not set, kgateway will default to "V4_PREFERRED". Note that this is different
@@ -105,6 +131,39 @@ type Settings struct {
 	// This corresponds to the value of the `grpc-xds` port in the service.
 	XdsServicePort uint32 `split_words:"true" default:"9977"`
 
+	// EnableXdsTls serves xDS config over TLS. The proxies deployed by kgateway validate the
+	// certificate of the xDS server with the CA of the certificate.
+	// Can't be enabled with EnableAgentGateway, as agentgateway doesn't support TLS to the xDS server.
+	EnableXdsTls bool `split_words:"true" default:"false"`
+
+	// XdsTlsSecretName is the name of the Secret, in the kgateway install namespace, holding the
+	// certificate of the xDS server in the tls.crt and tls.key keys, and its CA in the ca.crt key.
+	// If not set, kgateway generates a self-signed CA, stores it in a Secret named after the xDS
+	// Service, and issues the certificate of the xDS server from it, rotating it before it expires.
+	// Only used when EnableXdsTls is set.
+	XdsTlsSecretName string `split_words:"true"`
+
+	// EnableXdsAuth requires the proxies to authenticate to the xDS server, either with a projected
+	// ServiceAccount token or with a client certificate issued by the CA in XdsClientCaSecretName,
+	// and only serves them the config of the Gateway matching their identity. Requires EnableXdsTls,
+	// and can't be enabled with agentgateway, which doesn't support TLS to the xDS server.
+	EnableXdsAuth bool `split_words:"true" default:"false"`
+
+	// XdsClientCaSecretName is the name of the Secret, in the kgateway install namespace, holding in
+	// its ca.crt key the CA of the client certificates the proxies may authenticate with. If not set,
+	// the proxies can only authenticate with a projected ServiceAccount token.
+	// Only used when EnableXdsAuth is set.
+	XdsClientCaSecretName string `split_words:"true"`
+
+	// XdsTrustDomain is the SPIFFE trust domain of the client certificates the proxies may
+	// authenticate with. Only used when XdsClientCaSecretName is set.
+	XdsTrustDomain string `split_words:"true" default:"cluster.local"`
+
+	// EnableDeltaXds makes the proxies deployed by kgateway use the incremental (delta) xDS
+	// protocol, so a change only sends the resources that changed instead of all the resources
+	// of its type. The xDS server serves both protocols regardless of this setting.
+	EnableDeltaXds bool `split_words:"true" default:"false"`
+
 	UseRustFormations bool `split_words:"true" default:"false"`
 
 	// EnableInferExt defines whether to enable/disable support for Gateway API inference extension.
@@ -153,6 +212,16 @@ type Settings struct {
 	// - "STRICT": Builds on STANDARD by running targeted validation
 	RouteReplacementMode RouteReplacementMode `split_words:"true" default:"STANDARD"`
 
+	// GatewayProgrammedMode determines when the Programmed condition of a Gateway is True.
+	// If not set, kgateway will default to "TRANSLATED". Supported values are:
+	// - "TRANSLATED": The xDS config of the Gateway is translated
+	// - "ANY_PROXY_ACK": At least one proxy of the Gateway accepted its latest xDS config
+	// - "ALL_PROXIES_ACK": All the proxies of the Gateway accepted its latest xDS config
+	// The acknowledgements are only known by the replica the proxies are connected to, and the
+	// status is only written by the leader, so the ACK modes are refused at startup when leader
+	// election is enabled with more than one replica.
+	GatewayProgrammedMode GatewayProgrammedMode `split_words:"true" default:"TRANSLATED"`
+
 	// EnableBuiltinDefaultMetrics enables the default builtin controller-runtime metrics and go runtime metrics.
 	// Since these metrics can be numerous, it is disabled by default.
 	EnableBuiltinDefaultMetrics bool `split_words:"true" default:"false"`
@@ -163,6 +232,10 @@ type Settings struct {
 
 	// Controls if leader election is disabled. Defaults to false.
 	DisableLeaderElection bool `split_words:"true" default:"false"`
+
+	// Replicas is the number of replicas of the controller. It must match the replicas of its
+	// Deployment, as it is used to refuse the settings that require a single replica.
+	Replicas int `split_words:"true" default:"1"`
 }
 
 // BuildSettings returns a zero-valued Settings obj if error is encountered when parsing env
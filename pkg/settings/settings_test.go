@@ -27,6 +27,12 @@ func allEnvVarsSet() map[string]string {
 		"KGW_XDS_SERVICE_HOST":               "my-xds-host",
 		"KGW_XDS_SERVICE_NAME":               "custom-svc",
 		"KGW_XDS_SERVICE_PORT":               "1234",
+		"KGW_ENABLE_XDS_TLS":                 "true",
+		"KGW_XDS_TLS_SECRET_NAME":            "xds-tls",
+		"KGW_ENABLE_XDS_AUTH":                "true",
+		"KGW_XDS_CLIENT_CA_SECRET_NAME":      "xds-client-ca",
+		"KGW_XDS_TRUST_DOMAIN":               "example.org",
+		"KGW_ENABLE_DELTA_XDS":               "true",
 		"KGW_USE_RUST_FORMATIONS":            "true",
 		"KGW_ENABLE_INFER_EXT":               "true",
 		"KGW_INFER_EXT_AUTO_PROVISION":       "true",
@@ -40,9 +46,11 @@ func allEnvVarsSet() map[string]string {
 		"KGW_ENABLE_AGENT_GATEWAY":           "true",
 		"KGW_WEIGHTED_ROUTE_PRECEDENCE":      "true",
 		"KGW_ROUTE_REPLACEMENT_MODE":         string(settings.RouteReplacementStrict),
+		"KGW_GATEWAY_PROGRAMMED_MODE":        string(settings.GatewayProgrammedAllProxiesAck),
 		"KGW_ENABLE_BUILTIN_DEFAULT_METRICS": "true",
 		"KGW_GLOBAL_POLICY_NAMESPACE":        "foo",
 		"KGW_DISABLE_LEADER_ELECTION":        "true",
+		"KGW_REPLICAS":                       "3",
 	}
 }
 
@@ -74,6 +82,7 @@ func TestSettings(t *testing.T) {
 				XdsServiceHost:              "",
 				XdsServiceName:              wellknown.DefaultXdsService,
 				XdsServicePort:              wellknown.DefaultXdsPort,
+				XdsTrustDomain:              "cluster.local",
 				UseRustFormations:           false,
 				EnableInferExt:              false,
 				InferExtAutoProvision:       false,
@@ -87,9 +96,11 @@ func TestSettings(t *testing.T) {
 				EnableAgentGateway:          false,
 				WeightedRoutePrecedence:     false,
 				RouteReplacementMode:        settings.RouteReplacementStandard,
+				GatewayProgrammedMode:       settings.GatewayProgrammedTranslated,
 				EnableBuiltinDefaultMetrics: false,
 				GlobalPolicyNamespace:       "",
 				DisableLeaderElection:       false,
+				Replicas:                    1,
 			},
 		},
 		{
@@ -106,6 +117,12 @@ func TestSettings(t *testing.T) {
 				XdsServiceHost:              "my-xds-host",
 				XdsServiceName:              "custom-svc",
 				XdsServicePort:              1234,
+				EnableXdsTls:                true,
+				XdsTlsSecretName:            "xds-tls",
+				EnableXdsAuth:               true,
+				XdsClientCaSecretName:       "xds-client-ca",
+				XdsTrustDomain:              "example.org",
+				EnableDeltaXds:              true,
 				UseRustFormations:           true,
 				EnableInferExt:              true,
 				InferExtAutoProvision:       true,
@@ -119,9 +136,11 @@ func TestSettings(t *testing.T) {
 				EnableAgentGateway:          true,
 				WeightedRoutePrecedence:     true,
 				RouteReplacementMode:        settings.RouteReplacementStrict,
+				GatewayProgrammedMode:       settings.GatewayProgrammedAllProxiesAck,
 				EnableBuiltinDefaultMetrics: true,
 				GlobalPolicyNamespace:       "foo",
 				DisableLeaderElection:       true,
+				Replicas:                    3,
 			},
 		},
 		{
@@ -152,6 +171,13 @@ func TestSettings(t *testing.T) {
 			},
 			expectedErrorStr: `invalid route replacement mode: "invalid"`,
 		},
+		{
+			name: "errors on invalid gateway programmed mode",
+			envVars: map[string]string{
+				"KGW_GATEWAY_PROGRAMMED_MODE": "invalid",
+			},
+			expectedErrorStr: `invalid gateway programmed mode: "invalid"`,
+		},
 		{
 			name: "ignores other env vars",
 			envVars: map[string]string{
@@ -166,6 +192,7 @@ func TestSettings(t *testing.T) {
 				IstioNamespace:              "istio-system",
 				XdsServiceName:              wellknown.DefaultXdsService,
 				XdsServicePort:              wellknown.DefaultXdsPort,
+				XdsTrustDomain:              "cluster.local",
 				DefaultImageRegistry:        "cr.kgateway.dev",
 				DefaultImageTag:             "",
 				DefaultImagePullPolicy:      "IfNotPresent",
@@ -176,6 +203,8 @@ func TestSettings(t *testing.T) {
 				EnableAgentGateway:          false,
 				WeightedRoutePrecedence:     false,
 				RouteReplacementMode:        settings.RouteReplacementStandard,
+				GatewayProgrammedMode:       settings.GatewayProgrammedTranslated,
+				Replicas:                    1,
 			},
 		},
 	}
@@ -0,0 +1,346 @@
+package bootstrap
+
+import (
+	"fmt"
+	"time"
+
+	envoybootstrapv3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrouterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	envoy_extensions_filters_network_http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/translator/utils"
+	kgwutils "github.com/kgateway-dev/kgateway/v2/internal/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/internal/kgateway/xds"
+)
+
+const (
+	// XdsClusterName is the name of the cluster of the control plane serving the xDS config.
+	XdsClusterName = "xds_cluster"
+	// AdminClusterName is the name of the cluster of the Envoy admin interface.
+	AdminClusterName = "admin_port_cluster"
+
+	// DefaultReadinessPort is the port of the readiness listener, as in the proxy deployments
+	// of the kgateway helm chart.
+	DefaultReadinessPort uint32 = 8082
+)
+
+// ProxyOptions configures the bootstrap of a proxy that is not deployed by kgateway,
+// such as an Envoy running on a VM outside Kubernetes.
+type ProxyOptions struct {
+	// GatewayName and GatewayNamespace identify the Gateway whose config is served to the proxy.
+	GatewayName      string
+	GatewayNamespace string
+	// NodeName identifies the proxy among the proxies of the Gateway, e.g. the VM hostname.
+	NodeName string
+
+	// XdsHost and XdsPort are the address of the kgateway xDS server.
+	XdsHost string
+	XdsPort uint32
+	// XdsTLS configures TLS to the xDS server, plaintext is used when nil.
+	XdsTLS *XdsTLSOptions
+	// XdsDelta makes the proxy use the incremental (delta) xDS protocol.
+	XdsDelta bool
+
+	// AdminAddress and AdminPort are the address of the Envoy admin interface.
+	AdminAddress string
+	AdminPort    uint32
+	// ReadinessPort is the port of the listener serving the readiness check on /ready,
+	// the listener is not added when zero.
+	ReadinessPort uint32
+}
+
+// XdsTLSOptions configures TLS to the xDS server, whose certificate is always validated.
+// The files are read by Envoy, so the paths must be valid on the host running the proxy.
+type XdsTLSOptions struct {
+	// CACertFile is the CA bundle used to validate the certificate of the xDS server.
+	CACertFile string
+	// CertFile and KeyFile are the client certificate presented to the xDS server, if any.
+	CertFile string
+	KeyFile  string
+	// SNI is the server name sent to the xDS server, defaults to the xDS host.
+	SNI string
+}
+
+// NodeID returns the node ID of the proxy. It follows the "name.namespace" convention of
+// the proxies deployed by kgateway, which use the pod name and namespace, so the control
+// plane can tell the proxies of a Gateway apart.
+func (o ProxyOptions) NodeID() string {
+	return o.NodeName + "." + o.GatewayNamespace
+}
+
+// Role returns the role of the proxy, which the control plane uses to pick the config
+// of the Gateway.
+func (o ProxyOptions) Role() string {
+	return xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, o.GatewayNamespace, o.GatewayName)
+}
+
+func (o ProxyOptions) validate() error {
+	switch {
+	case o.GatewayName == "":
+		return fmt.Errorf("gateway name is required")
+	case o.GatewayNamespace == "":
+		return fmt.Errorf("gateway namespace is required")
+	case o.NodeName == "":
+		return fmt.Errorf("node name is required")
+	case o.XdsHost == "":
+		return fmt.Errorf("xDS host is required")
+	case o.XdsPort == 0:
+		return fmt.Errorf("xDS port is required")
+	case o.AdminPort == 0:
+		return fmt.Errorf("admin port is required")
+	}
+	if o.XdsTLS == nil {
+		return nil
+	}
+	if o.XdsTLS.CACertFile == "" {
+		return fmt.Errorf("the CA certificate of the xDS server is required to use TLS")
+	}
+	if (o.XdsTLS.CertFile == "") != (o.XdsTLS.KeyFile == "") {
+		return fmt.Errorf("both the certificate and the key of the xDS client certificate are required")
+	}
+	return nil
+}
+
+// BuildProxy creates a complete bootstrap config for a proxy that gets its config from
+// the kgateway xDS server, equivalent to the one of the proxies deployed by kgateway.
+func BuildProxy(opts ProxyOptions) (*envoybootstrapv3.Bootstrap, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	if opts.AdminAddress == "" {
+		opts.AdminAddress = "127.0.0.1"
+	}
+
+	xdsCluster, err := buildXdsCluster(opts)
+	if err != nil {
+		return nil, err
+	}
+	clusters := []*envoyclusterv3.Cluster{
+		xdsCluster,
+		staticCluster(AdminClusterName, opts.AdminAddress, opts.AdminPort),
+	}
+
+	var listeners []*envoylistenerv3.Listener
+	if opts.ReadinessPort != 0 {
+		readinessListener, err := buildReadinessListener(opts.ReadinessPort)
+		if err != nil {
+			return nil, err
+		}
+		listeners = append(listeners, readinessListener)
+	}
+
+	adsConfigSource := &envoycorev3.ConfigSource{
+		ResourceApiVersion:    envoycorev3.ApiVersion_V3,
+		ConfigSourceSpecifier: &envoycorev3.ConfigSource_Ads{Ads: &envoycorev3.AggregatedConfigSource{}},
+	}
+
+	return &envoybootstrapv3.Bootstrap{
+		Node: &envoycorev3.Node{
+			Id:      opts.NodeID(),
+			Cluster: opts.GatewayName + "." + opts.GatewayNamespace,
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					xds.RoleKey: structpb.NewStringValue(opts.Role()),
+				},
+			},
+		},
+		Admin: &envoybootstrapv3.Admin{
+			Address: socketAddress(opts.AdminAddress, opts.AdminPort),
+		},
+		LayeredRuntime: &envoybootstrapv3.LayeredRuntime{
+			Layers: []*envoybootstrapv3.RuntimeLayer{
+				{
+					Name: "static_layer",
+					LayerSpecifier: &envoybootstrapv3.RuntimeLayer_StaticLayer{
+						StaticLayer: &structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"envoy.restart_features.use_eds_cache_for_ads": structpb.NewBoolValue(true),
+							},
+						},
+					},
+				},
+				{
+					Name:           "admin_layer",
+					LayerSpecifier: &envoybootstrapv3.RuntimeLayer_AdminLayer_{AdminLayer: &envoybootstrapv3.RuntimeLayer_AdminLayer{}},
+				},
+			},
+		},
+		StaticResources: &envoybootstrapv3.Bootstrap_StaticResources{
+			Listeners: listeners,
+			Clusters:  clusters,
+		},
+		DynamicResources: &envoybootstrapv3.Bootstrap_DynamicResources{
+			AdsConfig: &envoycorev3.ApiConfigSource{
+				ApiType:             adsApiType(opts),
+				TransportApiVersion: envoycorev3.ApiVersion_V3,
+				RateLimitSettings:   &envoycorev3.RateLimitSettings{},
+				GrpcServices: []*envoycorev3.GrpcService{{
+					TargetSpecifier: &envoycorev3.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{ClusterName: XdsClusterName},
+					},
+				}},
+			},
+			CdsConfig: adsConfigSource,
+			LdsConfig: adsConfigSource,
+		},
+	}, nil
+}
+
+func adsApiType(opts ProxyOptions) envoycorev3.ApiConfigSource_ApiType {
+	if opts.XdsDelta {
+		return envoycorev3.ApiConfigSource_DELTA_GRPC
+	}
+	return envoycorev3.ApiConfigSource_GRPC
+}
+
+func buildXdsCluster(opts ProxyOptions) (*envoyclusterv3.Cluster, error) {
+	cluster := &envoyclusterv3.Cluster{
+		Name:                 XdsClusterName,
+		AltStatName:          XdsClusterName,
+		ConnectTimeout:       durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STRICT_DNS},
+		RespectDnsTtl:        true,
+		LoadAssignment:       loadAssignment(XdsClusterName, opts.XdsHost, opts.XdsPort),
+		UpstreamConnectionOptions: &envoyclusterv3.UpstreamConnectionOptions{
+			TcpKeepalive: &envoycorev3.TcpKeepalive{
+				KeepaliveTime: wrapperspb.UInt32(10),
+			},
+		},
+	}
+	if err := utils.SetHttp2options(cluster); err != nil {
+		return nil, fmt.Errorf("failed to set the HTTP/2 options of the xDS cluster: %w", err)
+	}
+
+	if opts.XdsTLS != nil {
+		tlsContext := &envoytlsv3.UpstreamTlsContext{
+			Sni: opts.XdsTLS.SNI,
+			CommonTlsContext: &envoytlsv3.CommonTlsContext{
+				AlpnProtocols: []string{"h2"},
+			},
+		}
+		if tlsContext.GetSni() == "" {
+			tlsContext.Sni = opts.XdsHost
+		}
+		tlsContext.CommonTlsContext.ValidationContextType = &envoytlsv3.CommonTlsContext_ValidationContext{
+			ValidationContext: &envoytlsv3.CertificateValidationContext{
+				TrustedCa: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_Filename{Filename: opts.XdsTLS.CACertFile},
+				},
+			},
+		}
+		if opts.XdsTLS.CertFile != "" {
+			tlsContext.CommonTlsContext.TlsCertificates = []*envoytlsv3.TlsCertificate{{
+				CertificateChain: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_Filename{Filename: opts.XdsTLS.CertFile},
+				},
+				PrivateKey: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_Filename{Filename: opts.XdsTLS.KeyFile},
+				},
+			}}
+		}
+		tlsAny, err := kgwutils.MessageToAny(tlsContext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal the TLS context of the xDS cluster: %w", err)
+		}
+		cluster.TransportSocket = &envoycorev3.TransportSocket{
+			Name:       envoywellknown.TransportSocketTls,
+			ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: tlsAny},
+		}
+	}
+	return cluster, nil
+}
+
+func buildReadinessListener(port uint32) (*envoylistenerv3.Listener, error) {
+	routerAny, err := kgwutils.MessageToAny(&envoyrouterv3.Router{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Router: %w", err)
+	}
+	hcmAny, err := kgwutils.MessageToAny(&envoy_extensions_filters_network_http_connection_manager_v3.HttpConnectionManager{
+		StatPrefix: "ingress_http",
+		CodecType:  envoy_extensions_filters_network_http_connection_manager_v3.HttpConnectionManager_AUTO,
+		RouteSpecifier: &envoy_extensions_filters_network_http_connection_manager_v3.HttpConnectionManager_RouteConfig{
+			RouteConfig: &envoyroutev3.RouteConfiguration{
+				Name: "main_route",
+				VirtualHosts: []*envoyroutev3.VirtualHost{{
+					Name:    "local_service",
+					Domains: []string{"*"},
+					Routes: []*envoyroutev3.Route{{
+						Match: &envoyroutev3.RouteMatch{
+							PathSpecifier: &envoyroutev3.RouteMatch_Path{Path: "/ready"},
+						},
+						Action: &envoyroutev3.Route_Route{
+							Route: &envoyroutev3.RouteAction{
+								ClusterSpecifier: &envoyroutev3.RouteAction_Cluster{Cluster: AdminClusterName},
+							},
+						},
+					}},
+				}},
+			},
+		},
+		HttpFilters: []*envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter{{
+			Name:       envoywellknown.Router,
+			ConfigType: &envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter_TypedConfig{TypedConfig: routerAny},
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal HttpConnectionManager: %w", err)
+	}
+
+	return &envoylistenerv3.Listener{
+		Name:    "readiness_listener",
+		Address: socketAddress("0.0.0.0", port),
+		FilterChains: []*envoylistenerv3.FilterChain{{
+			Filters: []*envoylistenerv3.Filter{{
+				Name:       envoywellknown.HTTPConnectionManager,
+				ConfigType: &envoylistenerv3.Filter_TypedConfig{TypedConfig: hcmAny},
+			}},
+		}},
+	}, nil
+}
+
+func staticCluster(name, address string, port uint32) *envoyclusterv3.Cluster {
+	return &envoyclusterv3.Cluster{
+		Name:                 name,
+		ConnectTimeout:       durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STATIC},
+		LbPolicy:             envoyclusterv3.Cluster_ROUND_ROBIN,
+		LoadAssignment:       loadAssignment(name, address, port),
+	}
+}
+
+func loadAssignment(clusterName, address string, port uint32) *envoyendpointv3.ClusterLoadAssignment {
+	return &envoyendpointv3.ClusterLoadAssignment{
+		ClusterName: clusterName,
+		Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{
+			LbEndpoints: []*envoyendpointv3.LbEndpoint{{
+				HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
+					Endpoint: &envoyendpointv3.Endpoint{
+						Address: socketAddress(address, port),
+					},
+				},
+			}},
+		}},
+	}
+}
+
+func socketAddress(address string, port uint32) *envoycorev3.Address {
+	return &envoycorev3.Address{
+		Address: &envoycorev3.Address_SocketAddress{
+			SocketAddress: &envoycorev3.SocketAddress{
+				Address:       address,
+				PortSpecifier: &envoycorev3.SocketAddress_PortValue{PortValue: port},
+			},
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package bootstrap
+
+import (
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildProxy(t *testing.T) {
+	baseOpts := func() ProxyOptions {
+		return ProxyOptions{
+			GatewayName:      "gw",
+			GatewayNamespace: "infra",
+			NodeName:         "vm-1",
+			XdsHost:          "xds.example.com",
+			XdsPort:          9977,
+			AdminPort:        19000,
+			ReadinessPort:    DefaultReadinessPort,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		opts     func(o *ProxyOptions)
+		validate func(t *testing.T, opts ProxyOptions)
+		wantErr  string
+	}{
+		{
+			name: "node follows the conventions of the proxies deployed by kgateway",
+			validate: func(t *testing.T, opts ProxyOptions) {
+				bs, err := BuildProxy(opts)
+				require.NoError(t, err)
+				require.NoError(t, bs.ValidateAll())
+				assert.Equal(t, "vm-1.infra", bs.GetNode().GetId())
+				assert.Equal(t, "gw.infra", bs.GetNode().GetCluster())
+				assert.Equal(t, "kgateway-kube-gateway-api~infra~gw", bs.GetNode().GetMetadata().GetFields()["role"].GetStringValue())
+
+				clusters := bs.GetStaticResources().GetClusters()
+				require.Len(t, clusters, 2)
+				assert.Equal(t, XdsClusterName, clusters[0].GetName())
+				assert.Nil(t, clusters[0].GetTransportSocket())
+				assert.Equal(t, AdminClusterName, clusters[1].GetName())
+				assert.Equal(t, "127.0.0.1", bs.GetAdmin().GetAddress().GetSocketAddress().GetAddress())
+				assert.Len(t, bs.GetStaticResources().GetListeners(), 1)
+				assert.Equal(t, XdsClusterName, bs.GetDynamicResources().GetAdsConfig().GetGrpcServices()[0].GetEnvoyGrpc().GetClusterName())
+				assert.Equal(t, envoycorev3.ApiConfigSource_GRPC, bs.GetDynamicResources().GetAdsConfig().GetApiType())
+			},
+		},
+		{
+			name: "delta xDS",
+			opts: func(o *ProxyOptions) {
+				o.XdsDelta = true
+			},
+			validate: func(t *testing.T, opts ProxyOptions) {
+				bs, err := BuildProxy(opts)
+				require.NoError(t, err)
+				require.NoError(t, bs.ValidateAll())
+				assert.Equal(t, envoycorev3.ApiConfigSource_DELTA_GRPC, bs.GetDynamicResources().GetAdsConfig().GetApiType())
+			},
+		},
+		{
+			name: "TLS to the xDS server",
+			opts: func(o *ProxyOptions) {
+				o.XdsTLS = &XdsTLSOptions{
+					CACertFile: "/etc/kgateway/ca.crt",
+					CertFile:   "/etc/kgateway/tls.crt",
+					KeyFile:    "/etc/kgateway/tls.key",
+				}
+			},
+			validate: func(t *testing.T, opts ProxyOptions) {
+				bs, err := BuildProxy(opts)
+				require.NoError(t, err)
+				require.NoError(t, bs.ValidateAll())
+
+				ts := bs.GetStaticResources().GetClusters()[0].GetTransportSocket()
+				require.NotNil(t, ts)
+				tlsContext := &envoytlsv3.UpstreamTlsContext{}
+				require.NoError(t, ts.GetTypedConfig().UnmarshalTo(tlsContext))
+				assert.Equal(t, "xds.example.com", tlsContext.GetSni())
+				assert.Equal(t, "/etc/kgateway/ca.crt", tlsContext.GetCommonTlsContext().GetValidationContext().GetTrustedCa().GetFilename())
+				require.Len(t, tlsContext.GetCommonTlsContext().GetTlsCertificates(), 1)
+				assert.Equal(t, "/etc/kgateway/tls.key", tlsContext.GetCommonTlsContext().GetTlsCertificates()[0].GetPrivateKey().GetFilename())
+			},
+		},
+		{
+			name: "readiness listener is optional",
+			opts: func(o *ProxyOptions) {
+				o.ReadinessPort = 0
+			},
+			validate: func(t *testing.T, opts ProxyOptions) {
+				bs, err := BuildProxy(opts)
+				require.NoError(t, err)
+				assert.Empty(t, bs.GetStaticResources().GetListeners())
+			},
+		},
+		{
+			name: "missing xDS host",
+			opts: func(o *ProxyOptions) {
+				o.XdsHost = ""
+			},
+			wantErr: "xDS host is required",
+		},
+		{
+			name: "TLS without CA",
+			opts: func(o *ProxyOptions) {
+				o.XdsTLS = &XdsTLSOptions{SNI: "xds"}
+			},
+			wantErr: "the CA certificate of the xDS server is required to use TLS",
+		},
+		{
+			name: "client certificate without key",
+			opts: func(o *ProxyOptions) {
+				o.XdsTLS = &XdsTLSOptions{CACertFile: "/ca.crt", CertFile: "/tls.crt"}
+			},
+			wantErr: "both the certificate and the key of the xDS client certificate are required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := baseOpts()
+			if tt.opts != nil {
+				tt.opts(&opts)
+			}
+			if tt.wantErr != "" {
+				_, err := BuildProxy(opts)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			tt.validate(t, opts)
+		})
+	}
+}
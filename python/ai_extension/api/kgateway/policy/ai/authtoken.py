@@ -7,6 +7,7 @@ from typing import Optional
 class SingleAuthTokenKind(Enum):
     INLINE = "Inline"
     PASSTHROUGH = "Passthrough"
+    SECRET_REF = "SecretRef"
     UNKNOWN = "Unknown"
 
 
@@ -23,13 +24,13 @@ class LocalObjectReference:
 class SingleAuthToken:
     kind: SingleAuthTokenKind
     inline: Optional[str] = None
+    secret_ref: Optional[LocalObjectReference] = None
 
     def __repr__(self):
-        return f"SingleAuthToken(kind={self.kind}, inline={self.inline})"
+        return f"SingleAuthToken(kind={self.kind}, secret_ref={self.secret_ref})"
 
 
 def auth_token_from_json(json_data: dict) -> SingleAuthToken:
-    logging.error(f"json data: {json_data}")
     if json_data.get("kind") == "Inline":
         return SingleAuthToken(
             kind=SingleAuthTokenKind.INLINE,
@@ -39,6 +40,11 @@ def auth_token_from_json(json_data: dict) -> SingleAuthToken:
         return SingleAuthToken(
             kind=SingleAuthTokenKind.PASSTHROUGH,
         )
+    elif json_data.get("kind") == "SecretRef":
+        return SingleAuthToken(
+            kind=SingleAuthTokenKind.SECRET_REF,
+            secret_ref=LocalObjectReference.from_json(json_data.get("secretRef", {})),
+        )
 
     logging.error(f"Unknown auth token kind: {json_data.get('kind')}")
     return SingleAuthToken(kind=SingleAuthTokenKind.UNKNOWN)
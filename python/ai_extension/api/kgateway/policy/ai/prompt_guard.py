@@ -36,6 +36,8 @@ class BuiltIn(Enum):
     CREDIT_CARD = "CREDIT_CARD"
     PHONE_NUMBER = "PHONE_NUMBER"
     EMAIL = "EMAIL"
+    IBAN = "IBAN"
+    US_PASSPORT = "US_PASSPORT"
 
 
 class Action(Enum):
@@ -43,20 +45,48 @@ class Action(Enum):
     REJECT = "REJECT"
 
 
+class MaskStrategy(Enum):
+    REDACT = "Redact"
+    HASH = "Hash"
+    TOKENIZE = "Tokenize"
+
+
+@dataclass
+class RegexMask:
+    strategy: MaskStrategy = MaskStrategy.REDACT
+    replacement: Optional[str] = None
+    hash_key_secret_ref: Optional[str] = None
+    """
+    hash_key_secret_ref is the name of the mounted Secret that holds the HMAC key of the
+    Hash strategy.
+    """
+
+    @staticmethod
+    def from_json(data: dict) -> "RegexMask":
+        return RegexMask(
+            strategy=MaskStrategy(data.get("strategy", "Redact")),
+            replacement=data.get("replacement"),
+            hash_key_secret_ref=(data.get("hashKeySecretRef") or {}).get("name"),
+        )
+
+
 @dataclass
 class Regex:
     matches: Optional[List[RegexMatch]] = field(default_factory=list)
     builtins: Optional[List[BuiltIn]] = field(default_factory=list)
     action: Optional[Action] = Action.MASK  # Use Action class for default
+    mask: RegexMask = field(default_factory=RegexMask)
 
     @staticmethod
     def from_json(data: dict) -> "Regex":
         matches = [RegexMatch.from_json(m) for m in data.get("matches", [])]
         builtins = [BuiltIn[b] for b in data.get("builtins", [])]
+        mask_data = data.get("mask")
         return Regex(
             matches=matches,
             builtins=builtins,
             action=Action(data.get("action", "MASK")),
+            mask=RegexMask.from_json(mask_data) if mask_data else RegexMask(),
         )
 
 
@@ -142,10 +172,28 @@ class PromptguardRequest:
     moderation: Optional[Moderation] = None
 
 
+# The default number of characters of a streaming response held back before it is inspected
+DEFAULT_STREAMING_WINDOW_SIZE = 50
+
+
+@dataclass
+class StreamingPromptguard:
+    window_size: int = DEFAULT_STREAMING_WINDOW_SIZE
+    action: Action = Action.MASK
+
+    @staticmethod
+    def from_json(data: dict) -> "StreamingPromptguard":
+        return StreamingPromptguard(
+            window_size=data.get("windowSize", DEFAULT_STREAMING_WINDOW_SIZE),
+            action=Action(data.get("action", "MASK")),
+        )
+
+
 @dataclass
 class PromptguardResponse:
     regex: Optional[Regex] = None
     webhook: Optional[Webhook] = None
+    streaming: StreamingPromptguard = field(default_factory=StreamingPromptguard)
 
 
 def resp_from_json(data: str) -> PromptguardResponse:
@@ -162,9 +210,15 @@ def resp_from_json(data: str) -> PromptguardResponse:
     if webhook_data:
         webhook = Webhook.from_json(webhook_data)
 
+    streaming_data = response_data.get("streaming")
+    streaming = StreamingPromptguard()
+    if streaming_data:
+        streaming = StreamingPromptguard.from_json(streaming_data)
+
     return PromptguardResponse(
         regex=regex,
         webhook=webhook,
+        streaming=streaming,
     )
 
 
@@ -0,0 +1,45 @@
+import json
+from dataclasses import dataclass, field
+from typing import Optional
+
+
+@dataclass
+class Variable:
+    name: str
+    header: Optional[str] = None
+    claim: Optional[str] = None
+    """
+    claim is the name of a claim of the JWT bearer token, nested claims are separated by dots.
+    """
+    default: str = ""
+
+    @staticmethod
+    def from_json(data: dict) -> "Variable":
+        header = data.get("header")
+        return Variable(
+            name=data["name"],
+            header=header.lower() if header else None,
+            claim=data.get("claim") or None,
+            default=data.get("default", ""),
+        )
+
+
+@dataclass
+class PromptTemplate:
+    content: str
+    role: str = "system"
+    position: str = "prepend"
+    variables: list[Variable] = field(default_factory=list)
+
+    @staticmethod
+    def from_json(data: dict) -> "PromptTemplate":
+        return PromptTemplate(
+            content=data["content"],
+            role=data.get("role", "system"),
+            position=data.get("position", "prepend"),
+            variables=[Variable.from_json(v) for v in data.get("variables") or []],
+        )
+
+
+def from_json(data: str) -> list[PromptTemplate]:
+    return [PromptTemplate.from_json(t) for t in json.loads(data)]
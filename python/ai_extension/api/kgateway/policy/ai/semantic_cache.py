@@ -0,0 +1,54 @@
+import json
+from dataclasses import dataclass
+from typing import Optional
+
+from ..ai.authtoken import SingleAuthToken, auth_token_from_json
+from ..ai.token_ratelimit import parse_duration
+
+
+@dataclass
+class Embedding:
+    provider: str
+    auth_token: SingleAuthToken
+    model: Optional[str] = None
+    base_url: Optional[str] = None
+    endpoint: Optional[str] = None
+    deployment: Optional[str] = None
+    api_version: Optional[str] = None
+
+    @staticmethod
+    def from_json(data: dict) -> "Embedding":
+        return Embedding(
+            provider=data["provider"],
+            auth_token=auth_token_from_json(data.get("authToken", {})),
+            model=data.get("model"),
+            base_url=data.get("baseUrl"),
+            endpoint=data.get("endpoint"),
+            deployment=data.get("deployment"),
+            api_version=data.get("apiVersion"),
+        )
+
+
+@dataclass
+class SemanticCache:
+    embedding: Embedding
+    max_entries: int = 1000
+    similarity_threshold: float = 0.95
+    ttl: Optional[float] = None
+    """
+    ttl is how long a response is cached, in seconds. None means forever.
+    """
+
+    @staticmethod
+    def from_json(data: dict) -> "SemanticCache":
+        ttl = data.get("ttl")
+        return SemanticCache(
+            embedding=Embedding.from_json(data["embedding"]),
+            max_entries=data.get("maxEntries", 1000),
+            similarity_threshold=float(data.get("similarityThreshold", "0.95")),
+            ttl=parse_duration(ttl) if ttl else None,
+        )
+
+
+def from_json(data: str) -> SemanticCache:
+    return SemanticCache.from_json(json.loads(data))
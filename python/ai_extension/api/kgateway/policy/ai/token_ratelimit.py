@@ -0,0 +1,61 @@
+import json
+import re
+from dataclasses import dataclass
+from typing import Optional
+
+_duration_re = re.compile(r"(\d+(?:\.\d+)?)(h|ms|us|µs|ns|m|s)")
+_duration_units = {
+    "h": 3600.0,
+    "m": 60.0,
+    "s": 1.0,
+    "ms": 1e-3,
+    "us": 1e-6,
+    "µs": 1e-6,
+    "ns": 1e-9,
+}
+
+
+def parse_duration(value: str) -> float:
+    """
+    Parse a Go duration string, such as "1m0s" or "500ms", into seconds.
+    """
+    matches = _duration_re.findall(value)
+    if not matches or "".join(n + u for n, u in matches) != value:
+        raise ValueError(f"invalid duration {value!r}")
+    return sum(float(n) * _duration_units[u] for n, u in matches)
+
+
+@dataclass
+class TokenBucket:
+    max_tokens: int
+    fill_interval: float
+    """
+    fill_interval is the time between two fills of the bucket, in seconds.
+    """
+    tokens_per_fill: int = 1
+
+    @staticmethod
+    def from_json(data: dict) -> "TokenBucket":
+        return TokenBucket(
+            max_tokens=data["maxTokens"],
+            fill_interval=parse_duration(data["fillInterval"]),
+            tokens_per_fill=data.get("tokensPerFill", 1),
+        )
+
+
+@dataclass
+class LocalTokenRateLimit:
+    token_bucket: TokenBucket
+    header: Optional[str] = None
+
+    @staticmethod
+    def from_json(data: dict) -> "LocalTokenRateLimit":
+        header = data.get("header")
+        return LocalTokenRateLimit(
+            token_bucket=TokenBucket.from_json(data["tokenBucket"]),
+            header=header.lower() if header else None,
+        )
+
+
+def local_from_json(data: str) -> LocalTokenRateLimit:
+    return LocalTokenRateLimit.from_json(json.loads(data))
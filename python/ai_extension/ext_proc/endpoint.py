@@ -0,0 +1,50 @@
+from typing import Final
+
+CHAT: Final[str] = "chat"
+EMBEDDINGS: Final[str] = "embeddings"
+IMAGE_GENERATION: Final[str] = "image_generation"
+AUDIO_TRANSCRIPTION: Final[str] = "audio_transcription"
+
+# The endpoint of a request is determined by the suffix of its path, as the AI backends
+# of the control plane do to route the request to the provider.
+_path_suffixes: Final[dict[str, str]] = {
+    EMBEDDINGS: "/embeddings",
+    IMAGE_GENERATION: "/images/generations",
+    AUDIO_TRANSCRIPTION: "/audio/transcriptions",
+}
+
+
+def from_path(path: str) -> str:
+    """
+    Returns the endpoint of a request path, which is chat for any path
+    that is not one of the other endpoints.
+    """
+    path = path.split("?", 1)[0]
+    for endpoint, suffix in _path_suffixes.items():
+        if path.endswith(suffix):
+            return endpoint
+    return CHAT
+
+
+def parse_endpoints(value: str) -> set[str]:
+    """
+    Parse the comma separated endpoints served by the provider of a backend.
+    """
+    return {e for e in value.split(",") if e}
+
+
+def multipart_form_field(body: bytes, name: str) -> str | None:
+    """
+    Returns the value of a text field of a multipart/form-data body, such as the
+    model of an audio transcription request, or None if the field is not present.
+    """
+    first_line, _, _ = body.partition(b"\r\n")
+    if not first_line.startswith(b"--"):
+        return None
+    disposition = f'name="{name}"'.encode("utf-8")
+    for part in body.split(first_line):
+        headers, sep, value = part.partition(b"\r\n\r\n")
+        if not sep or disposition not in headers or b"filename=" in headers:
+            continue
+        return value.removesuffix(b"\r\n").decode("utf-8", errors="replace")
+    return None
@@ -0,0 +1,65 @@
+import json
+import re
+from typing import Any
+
+from api.kgateway.policy.ai.prompt_template import PromptTemplate
+
+_variable_re = re.compile(r"\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}")
+
+JWT_METADATA_NAMESPACE = "envoy.filters.http.jwt_authn"
+"""
+JWT_METADATA_NAMESPACE is the dynamic metadata namespace in which the Envoy JWT filter
+stores the payloads of the tokens it verified.
+"""
+
+
+def _claim_value(claims: dict, name: str) -> Any:
+    value: Any = claims
+    for part in name.split("."):
+        if not isinstance(value, dict) or part not in value:
+            return None
+        value = value[part]
+    return value
+
+
+def render(
+    templates: list[PromptTemplate], headers: dict[str, str], claims: dict
+) -> list[tuple[str, dict]]:
+    """
+    Render the templates with the variables read from the request headers, keyed by their
+    lowercase name, and from the verified JWT claims, which is the content of the JWT
+    filter dynamic metadata. Returns the position and the message of each template.
+
+    Values are substituted as JSON string literals, so that a value set by the client
+    cannot end its quotes or start a new line to pose as instructions of the template.
+    """
+    rendered = []
+    for template in templates:
+        values: dict[str, str] = {}
+        for variable in template.variables:
+            value = None
+            if variable.header:
+                value = headers.get(variable.header)
+            elif variable.claim:
+                claim = _claim_value(claims, variable.claim)
+                if claim is not None:
+                    value = claim if isinstance(claim, str) else json.dumps(claim)
+            value = variable.default if value is None else value
+            values[variable.name] = json.dumps(value, ensure_ascii=False)
+        content = _variable_re.sub(lambda m: values.get(m.group(1), ""), template.content)
+        rendered.append((template.position, {"role": template.role, "content": content}))
+    return rendered
+
+
+def apply(rendered: list[tuple[str, dict]], body: dict) -> dict:
+    """
+    Add the rendered templates to the messages of a request body.
+    Bodies without messages are returned unchanged.
+    """
+    messages = body.get("messages")
+    if not rendered or not isinstance(messages, list):
+        return body
+    prepend = [msg for position, msg in rendered if position == "prepend"]
+    append = [msg for position, msg in rendered if position == "append"]
+    body["messages"] = prepend + messages + append
+    return body
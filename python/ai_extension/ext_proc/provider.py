@@ -21,6 +21,7 @@ OPENAI_LLM_STR: Final[str] = "openai"
 ANTHROPIC_LLM_STR: Final[str] = "anthropic"
 GEMINI_LLM_STR: Final[str] = "gemini"
 VERTEX_AI_LLM_STR: Final[str] = "vertex-ai"
+OLLAMA_LLM_STR: Final[str] = "ollama"
 
 
 @dataclass
@@ -353,6 +354,14 @@ class OpenAI(Provider):
                 audio=details.get("audio_tokens", 0),
                 cached=details.get("cached_tokens", 0),
             )
+        # image generation and audio transcription report input and output tokens
+        details = usage.get("input_tokens_details", usage.get("input_token_details"))
+        if details is not None:
+            prompt_details = TokensDetails(
+                text=details.get("text_tokens", 0),
+                image=details.get("image_tokens", 0),
+                audio=details.get("audio_tokens", 0),
+            )
 
         completion_details = None
         details = usage.get("completion_tokens_details")
@@ -365,8 +374,10 @@ class OpenAI(Provider):
             )
 
         return Tokens(
-            completion=int(usage.get("completion_tokens", 0)),
-            prompt=int(usage.get("prompt_tokens", 0)),
+            completion=int(
+                usage.get("completion_tokens", usage.get("output_tokens", 0))
+            ),
+            prompt=int(usage.get("prompt_tokens", usage.get("input_tokens", 0))),
             prompt_details=prompt_details,
             completion_details=completion_details,
         )
@@ -893,6 +904,211 @@ class Anthropic(OpenAI):
         return False
 
 
+class Ollama(OpenAI):
+    """
+    Ollama is the native chat (/api/chat) and generate (/api/generate) API of Ollama.
+    Responses have a single message, and streaming responses are newline delimited json
+    objects where the last one is marked as done and has the token counts.
+    API reference: https://github.com/ollama/ollama/blob/main/docs/api.md
+    """
+
+    def get_attributes_for_response_body(self, body: dict) -> Attributes:
+        return {
+            gen_ai_attributes.GEN_AI_RESPONSE_MODEL: self.get_model_resp(body),
+            gen_ai_attributes.GEN_AI_RESPONSE_FINISH_REASONS: body.get(
+                "done_reason", ""
+            ),
+            gen_ai_attributes.GEN_AI_USAGE_INPUT_TOKENS: self.tokens(body).prompt,
+            gen_ai_attributes.GEN_AI_USAGE_OUTPUT_TOKENS: self.tokens(body).completion,
+        }
+
+    def tokens(self, jsn: dict) -> Tokens:
+        # streaming chunks only have the counts once they are done
+        return Tokens(
+            completion=int(jsn.get("eval_count", 0)),
+            prompt=int(jsn.get("prompt_eval_count", 0)),
+        )
+
+    def create_usage_json(self, tokens: Tokens) -> Dict[str, Any]:
+        return {"prompt_eval_count": tokens.prompt, "eval_count": tokens.completion}
+
+    def has_function_call_finish_reason(self, body: dict) -> bool:
+        return len(body.get("message", {}).get("tool_calls", None) or []) > 0
+
+    def update_stream_resp_usage_token(self, json_data: Dict[str, Any], tokens: Tokens):
+        json_data.update(self.create_usage_json(tokens))
+
+    def get_content(self, body: dict) -> str | None:
+        """
+        get_content returns the message content of a chat response, or the response
+        of a generate response.
+        """
+        if isinstance(body.get("message"), dict):
+            content = body["message"].get("content")
+        else:
+            content = body.get("response")
+        return content if isinstance(content, str) else None
+
+    def set_content(self, body: dict, content: str):
+        if isinstance(body.get("message"), dict):
+            body["message"]["content"] = content
+        else:
+            body["response"] = content
+
+    def iterate_str_resp_messages(self, body: dict, cb: Callable[[str, str], str]):
+        content = self.get_content(body)
+        if content is not None:
+            role = body.get("message", {}).get("role", "assistant")
+            self.set_content(body, cb(role, content))
+
+    def iterate_str_req_messages(self, body: dict, cb: Callable[[str, str], str]):
+        if "messages" in body:
+            super().iterate_str_req_messages(body, cb)
+            return
+
+        if isinstance(body.get("system"), str):
+            body["system"] = cb("system", body["system"])
+        if isinstance(body.get("prompt"), str):
+            body["prompt"] = cb("user", body["prompt"])
+
+    def get_num_tokens_from_body(self, body: dict) -> int:
+        if "messages" in body:
+            return num_tokens_from_messages(body["messages"])
+
+        messages = []
+        if "system" in body:
+            messages.append({"role": "system", "content": body["system"]})
+        if "prompt" in body:
+            messages.append({"role": "user", "content": body["prompt"]})
+        return num_tokens_from_messages(messages) if messages else 0
+
+    def is_streaming_req(self, body_jsn: dict, headers_jsn: dict) -> bool:
+        # unlike OpenAI, Ollama streams the response unless asked not to
+        return body_jsn.get("stream", True)
+
+    def is_streaming_response(
+        self,
+        is_streaming_request: bool,
+        response_headers: base_pb2.HeaderMap,
+        content_type: str | None = None,
+    ) -> bool:
+        if content_type is None:
+            content_type = get_content_type(response_headers)
+
+        return content_type == "application/x-ndjson"
+
+    def all_req_content(self, body: dict) -> str:
+        if "messages" in body:
+            return super().all_req_content(body)
+
+        s = ""
+        if isinstance(body.get("system"), str):
+            s += f"role: system:\n{body['system']}\n"
+        s += f"role: user:\n{body.get('prompt', '')}"
+        return s
+
+    def construct_request_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.PromptMessages:
+        if "messages" in body:
+            return super().construct_request_webhook_request_body(body)
+
+        return webhook_api.PromptMessages(
+            messages=[webhook_api.Message(role="user", content=body.get("prompt", ""))]
+        )
+
+    def update_request_body_from_webhook(
+        self, original_body: dict, webhook_modified_messages: webhook_api.PromptMessages
+    ):
+        if "messages" in original_body:
+            super().update_request_body_from_webhook(
+                original_body, webhook_modified_messages
+            )
+            return
+
+        if len(webhook_modified_messages.messages) != 1:
+            logger.error("webhook modified messages do not match the original prompt!")
+            return
+
+        original_body["prompt"] = webhook_modified_messages.messages[0].content
+
+    def construct_response_webhook_request_body(
+        self, body: dict
+    ) -> webhook_api.ResponseChoices:
+        return webhook_api.ResponseChoices(
+            choices=[
+                webhook_api.ResponseChoice(
+                    message=webhook_api.Message(
+                        role=body.get("message", {}).get("role", "assistant"),
+                        content=self.get_content(body) or "",
+                    )
+                )
+            ]
+        )
+
+    def update_response_body_from_webhook(
+        self,
+        original_body: dict,
+        webhook_modified_messages: webhook_api.ResponseChoices,
+    ):
+        if len(webhook_modified_messages.choices) != 1:
+            logger.error("webhook modified messages do not match the original response!")
+            return
+
+        if self.get_content(original_body) is not None:
+            self.set_content(
+                original_body, webhook_modified_messages.choices[0].message.content
+            )
+
+    def extract_contents_from_resp_chunk(
+        self, json_data: Dict[str, Any] | None
+    ) -> List[bytes] | None:
+        if json_data is None:
+            return None
+
+        content = self.get_content(json_data)
+        if not content:
+            return None
+
+        return [content.encode("utf-8")]
+
+    def has_choice_index(
+        self, json_data: Dict[str, Any] | None, choice_index: int
+    ) -> bool:
+        # Ollama does not support choices in response, so return false if choice_index is not 0
+        return choice_index == 0
+
+    def update_stream_resp_contents(self, json_data, choice_index: int, content: bytes):
+        if json_data is None or self.get_content(json_data) is None:
+            logger.warning(
+                f"update_stream_resp_contents() called but has no content. choice_index: {choice_index} content: {content}"
+            )
+            return None
+
+        self.set_content(json_data, content.decode("utf-8"))
+
+    def is_streaming_response_completed(
+        self,
+        chunk: StreamChunkData,
+    ) -> bool:
+        return chunk.json_data is not None and chunk.json_data.get("done", False)
+
+    def get_sse_delimiter(self) -> bytes:
+        # each line of the streaming response is a json object
+        return b"\n"
+
+    def get_stream_resp_chunk_type(
+        self, json_data: Dict[str, Any]
+    ) -> StreamChunkDataType:
+        has_content = bool(self.get_content(json_data))
+        if json_data.get("done", False):
+            if has_content:
+                return StreamChunkDataType.FINISH
+            return StreamChunkDataType.FINISH_NO_CONTENT
+
+        return StreamChunkDataType.NORMAL_TEXT
+
+
 class Gemini(Provider):
     def get_attributes_for_response_body(self, jsn: dict) -> Attributes:
         # TODO(zhengke) implement me
@@ -0,0 +1,71 @@
+import time
+import threading
+
+from dataclasses import dataclass
+from typing import Callable
+
+from api.kgateway.policy.ai.token_ratelimit import TokenBucket
+
+
+@dataclass
+class _Bucket:
+    tokens: float
+    last_fill: float
+
+
+class TokenRateLimiter:
+    """
+    TokenRateLimiter keeps an in-memory token bucket per rate limit key and
+    descriptor value.
+
+    Unlike a request rate limiter, the number of tokens a request consumes is only
+    known once the request body, and later the response, have been parsed. So a
+    request is admitted as long as the bucket is not empty, and the tokens it
+    consumes are debited afterwards. This may leave the bucket with a negative
+    balance that has to be refilled before further requests are admitted.
+    """
+
+    def __init__(self, clock: Callable[[], float] = time.monotonic):
+        self._clock = clock
+        self._buckets: dict[tuple[str, str], _Bucket] = {}
+        self._lock = threading.Lock()
+
+    def _get(self, key: tuple[str, str], config: TokenBucket) -> _Bucket:
+        now = self._clock()
+        bucket = self._buckets.get(key)
+        if bucket is None:
+            bucket = _Bucket(tokens=float(config.max_tokens), last_fill=now)
+            self._buckets[key] = bucket
+            return bucket
+        if config.fill_interval > 0:
+            fills = int((now - bucket.last_fill) // config.fill_interval)
+            if fills > 0:
+                bucket.tokens = min(
+                    float(config.max_tokens),
+                    bucket.tokens + fills * config.tokens_per_fill,
+                )
+                bucket.last_fill += fills * config.fill_interval
+        return bucket
+
+    def admit(
+        self, key: str, descriptor: str, config: TokenBucket, tokens: int
+    ) -> bool:
+        """
+        Debit the given tokens if the bucket is not empty.
+        Returns False, without debiting anything, if the bucket is exhausted.
+        """
+        with self._lock:
+            bucket = self._get((key, descriptor), config)
+            if bucket.tokens <= 0:
+                return False
+            bucket.tokens -= tokens
+            return True
+
+    def debit(self, key: str, descriptor: str, config: TokenBucket, tokens: int):
+        """
+        Debit the given tokens unconditionally, such as the completion tokens
+        of a response.
+        """
+        with self._lock:
+            bucket = self._get((key, descriptor), config)
+            bucket.tokens -= tokens
@@ -0,0 +1,201 @@
+import math
+import time
+import threading
+
+from abc import ABC, abstractmethod
+from collections import OrderedDict
+from dataclasses import dataclass
+from functools import lru_cache
+from typing import Callable
+
+from openai import AsyncOpenAI, AsyncAzureOpenAI
+
+from api.kgateway.policy.ai import semantic_cache
+
+
+class VectorStore(ABC):
+    """
+    VectorStore stores responses keyed by the embedding of their prompt.
+    Entries are partitioned by namespace, and a lookup only matches entries
+    of the same namespace.
+    """
+
+    @abstractmethod
+    def search(
+        self, namespace: str, embedding: list[float], threshold: float
+    ) -> bytes | None:
+        """
+        Return the value of the entry whose embedding is the most similar to the
+        given embedding, if its similarity is at least the threshold.
+        """
+        pass
+
+    @abstractmethod
+    def add(self, namespace: str, embedding: list[float], value: bytes):
+        """
+        Add an entry to the store.
+        """
+        pass
+
+
+@dataclass
+class _Entry:
+    namespace: str
+    embedding: list[float]
+    norm: float
+    value: bytes
+    created: float
+
+
+class InMemoryVectorStore(VectorStore):
+    """
+    InMemoryVectorStore is a VectorStore that keeps up to max_entries entries in
+    memory and does an exhaustive search. Once full, the oldest entries are evicted.
+    """
+
+    def __init__(
+        self,
+        max_entries: int,
+        ttl: float | None = None,
+        clock: Callable[[], float] = time.monotonic,
+    ):
+        self._max_entries = max_entries
+        self._ttl = ttl
+        self._clock = clock
+        self._entries: OrderedDict[int, _Entry] = OrderedDict()
+        self._next_id = 0
+        self._lock = threading.Lock()
+
+    def search(
+        self, namespace: str, embedding: list[float], threshold: float
+    ) -> bytes | None:
+        norm = _norm(embedding)
+        if norm == 0:
+            return None
+        now = self._clock()
+        best: _Entry | None = None
+        best_similarity = threshold
+        with self._lock:
+            self._evict_expired(now)
+            for entry in self._entries.values():
+                if entry.namespace != namespace or entry.norm == 0:
+                    continue
+                similarity = _dot(embedding, entry.embedding) / (norm * entry.norm)
+                if similarity >= best_similarity:
+                    best, best_similarity = entry, similarity
+        return best.value if best else None
+
+    def add(self, namespace: str, embedding: list[float], value: bytes):
+        with self._lock:
+            self._entries[self._next_id] = _Entry(
+                namespace=namespace,
+                embedding=embedding,
+                norm=_norm(embedding),
+                value=value,
+                created=self._clock(),
+            )
+            self._next_id += 1
+            while len(self._entries) > self._max_entries:
+                self._entries.popitem(last=False)
+
+    def _evict_expired(self, now: float):
+        if self._ttl is None:
+            return
+        # entries are ordered by creation time, so the expired ones come first
+        while self._entries:
+            _, oldest = next(iter(self._entries.items()))
+            if now - oldest.created < self._ttl:
+                break
+            self._entries.popitem(last=False)
+
+
+def _dot(a: list[float], b: list[float]) -> float:
+    return math.fsum(x * y for x, y in zip(a, b))
+
+
+def _norm(a: list[float]) -> float:
+    return math.sqrt(_dot(a, a))
+
+
+def new_vector_store(config: semantic_cache.SemanticCache) -> VectorStore:
+    """
+    Create the vector store configured by the semantic cache config.
+    """
+    return InMemoryVectorStore(max_entries=config.max_entries, ttl=config.ttl)
+
+
+class VectorStores:
+    """
+    VectorStores holds the vector stores of up to max_stores semantic caches, keyed by
+    the policy and its config. Once full, the least recently used store is evicted.
+    """
+
+    def __init__(self, max_stores: int = 100):
+        self._max_stores = max_stores
+        self._stores: OrderedDict[str, VectorStore] = OrderedDict()
+        self._lock = threading.Lock()
+
+    def get(self, key: str) -> VectorStore | None:
+        with self._lock:
+            if (store := self._stores.get(key)) is not None:
+                self._stores.move_to_end(key)
+            return store
+
+    def get_or_create(
+        self, key: str, config: semantic_cache.SemanticCache
+    ) -> VectorStore:
+        with self._lock:
+            if (store := self._stores.get(key)) is not None:
+                self._stores.move_to_end(key)
+                return store
+            store = new_vector_store(config)
+            self._stores[key] = store
+            while len(self._stores) > self._max_stores:
+                self._stores.popitem(last=False)
+            return store
+
+
+@lru_cache(maxsize=32)
+def _embedding_client(
+    provider: str,
+    api_key: str,
+    base_url: str | None,
+    endpoint: str | None,
+    deployment: str | None,
+    api_version: str | None,
+) -> AsyncOpenAI:
+    """
+    Return the client of an embedding API, clients are reused across requests so that
+    their connections are.
+    """
+    if provider == "azureopenai":
+        return AsyncAzureOpenAI(
+            api_key=api_key,
+            azure_endpoint=(
+                endpoint if "://" in endpoint else f"https://{endpoint}"
+            ),
+            azure_deployment=deployment,
+            api_version=api_version,
+        )
+    elif provider == "openai":
+        return AsyncOpenAI(api_key=api_key, base_url=base_url)
+    raise ValueError(f"Unknown embedding provider {provider}")
+
+
+async def embed(config: semantic_cache.Embedding, api_key: str, text: str) -> list[float]:
+    """
+    Embed the given text with the configured embedding model.
+    """
+    client = _embedding_client(
+        config.provider,
+        api_key,
+        config.base_url,
+        config.endpoint,
+        config.deployment,
+        config.api_version,
+    )
+    model = config.model
+    if config.provider == "azureopenai":
+        model = config.model or config.deployment
+    response = await client.embeddings.create(model=model, input=text)
+    return response.data[0].embedding
@@ -17,6 +17,7 @@ from typing import AsyncIterable, Final
 import signal
 import grpc
 import gzip
+import hashlib
 
 from telemetry.stats import Config as StatsConfig
 import telemetry.attributes as ai_attributes
@@ -27,18 +28,27 @@ from telemetry.tracing import (
     RejectResult,
 )
 from .stream import Handler as StreamHandler
+from .ratelimit import TokenRateLimiter
+from . import semantic_cache as semantic_cache_store
+from . import prompt_template as prompt_template_renderer
+from . import endpoint as ai_endpoint
+from . import translation
 from guardrails.regex import RegexRejection
 
 from openai import AsyncOpenAI as OpenAIClient
-from google.protobuf import struct_pb2 as struct_pb2
+from google.protobuf import json_format, struct_pb2 as struct_pb2
 from prometheus_client import Counter, Histogram, start_http_server
 
 from grpc_health.v1 import health
 from grpc_health.v1 import health_pb2_grpc
 
+from api.envoy.config.core.v3 import base_pb2
 from api.envoy.service.ext_proc.v3 import external_processor_pb2
 from api.envoy.service.ext_proc.v3 import external_processor_pb2_grpc
 from api.kgateway.policy.ai import prompt_guard
+from api.kgateway.policy.ai import token_ratelimit
+from api.kgateway.policy.ai import semantic_cache
+from api.kgateway.policy.ai import prompt_template
 from util.proto import (
     extproc_clear_request_body,
     extproc_clear_response_body,
@@ -47,7 +57,7 @@ from util.proto import (
     get_http_header,
     map_int_to_grpc_status_code,
 )
-from util.env import open_ai_token_env
+from util.env import azure_open_ai_token_env, open_ai_token_env
 
 from guardrails.api import (
     RejectAction,
@@ -78,6 +88,8 @@ logger = logging.getLogger().getChild("kgateway-ai-ext.external_processor")
 
 llm_label_name: Final[str] = "llm"
 model_label_name: Final[str] = "model"
+route_label_name: Final[str] = "route"
+client_label_name: Final[str] = "client"
 
 ai_stat_namespace: Final[str] = "ai"
 
@@ -87,6 +99,8 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
     _completion_tokens_ctr: Counter
     _rate_limited_tokens_ctr: Counter
     _exception_raised: Counter
+    _time_to_first_token: Histogram
+    _streaming_duration: Histogram
     _webhook_req_time_sec: Histogram
     _stats_config: StatsConfig
 
@@ -97,9 +111,11 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
     ):
         self._req_guard: dict[str, list[EntityRecognizer]] = {}
         self._resp_guard: dict[str, list[EntityRecognizer]] = {}
+        self._token_ratelimiter = TokenRateLimiter()
+        self._semantic_caches = semantic_cache_store.VectorStores()
         self._stats_config = stats_config
 
-        labels = [llm_label_name, model_label_name]
+        labels = [llm_label_name, model_label_name, route_label_name, client_label_name]
 
         for custom_label in stats_config.custom_labels:
             labels.append(custom_label.name)
@@ -116,6 +132,18 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
             labels,
             ai_stat_namespace,
         )
+        self._time_to_first_token = Histogram(
+            "time_to_first_token_seconds",
+            "Time from the request to the first token of the response",
+            labels,
+            ai_stat_namespace,
+        )
+        self._streaming_duration = Histogram(
+            "streaming_duration_seconds",
+            "Time from the first to the last token of streaming responses",
+            labels,
+            ai_stat_namespace,
+        )
 
         OtelTracer.init(tracing_config.tracer())
 
@@ -166,6 +194,11 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                         handler.build_extra_labels(
                             self._stats_config, request.metadata_context
                         )
+                        handler.set_client(
+                            self._stats_config.client_header,
+                            self._stats_config.clients,
+                            request.request_headers,
+                        )
                         with tracer.start_as_current_span(
                             "parse_config",
                             context=trace.set_span_in_context(header_span),
@@ -174,6 +207,7 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                                 handler,
                                 dict(context.invocation_metadata()),
                                 request.request_headers,
+                                request.metadata_context,
                             )
                         try:
                             yield self.handle_request_headers(
@@ -225,6 +259,9 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                         handler.content_encoding = get_http_header(
                             request.response_headers.headers, "content-encoding"
                         )
+                        handler.resp.status = get_http_header(
+                            request.response_headers.headers, ":status"
+                        )
                         handler.resp.set_headers(
                             (
                                 handler.resp_webhook.forwardHeaders
@@ -287,6 +324,7 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         handler: StreamHandler,
         metadict: dict,
         headers: external_processor_pb2.HttpHeaders,
+        metadata_context: base_pb2.Metadata,
     ):
         if (guardrails := metadict.get("x-req-guardrails-config", "")) != "":
             guardrails_obj = prompt_guard.req_from_json(guardrails)
@@ -311,6 +349,7 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                 handler.req_webhook = guardrails_obj.webhook
             if guardrails_obj.regex:
                 handler.req_regex_action = guardrails_obj.regex.action
+                handler.req_regex_mask = guardrails_obj.regex.mask
                 if config_hash in self._req_guard:
                     handler.req_regex = self._req_guard.get(config_hash)
                     logger.debug("reusing cached request regex")
@@ -323,9 +362,11 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         if (guardrails := metadict.get("x-resp-guardrails-config", "")) != "":
             guardrails_obj = prompt_guard.resp_from_json(guardrails)
             config_hash = metadict.get("x-resp-guardrails-config-hash", "")
+            handler.resp_streaming = guardrails_obj.streaming
             if guardrails_obj.webhook:
                 handler.resp_webhook = guardrails_obj.webhook
             if guardrails_obj.regex:
+                handler.resp_regex_mask = guardrails_obj.regex.mask
                 if config_hash in self._resp_guard:
                     handler.resp_regex = self._resp_guard.get(config_hash)
                     logger.debug("reusing cached response regex")
@@ -335,6 +376,47 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                     if handler.resp_regex is not None:
                         self._resp_guard[config_hash] = handler.resp_regex
 
+        if (ratelimit := metadict.get("x-token-ratelimit-config", "")) != "":
+            handler.token_ratelimit = token_ratelimit.local_from_json(ratelimit)
+            handler.token_ratelimit_key = metadict.get("x-token-ratelimit-key", "")
+            if handler.token_ratelimit.header:
+                handler.token_ratelimit_descriptor = get_http_header(
+                    headers.headers, handler.token_ratelimit.header
+                )
+
+        if metadict.get("x-llm-api-format", "") == "openai":
+            handler.translator = translation.new_translator(handler.llm_provider)
+
+        if (cache := metadict.get("x-semantic-cache-config", "")) != "":
+            handler.semantic_cache = semantic_cache.from_json(cache)
+            handler.semantic_cache_key = metadict.get("x-semantic-cache-key", "")
+            handler.semantic_cache_api_key = get_auth_token(
+                handler.semantic_cache.embedding.auth_token,
+                headers.headers,
+                azure_open_ai_token_env
+                if handler.semantic_cache.embedding.provider == "azureopenai"
+                else open_ai_token_env,
+            )
+            # cached responses are only served to the caller they were cached for
+            handler.semantic_cache_caller = hashlib.sha256(
+                get_http_header(headers.headers, "authorization").encode()
+            ).hexdigest()
+
+        if (templates := metadict.get("x-prompt-templates-config", "")) != "":
+            handler.prompt_templates = prompt_template_renderer.render(
+                prompt_template.from_json(templates),
+                {
+                    h.key.lower(): h.raw_value.decode("utf-8")
+                    for h in headers.headers.headers
+                },
+                json_format.MessageToDict(
+                    metadata_context.filter_metadata.get(
+                        prompt_template_renderer.JWT_METADATA_NAMESPACE,
+                        struct_pb2.Struct(),
+                    )
+                ),
+            )
+
         return handler
 
     def handle_request_headers(
@@ -345,6 +427,14 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
             headers,
         )
         handler.req.path = get_http_header(headers.headers, ":path")
+        if not handler.set_endpoint(handler.req.path):
+            return error_response(
+                prompt_guard.CustomResponse(
+                    message=f"The {handler.endpoint} endpoint is not served by the {handler.llm_provider} provider",
+                    status_code=404,
+                ),
+                "Rejected unsupported endpoint",
+            )
         auth_header = get_http_header(headers.headers, "authorization").removeprefix(
             "Bearer "
         )
@@ -483,14 +573,25 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         # Always append the request body
         handler.req.append(req_body.body)
         if req_body.end_of_stream:
+            if handler.endpoint == ai_endpoint.AUDIO_TRANSCRIPTION:
+                return self.handle_request_body_audio(handler)
             body_jsn = json.loads(handler.req.body.decode("utf-8"))
-            handler.request_model = handler.provider.get_model_req(body_jsn, metadict)
-
-            # Check if request is streaming
-            # TODO(npolshak): Remove x-chat-streaming header once have access to request path
-            handler.req.is_streaming = handler.provider.is_streaming_req(
-                body_jsn, metadict
+            # Templates are added before translation and guardrails, so that they apply to
+            # the templated prompt and its tokens are counted
+            body_jsn = prompt_template_renderer.apply(
+                handler.prompt_templates, body_jsn
             )
+            if handler.translator:
+                # OpenAI requests ask for a streaming response in the body
+                handler.req.is_streaming = bool(body_jsn.get("stream", False))
+                body_jsn = handler.translator.translate_request(body_jsn)
+            else:
+                # Check if request is streaming
+                # TODO(npolshak): Remove x-chat-streaming header once have access to request path
+                handler.req.is_streaming = handler.provider.is_streaming_req(
+                    body_jsn, metadict
+                )
+            handler.request_model = handler.provider.get_model_req(body_jsn, metadict)
 
             body = body_jsn
             operation_name = handler.get_operation_name()
@@ -550,25 +651,49 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                         moderation_span.set_attribute(
                             ai_attributes.AI_MODERATION_FLAGGED, False
                         )
-                # currently we only count the prompt token for ratelimiting. So,
-                # this is only set here. If we change to count completion token as well
-                # will need to add those into rate_limited_tokens for stats purpose.
+                if handler.semantic_cache and not handler.req.is_streaming:
+                    if cached := await self.handle_request_body_semantic_cache(
+                        body, handler, gen_ai_client_span
+                    ):
+                        return cached
+
+                if handler.token_ratelimit and not self._token_ratelimiter.admit(
+                    handler.token_ratelimit_key,
+                    handler.token_ratelimit_descriptor,
+                    handler.token_ratelimit.token_bucket,
+                    tokens,
+                ):
+                    return error_response(
+                        prompt_guard.CustomResponse(
+                            message="Token rate limit exceeded", status_code=429
+                        ),
+                        "Rejected by token rate limit",
+                    )
+                # the prompt tokens count toward rate limiting from the request path,
+                # the completion tokens debited from the token rate limit are added
+                # to rate_limited_tokens on the response path
                 handler.rate_limited_tokens = tokens
-            return external_processor_pb2.ProcessingResponse(
-                dynamic_metadata=struct_pb2.Struct(
-                    # increment tokens for rate limiting
-                    fields={
-                        "envoy.ratelimit": struct_pb2.Value(
-                            struct_value=struct_pb2.Struct(
-                                fields={
-                                    "hits_addend": struct_pb2.Value(
-                                        number_value=float(tokens),
-                                    )
-                                }
-                            )
+            dynamic_metadata = struct_pb2.Struct(
+                # increment tokens for rate limiting
+                fields={
+                    "envoy.ratelimit": struct_pb2.Value(
+                        struct_value=struct_pb2.Struct(
+                            fields={
+                                "hits_addend": struct_pb2.Value(
+                                    number_value=float(tokens),
+                                )
+                            }
                         )
-                    },
-                ),
+                    )
+                },
+            )
+            if handler.translator and handler.req.is_streaming:
+                # The backend transformation selects the streaming API of the provider from the route type
+                dynamic_metadata.fields["ai.kgateway.io"].struct_value.fields[
+                    "route_type"
+                ].string_value = "CHAT_STREAMING"
+            return external_processor_pb2.ProcessingResponse(
+                dynamic_metadata=dynamic_metadata,
                 request_body=external_processor_pb2.BodyResponse(
                     response=external_processor_pb2.CommonResponse(
                         body_mutation=external_processor_pb2.BodyMutation(
@@ -581,6 +706,118 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         # If it's not end of stream, clear the body so envoy doesn't forward to upstream.
         return extproc_clear_request_body()
 
+    def handle_request_body_audio(
+        self, handler: StreamHandler
+    ) -> external_processor_pb2.ProcessingResponse:
+        """
+        Audio transcription requests are multipart forms, which are sent upstream as is.
+        Their prompt tokens are only known from the usage reported by the provider,
+        so they are debited from the token rate limit on the response path.
+        """
+        body = bytes(handler.req.body)
+        handler.request_model = ai_endpoint.multipart_form_field(body, "model") or ""
+        if handler.token_ratelimit and not self._token_ratelimiter.admit(
+            handler.token_ratelimit_key,
+            handler.token_ratelimit_descriptor,
+            handler.token_ratelimit.token_bucket,
+            0,
+        ):
+            return error_response(
+                prompt_guard.CustomResponse(
+                    message="Token rate limit exceeded", status_code=429
+                ),
+                "Rejected by token rate limit",
+            )
+        # the previous chunks of the body were cleared while it was buffered
+        return external_processor_pb2.ProcessingResponse(
+            request_body=external_processor_pb2.BodyResponse(
+                response=external_processor_pb2.CommonResponse(
+                    body_mutation=external_processor_pb2.BodyMutation(body=body),
+                ),
+            ),
+        )
+
+    async def handle_request_body_semantic_cache(
+        self,
+        body: dict,
+        handler: StreamHandler,
+        parent_span: trace.Span,
+    ) -> external_processor_pb2.ProcessingResponse | None:
+        """
+        Look up the response to a similar prompt in the semantic cache and return it.
+        On a cache miss, the prompt embedding is kept in the handler so that the
+        response can be cached. Errors are logged and the request is sent upstream.
+        """
+        config = handler.semantic_cache
+        with OtelTracer.get().start_as_current_span(
+            "handle_request_body_semantic_cache",
+            context=trace.set_span_in_context(parent_span),
+        ) as span:
+            try:
+                embedding = await semantic_cache_store.embed(
+                    config.embedding,
+                    handler.semantic_cache_api_key,
+                    handler.provider.all_req_content(body),
+                )
+            except Exception as exc:
+                span.record_exception(exc)
+                handler.logger.error(
+                    "Error embedding prompt for semantic cache, %s", exc
+                )
+                return None
+
+            store = self._semantic_caches.get_or_create(
+                handler.semantic_cache_key, config
+            )
+            cached = store.search(
+                handler.semantic_cache_namespace(),
+                embedding,
+                config.similarity_threshold,
+            )
+            span.set_attribute(
+                ai_attributes.AI_SEMANTIC_CACHE_HIT, cached is not None
+            )
+            if cached is None:
+                handler.semantic_cache_embedding = embedding
+                return None
+
+            return external_processor_pb2.ProcessingResponse(
+                immediate_response=external_processor_pb2.ImmediateResponse(
+                    status=dict(code=map_int_to_grpc_status_code(200)),
+                    headers=external_processor_pb2.HeaderMutation(
+                        set_headers=[
+                            base_pb2.HeaderValueOption(
+                                header=base_pb2.HeaderValue(
+                                    key="content-type", raw_value=b"application/json"
+                                )
+                            ),
+                            base_pb2.HeaderValueOption(
+                                header=base_pb2.HeaderValue(
+                                    key="x-kgateway-semantic-cache", raw_value=b"hit"
+                                )
+                            ),
+                        ]
+                    ),
+                    body=cached,
+                    details="Served from semantic cache",
+                ),
+            )
+
+    def cache_response(self, handler: StreamHandler, body: bytes):
+        """
+        Cache the response to a prompt that missed the semantic cache.
+        """
+        if handler.semantic_cache_embedding is None or handler.resp.status != "200":
+            return
+        if handler.mask_tokens:
+            # the response has the restored data of this request
+            return
+        if (store := self._semantic_caches.get(handler.semantic_cache_key)) is None:
+            return
+        store.add(
+            handler.semantic_cache_namespace(), handler.semantic_cache_embedding, body
+        )
+
     async def handle_response_body_resp_webhook(
         self,
         body: dict,
@@ -646,6 +883,8 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         handler: StreamHandler,
         parent_span: trace.Span,
     ) -> external_processor_pb2.ProcessingResponse:
+        if resp_body.body:
+            handler.record_response_chunk()
         if handler.resp.is_streaming:
             # TODO(npolshak): Prompt guard is only applied to the last function call response.
             # We can optimize and avoid buffering the intermediate response
@@ -653,6 +892,9 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                 llm_provider=handler.provider,
                 resp_webhook=handler.resp_webhook,
                 resp_regex=handler.resp_regex,
+                resp_regex_mask=handler.resp_regex_mask,
+                resp_streaming=handler.resp_streaming,
+                mask_tokens=handler.mask_tokens,
                 anonymizer_engine=handler.anon,
                 resp_headers=handler.resp.headers,
                 resp_body=resp_body,
@@ -666,6 +908,10 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
 
             handler.resp.append(body)
             handler.logger.debug("handling streaming response %s\n", body)
+            if handler.translator:
+                body = handler.translator.translate_stream(
+                    body, resp_body.end_of_stream
+                )
             if resp_body.body == body:
                 extproc_response_body = external_processor_pb2.BodyResponse()
             else:
@@ -774,6 +1020,18 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
                                 jsn, handler, non_streaming_span
                             )
 
+                        if handler.mask_tokens:
+                            handler.provider.iterate_str_resp_messages(
+                                body=jsn, cb=handler.mask_tokens.restore
+                            )
+
+                        if handler.translator:
+                            jsn = handler.translator.translate_response(jsn)
+
+                        self.cache_response(
+                            handler, json.dumps(jsn).encode("utf-8")
+                        )
+
                         return external_processor_pb2.ProcessingResponse(
                             response_body=external_processor_pb2.BodyResponse(
                                 response=external_processor_pb2.CommonResponse(
@@ -795,13 +1053,33 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
         labels = handler.extra_labels.copy()
         labels[llm_label_name] = handler.llm_provider
         labels[model_label_name] = handler.request_model
+        labels[route_label_name] = handler.route
+        labels[client_label_name] = handler.client
 
         tokens = handler.get_tokens()
+        if handler.token_ratelimit:
+            # prompt tokens were debited on the request path, the completion
+            # tokens are only known once the provider has responded
+            debited = tokens.completion
+            if handler.endpoint == ai_endpoint.AUDIO_TRANSCRIPTION:
+                # the prompt tokens of audio are not counted on the request path
+                debited += tokens.prompt
+            self._token_ratelimiter.debit(
+                handler.token_ratelimit_key,
+                handler.token_ratelimit_descriptor,
+                handler.token_ratelimit.token_bucket,
+                debited,
+            )
+            handler.rate_limited_tokens += debited
         increment_counter(self._completion_tokens_ctr, labels, tokens.completion)
         increment_counter(self._prompt_tokens_ctr, labels, tokens.prompt)
         increment_counter(
             self._rate_limited_tokens_ctr, labels, handler.rate_limited_tokens
         )
+        if (ttft := handler.time_to_first_token()) is not None:
+            observe_histogram(self._time_to_first_token, labels, ttft)
+        if (duration := handler.streaming_duration()) is not None:
+            observe_histogram(self._streaming_duration, labels, duration)
 
         return handler.build_metadata()
 
@@ -813,6 +1091,8 @@ class ExtProcServer(external_processor_pb2_grpc.ExternalProcessorServicer):
             if handler.request_model
             else handler.get_response_model()
         )
+        labels[route_label_name] = handler.route
+        labels[client_label_name] = handler.client
         increment_counter(
             self._exception_raised,
             labels,
@@ -829,6 +1109,15 @@ def increment_counter(counter: Counter, labels: dict[str, str], value: int):
         logger.error(f"Error incrementing counter: {e}, continuing")
 
 
+# Function to observe a histogram and log any errors rather than
+# stopping the request/response logic flow.
+def observe_histogram(histogram: Histogram, labels: dict[str, str], value: float):
+    try:
+        histogram.labels(**labels).observe(value)
+    except ValueError as e:
+        logger.error(f"Error observing histogram: {e}, continuing")
+
+
 def error_response(
     custom_response: prompt_guard.CustomResponse | None,
     message: str,
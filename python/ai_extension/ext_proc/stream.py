@@ -1,9 +1,10 @@
 import re
+import time
 import logging
 
 from copy import copy
 from logging import Logger
-from typing import Iterable
+from typing import Callable, Iterable
 
 from telemetry import stats
 from .provider import (
@@ -12,9 +13,11 @@ from .provider import (
     OpenAI,
     Anthropic,
     Gemini,
+    Ollama,
     ANTHROPIC_LLM_STR,
     GEMINI_LLM_STR,
     VERTEX_AI_LLM_STR,
+    OLLAMA_LLM_STR,
     OPENAI_LLM_STR,
 )
 
@@ -22,13 +25,17 @@ from google.protobuf import struct_pb2 as struct_pb2
 from api.envoy.service.ext_proc.v3 import external_processor_pb2
 from api.envoy.config.core.v3 import base_pb2 as base_pb2
 from api.kgateway.policy.ai import prompt_guard
+from api.kgateway.policy.ai.token_ratelimit import LocalTokenRateLimit
+from api.kgateway.policy.ai.semantic_cache import SemanticCache
 from presidio_analyzer import EntityRecognizer
 from presidio_anonymizer import AnonymizerEngine
 from dataclasses import dataclass, field
 from openai.resources import AsyncModerations
 from ext_proc.streamchunks import StreamChunks
+from ext_proc.translation import Translator
+from ext_proc import endpoint as ai_endpoint
 from util.http import parse_content_type
-from guardrails.regex import regex_transform
+from guardrails.regex import MaskTokens, regex_transform
 from opentelemetry.semconv._incubating.attributes import gen_ai_attributes
 from opentelemetry.util.types import Attributes
 
@@ -65,6 +72,12 @@ class Info:
     This is set while we are looping through the header in set_header()
     """
 
+    status: str = ""
+    """
+    status is the response status code from the :status pseudo header
+    This is only set for the response
+    """
+
     def append(self, data: bytes):
         """
         Append data to the body of the Info object.
@@ -116,14 +129,82 @@ class Handler:
     resp_webhook: prompt_guard.Webhook | None = None
     req_regex: list[EntityRecognizer] | None = None
     req_regex_action: prompt_guard.Action = prompt_guard.Action.MASK
+    req_regex_mask: prompt_guard.RegexMask = field(
+        default_factory=prompt_guard.RegexMask
+    )
     req_moderation: tuple[AsyncModerations, str] | None = None
     req_custom_response: prompt_guard.CustomResponse | None = None
     resp_regex: list[EntityRecognizer] | None = None
+    resp_regex_mask: prompt_guard.RegexMask = field(
+        default_factory=prompt_guard.RegexMask
+    )
+    mask_tokens: MaskTokens = field(default_factory=MaskTokens)
+    """
+    mask_tokens are the tokens that replaced the data matched by the request regex with the
+    Tokenize mask strategy, the data is restored wherever the tokens are in the response.
+    """
+    resp_streaming: prompt_guard.StreamingPromptguard = field(
+        default_factory=prompt_guard.StreamingPromptguard
+    )
+    token_ratelimit: LocalTokenRateLimit | None = None
+    token_ratelimit_key: str = ""
+    token_ratelimit_descriptor: str = ""
+    """
+    token_ratelimit_descriptor is the value of the token rate limit header of the request,
+    each value has its own token budget.
+    """
+    semantic_cache: SemanticCache | None = None
+    semantic_cache_key: str = ""
+    semantic_cache_api_key: str = ""
+    semantic_cache_caller: str = ""
+    """
+    semantic_cache_caller is the hash of the Authorization header of the request, cached
+    responses are only served to requests with the same header.
+    """
+    semantic_cache_embedding: list[float] | None = None
+    """
+    semantic_cache_embedding is the embedding of the request prompt, it is set on the
+    request path on a cache miss and used to cache the response.
+    """
+    endpoint: str = ai_endpoint.CHAT
+    """
+    endpoint is the provider API endpoint of the request, such as chat or embeddings,
+    it is set from the path of the request.
+    """
+    served_endpoints: set[str] = field(default_factory=set)
+    """
+    served_endpoints are the endpoints other than chat served by the provider of the backend.
+    """
+    prompt_templates: list[tuple[str, dict]] = field(default_factory=list)
+    """
+    prompt_templates are the rendered prompt templates of the route, with the position
+    of each message relative to the messages of the request.
+    """
+    translator: Translator | None = None
+    """
+    translator is set when the client uses the OpenAI API format with another provider,
+    it translates the request to the provider format and the response back
+    """
     anon: AnonymizerEngine = field(default_factory=AnonymizerEngine)
     req: Info = field(default_factory=Info)
     resp: Info = field(default_factory=Info)
     stream_chunks: StreamChunks = field(default_factory=StreamChunks)
     extra_labels: dict[str, str] = field(default_factory=dict)
+    route: str = "unknown"
+    """
+    route is the name of the envoy route of the request, used as the route label of the metrics
+    """
+    client: str = "unknown"
+    """
+    client is the value of the client header of the request, used as the client label of the metrics
+    """
+    clock: Callable[[], float] = time.monotonic
+    request_start: float = field(default_factory=time.monotonic)
+    first_token_time: float | None = None
+    """
+    first_token_time is the time of the first response body chunk, which carries the first token
+    """
+    last_token_time: float | None = None
     _tokens: Tokens = field(default_factory=Tokens)
     """
         Tokens for non-streaming response. streaming response token is stored 
@@ -132,7 +213,8 @@ class Handler:
         Was going to use __tokens but double underscore is not allowed for dataclass
     """
 
-    # This value is set on the request path when we calculate the rate limited tokens.
+    # This value is set on the request path when we calculate the rate limited tokens,
+    # and the tokens debited from the token rate limit on the response path are added to it.
     # It is then used on the response path to increment the rate_limited_tokens counter
     # when we've received the exact model used by the backend.
     rate_limited_tokens: int = 0
@@ -203,12 +285,50 @@ class Handler:
             handler = Handler(
                 logger=sub_logger, provider=Gemini(), llm_provider=llm_provider
             )
+        elif llm_provider == OLLAMA_LLM_STR:
+            handler = Handler(
+                logger=sub_logger, provider=Ollama(), llm_provider=llm_provider
+            )
         else:
             handler = Handler(
                 logger=sub_logger, provider=OpenAI(), llm_provider=llm_provider
             )
+        handler.route = metadict.get("x-route-name", "unknown")
+        handler.served_endpoints = ai_endpoint.parse_endpoints(
+            metadict.get("x-llm-endpoints", "")
+        )
         return handler
 
+    def semantic_cache_namespace(self) -> str:
+        """
+        Return the namespace of the semantic cache entries of the request, so that
+        cached responses are only served for the same model and caller.
+        """
+        return f"{self.request_model}/{self.semantic_cache_caller}"
+
+    def set_endpoint(self, path: str) -> bool:
+        """
+        set_endpoint sets the endpoint of the request from its path.
+        Returns False if the provider of the backend does not serve the endpoint.
+        """
+        self.endpoint = ai_endpoint.from_path(path)
+        if self.endpoint == ai_endpoint.CHAT:
+            return True
+        if self.llm_provider == GEMINI_LLM_STR:
+            # Gemini serves the other endpoints with its OpenAI compatible API
+            self.provider = OpenAI()
+        # prompt guards, prompt templates, semantic caching and API format
+        # translation only apply to chat completions
+        self.translator = None
+        self.prompt_templates = []
+        self.semantic_cache = None
+        self.req_webhook = None
+        self.req_regex = None
+        self.req_moderation = None
+        self.resp_webhook = None
+        self.resp_regex = None
+        return self.endpoint in self.served_endpoints
+
     def build_metadata(self) -> struct_pb2.Struct:
         tokens = self.get_tokens()
         dynamic_meta = struct_pb2.Struct(
@@ -238,13 +358,66 @@ class Handler:
                             "streaming": struct_pb2.Value(
                                 bool_value=self.resp.is_streaming
                             ),
+                            "route": struct_pb2.Value(string_value=self.route),
+                            "client": struct_pb2.Value(string_value=self.client),
                         }
                     )
                 )
             },
         )
+        fields = dynamic_meta.fields["ai.kgateway.io"].struct_value.fields
+        if (ttft := self.time_to_first_token()) is not None:
+            fields["time_to_first_token_ms"].number_value = round(ttft * 1000)
+        if (duration := self.streaming_duration()) is not None:
+            fields["streaming_duration_ms"].number_value = round(duration * 1000)
         return dynamic_meta
 
+    def set_client(
+        self,
+        client_header: str | None,
+        clients: list[str],
+        headers: external_processor_pb2.HttpHeaders,
+    ):
+        """
+        set_client sets the client of the request from the configured client header.
+        The header is set by the client, so its values other than the configured clients
+        are reported as "other" to bound the cardinality of the client label.
+        """
+        if not client_header:
+            return
+        client_header = client_header.lower()
+        for header in headers.headers.headers:
+            if header.key == client_header:
+                if client := header.raw_value.decode("utf-8"):
+                    self.client = client if client in clients else "other"
+                return
+
+    def record_response_chunk(self):
+        """
+        record_response_chunk records the time of a response body chunk, it must be called
+        for every chunk to measure the time to first token and the streaming duration
+        """
+        now = self.clock()
+        if self.first_token_time is None:
+            self.first_token_time = now
+        self.last_token_time = now
+
+    def time_to_first_token(self) -> float | None:
+        """
+        time_to_first_token returns the seconds from the request to the first response body chunk
+        """
+        if self.first_token_time is None:
+            return None
+        return self.first_token_time - self.request_start
+
+    def streaming_duration(self) -> float | None:
+        """
+        streaming_duration returns the seconds from the first to the last chunk of a streaming response
+        """
+        if not self.resp.is_streaming or self.first_token_time is None:
+            return None
+        return self.last_token_time - self.first_token_time
+
     def increment_tokens(self, jsn: dict):
         """
         This function is only used for non-streaming response.
@@ -259,7 +432,13 @@ class Handler:
 
     def req_regex_transform(self, role: str, content: str) -> str:
         return regex_transform(
-            role, content, self.req_regex, self.anon, self.req_regex_action
+            role,
+            content,
+            self.req_regex,
+            self.anon,
+            self.req_regex_action,
+            self.req_regex_mask,
+            self.mask_tokens,
         )
 
     def resp_regex_transform(self, role: str, content: str) -> str:
@@ -268,6 +447,7 @@ class Handler:
             content,
             self.resp_regex,
             self.anon,
+            mask=self.resp_regex_mask,
         )
 
     def get_operation_name(self) -> str:
@@ -282,9 +462,11 @@ class Handler:
             Returns "generate_content" if no known operation keyword is found in the path.
         """
         path = self.req.path
-        if "chat/completion" in path:
+        if self.endpoint == ai_endpoint.EMBEDDINGS:
+            return "embeddings"
+        if "chat/completion" in path or "/api/chat" in path:
             return "chat"
-        if "completions" in path:
+        if "completions" in path or "/api/generate" in path:
             return "text_completion"
         return "generate_content"
 
@@ -309,6 +491,8 @@ class Handler:
             return "gcp.vertex_ai"
         elif self.llm_provider == OPENAI_LLM_STR:
             return "openai"
+        elif self.llm_provider == OLLAMA_LLM_STR:
+            return "ollama"
         return ""
 
     def get_attributes_for_request_body(self, body: dict) -> Attributes:
@@ -1,4 +1,5 @@
 from copy import deepcopy
+import json
 import logging
 import re
 import traceback
@@ -18,12 +19,23 @@ from presidio_analyzer import EntityRecognizer
 from presidio_anonymizer import AnonymizerEngine
 from telemetry.tracing import OtelTracer
 from typing import Any, Callable, Deque, Dict, List, Tuple
-from guardrails.regex import regex_transform
+from guardrails.regex import MaskTokens, RegexRejection, regex_transform
 from guardrails.webhook import call_response_webhook
 from util import sse
 
 logger = logging.getLogger().getChild("kgateway-ai-ext.streamchunks")
 
+STREAM_TERMINATED_MESSAGE = "The response was terminated due to inappropriate content"
+
+
+def stream_error_event(message: str) -> bytes:
+    """
+    stream_error_event returns the SSE error event that terminates a streaming response.
+    The event name is used by the Anthropic clients and the error field by the OpenAI clients.
+    """
+    data = {"type": "error", "error": {"type": "content_filter", "message": message}}
+    return b"event: error\ndata: " + json.dumps(data).encode("utf-8") + b"\n\n"
+
 
 def reconstruct_chunk(llm_provider: Provider, chunk: StreamChunkData):
     """
@@ -245,6 +257,11 @@ class StreamChunks:
         This indicated the stream is completed properly base on the provider specific indicator
         """
 
+        self.is_terminated: bool = False
+        """
+        This indicates the stream was terminated by the guardrails, the rest of the response is dropped
+        """
+
     def get_role(self, choice_index: int) -> str:
         if choice_index < 0 or choice_index >= len(self.__roles):
             return ""
@@ -763,6 +780,10 @@ class StreamChunks:
         anonymizer_engine: AnonymizerEngine,
         parent_span: trace.Span,
         final: bool = False,
+        window_size: int = prompt_guard.DEFAULT_STREAMING_WINDOW_SIZE,
+        regex_action: prompt_guard.Action = prompt_guard.Action.MASK,
+        regex_mask: prompt_guard.RegexMask | None = None,
+        mask_tokens: MaskTokens | None = None,
     ) -> int:
         """
         return how many chunks we should pop out from the fifo. 0 means we are just buffering until we get enough.
@@ -773,12 +794,11 @@ class StreamChunks:
             f"webhook (fifo: {len(self.__streaming_fifo)} final={final}): {contents}"
         )
 
-        # TODO(andy): This is deviated from the original design that use a minimum chunks (mainly for simplicity)
-        #             but turns out using minimum chunks do not work well with gemini because it packs a lot of
-        #             tokens in a single chunk where OpenAI packs only a few tokens at most per chunk.
-        #             According to ChatGPT, the average sentence for a chat is around 25 to 75 characters long,
-        #             so picking 50 here. Do we need this to be configurable and get this from x-resp-guardrails-config?
-        min_content_length = 50
+        # This is deviated from the original design that use a minimum chunks (mainly for simplicity)
+        # but turns out using minimum chunks do not work well with gemini because it packs a lot of
+        # tokens in a single chunk where OpenAI packs only a few tokens at most per chunk.
+        # The window size defaults to 50 characters, which is around the length of an average sentence.
+        min_content_length = window_size
         should_do_guardrails_check = True
         for content_data in contents:
             if len(content_data.content) < min_content_length:
@@ -837,9 +857,8 @@ class StreamChunks:
         regex_modified = False
         regex_modified_contents: List[str] = []
         if regex:
-            # regex_transform can throw RegexRejection exception. Deliberately not catching
-            # it here so it bubbles up all the way to server.Process() so it can construct an
-            # immediate error response there.
+            # regex_transform throws RegexRejection exception when the action is REJECT.
+            # Deliberately not catching it here so it bubbles up to buffer() which terminates the stream.
             with OtelTracer.get().start_as_current_span(
                 "regex",
                 context=trace.set_span_in_context(parent_span),
@@ -847,7 +866,14 @@ class StreamChunks:
                 for i, item in enumerate(contents):
                     logger.debug(f"regex: choice_index: {i} content: {item.content}")
                     regex_modified_contents.append(
-                        regex_transform("", item.content, regex, anonymizer_engine)
+                        regex_transform(
+                            "",
+                            item.content,
+                            regex,
+                            anonymizer_engine,
+                            regex_action,
+                            regex_mask,
+                        )
                     )
                     if item.content != regex_modified_contents[i]:
                         # as long as there is one choice that got modified, we need to collapse
@@ -857,6 +883,22 @@ class StreamChunks:
                             f"regex: choice_index: {i} modifed_content: {regex_modified_contents[i]}"
                         )
 
+        if mask_tokens:
+            # The tokens that masked the data of the request are replaced back with the data.
+            # The tokens have no segment boundary in them, so they are never split across windows.
+            guarded_contents = [content_data.content for content_data in contents]
+            if regex_modified:
+                guarded_contents = regex_modified_contents
+            elif webhook_modified and webhook_modified_contents is not None:
+                guarded_contents = webhook_modified_contents
+            restored_contents = [
+                mask_tokens.restore("", content) for content in guarded_contents
+            ]
+            if restored_contents != guarded_contents:
+                return self.collapse_chunks_with_new_content(
+                    llm_provider, contents, restored_contents
+                )
+
         if regex_modified:
             # if webhook has modified the contents, the modified contents would have already pass into regex
             # so, only use the webhook_modified_contents if regex didn't modify them
@@ -908,14 +950,24 @@ class StreamChunks:
         resp_headers: dict[str, str],
         resp_body: external_processor_pb2.HttpBody,
         parent_span: trace.Span,
+        resp_streaming: prompt_guard.StreamingPromptguard | None = None,
+        resp_regex_mask: prompt_guard.RegexMask | None = None,
+        mask_tokens: MaskTokens | None = None,
     ) -> bytes | None:
         """
         Buffer data for Guardrail. Returns the bytes when the data comes out of the Fifo
         """
-        if resp_webhook is None and resp_regex is None:
+        if resp_webhook is None and resp_regex is None and not mask_tokens:
             # Guardrail feature is not enabled, so no need to buffer
             return resp_body.body
 
+        if self.is_terminated:
+            # drop the rest of the response after the error event
+            return b""
+
+        if resp_streaming is None:
+            resp_streaming = prompt_guard.StreamingPromptguard()
+
         try:
             chunks, self.__leftover = sse.parse_sse_messages(
                 llm_provider=llm_provider,
@@ -950,15 +1002,29 @@ class StreamChunks:
                 )
             )
 
-        number_messages_to_remove = await self.do_guardrails_check(
-            final=resp_body.end_of_stream,
-            llm_provider=llm_provider,
-            resp_headers=resp_headers,
-            regex=resp_regex,
-            webhook=resp_webhook,
-            anonymizer_engine=anonymizer_engine,
-            parent_span=parent_span,
-        )
+        try:
+            number_messages_to_remove = await self.do_guardrails_check(
+                final=resp_body.end_of_stream,
+                llm_provider=llm_provider,
+                resp_headers=resp_headers,
+                regex=resp_regex,
+                webhook=resp_webhook,
+                anonymizer_engine=anonymizer_engine,
+                parent_span=parent_span,
+                window_size=resp_streaming.window_size,
+                regex_action=resp_streaming.action,
+                regex_mask=resp_regex_mask,
+                mask_tokens=mask_tokens,
+            )
+        except RegexRejection as exc:
+            logger.info(f"terminating streaming response: {exc}")
+            parent_span.add_event(
+                "stream_terminated", {"reason": STREAM_TERMINATED_MESSAGE}
+            )
+            # the content that failed the guardrails is never sent to the client
+            self.pop_all()
+            self.is_terminated = True
+            return stream_error_event(STREAM_TERMINATED_MESSAGE)
 
         return self.pop_chunks(number_messages_to_remove)
 
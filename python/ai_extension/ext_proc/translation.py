@@ -0,0 +1,686 @@
+"""
+Translation of OpenAI chat completions requests and responses to and from the API
+format of the other LLM providers, so that clients can use the OpenAI API regardless
+of the provider of the backend.
+
+Requests are translated before any other processing, and responses after it, so that
+guardrails and token counting keep working on the provider format.
+"""
+
+import json
+import re
+import time
+import uuid
+
+from abc import ABC, abstractmethod
+from typing import Any, Dict, List
+
+from .provider import (
+    ANTHROPIC_LLM_STR,
+    GEMINI_LLM_STR,
+    VERTEX_AI_LLM_STR,
+)
+
+# Anthropic requires max_tokens, OpenAI requests usually leave it out
+DEFAULT_ANTHROPIC_MAX_TOKENS = 4096
+
+_SSE_EVENT_DELIMITER = re.compile(rb"\r?\n\r?\n")
+_DATA_URL = re.compile(r"^data:(?P<media_type>[^;,]+);base64,(?P<data>.*)$", re.DOTALL)
+
+_ANTHROPIC_FINISH_REASONS = {
+    "end_turn": "stop",
+    "stop_sequence": "stop",
+    "max_tokens": "length",
+    "tool_use": "tool_calls",
+    "refusal": "content_filter",
+}
+
+_GEMINI_FINISH_REASONS = {
+    "STOP": "stop",
+    "MAX_TOKENS": "length",
+    "SAFETY": "content_filter",
+    "RECITATION": "content_filter",
+    "BLOCKLIST": "content_filter",
+    "PROHIBITED_CONTENT": "content_filter",
+    "SPII": "content_filter",
+}
+
+
+def text_content(content: Any) -> str:
+    """
+    text_content returns the text of an OpenAI message content, which is either a string
+    or a list of content parts.
+    """
+    if content is None:
+        return ""
+    if isinstance(content, str):
+        return content
+    return "".join(
+        part.get("text", "")
+        for part in content
+        if isinstance(part, dict) and part.get("type") == "text"
+    )
+
+
+def tool_arguments(arguments: str | None) -> dict:
+    """
+    tool_arguments parses the JSON encoded arguments of an OpenAI tool call.
+    """
+    if not arguments:
+        return {}
+    try:
+        parsed = json.loads(arguments)
+    except json.JSONDecodeError:
+        return {}
+    return parsed if isinstance(parsed, dict) else {}
+
+
+def openai_error(message: str, error_type: str, code: Any = None) -> dict:
+    return {
+        "error": {
+            "message": message,
+            "type": error_type,
+            "param": None,
+            "code": code,
+        }
+    }
+
+
+class Translator(ABC):
+    """
+    Translator translates OpenAI chat completions requests to the API format of a provider,
+    and the responses of the provider back to the OpenAI format.
+
+    A translator holds the state of a single request, it must not be shared across requests.
+    """
+
+    def __init__(self):
+        self.id = f"chatcmpl-{uuid.uuid4().hex}"
+        self.created = int(time.time())
+        self.model = ""
+        self._pending = b""
+        self._done = False
+
+    @abstractmethod
+    def translate_request(self, body: dict) -> dict:
+        """
+        translate_request translates an OpenAI chat completions request to the provider format.
+        """
+        pass
+
+    @abstractmethod
+    def translate_response(self, body: dict) -> dict:
+        """
+        translate_response translates a provider response to an OpenAI chat completion.
+        """
+        pass
+
+    @abstractmethod
+    def translate_event(self, data: dict) -> List[dict]:
+        """
+        translate_event translates an event of a provider streaming response to
+        OpenAI chat completion chunks.
+        """
+        pass
+
+    def translate_stream(self, data: bytes, end_of_stream: bool) -> bytes:
+        """
+        translate_stream translates a part of a provider SSE streaming response to
+        OpenAI SSE chat completion chunks. Incomplete events are kept until the next part.
+        """
+        self._pending += data
+        events = _SSE_EVENT_DELIMITER.split(self._pending)
+        self._pending = b"" if end_of_stream else events.pop()
+
+        out = bytearray()
+        for event in events:
+            payload = b"".join(
+                line[len(b"data:") :].strip()
+                for line in event.splitlines()
+                if line.startswith(b"data:")
+            )
+            if not payload or payload == b"[DONE]":
+                continue
+            try:
+                jsn = json.loads(payload)
+            except json.JSONDecodeError:
+                continue
+            for chunk in self.translate_event(jsn):
+                out += b"data: " + json.dumps(chunk).encode("utf-8") + b"\n\n"
+
+        if end_of_stream and not self._done:
+            self._done = True
+            out += b"data: [DONE]\n\n"
+        return bytes(out)
+
+    def chunk(
+        self,
+        delta: dict,
+        finish_reason: str | None = None,
+        usage: dict | None = None,
+        index: int = 0,
+    ) -> dict:
+        chunk = {
+            "id": self.id,
+            "object": "chat.completion.chunk",
+            "created": self.created,
+            "model": self.model,
+            "choices": [
+                {"index": index, "delta": delta, "finish_reason": finish_reason}
+            ],
+        }
+        if usage is not None:
+            chunk["usage"] = usage
+        return chunk
+
+    def completion(self, choices: List[dict], usage: dict) -> dict:
+        return {
+            "id": self.id,
+            "object": "chat.completion",
+            "created": self.created,
+            "model": self.model,
+            "choices": choices,
+            "usage": usage,
+        }
+
+
+def usage(prompt_tokens: int, completion_tokens: int) -> dict:
+    return {
+        "prompt_tokens": prompt_tokens,
+        "completion_tokens": completion_tokens,
+        "total_tokens": prompt_tokens + completion_tokens,
+    }
+
+
+def assistant_message(text: str, tool_calls: List[dict]) -> dict:
+    message: Dict[str, Any] = {"role": "assistant", "content": text or None}
+    if tool_calls:
+        message["tool_calls"] = tool_calls
+    return message
+
+
+class AnthropicTranslator(Translator):
+    """
+    AnthropicTranslator translates to and from the Anthropic messages API.
+    https://docs.anthropic.com/en/api/messages
+    """
+
+    def __init__(self):
+        super().__init__()
+        self._prompt_tokens = 0
+        self._tool_indexes: Dict[int, int] = {}
+
+    def translate_request(self, body: dict) -> dict:
+        self.model = body.get("model", "")
+        system: List[str] = []
+        messages: List[dict] = []
+        for msg in body.get("messages", []):
+            role = msg.get("role")
+            if role in ("system", "developer"):
+                system.append(text_content(msg.get("content")))
+            elif role == "tool":
+                result = {
+                    "type": "tool_result",
+                    "tool_use_id": msg.get("tool_call_id", ""),
+                    "content": text_content(msg.get("content")),
+                }
+                # the results of parallel tool calls go in a single user message
+                if messages and self._is_tool_results(messages[-1]):
+                    messages[-1]["content"].append(result)
+                else:
+                    messages.append({"role": "user", "content": [result]})
+            elif role == "assistant" and msg.get("tool_calls"):
+                content: List[dict] = []
+                if text := text_content(msg.get("content")):
+                    content.append({"type": "text", "text": text})
+                for call in msg["tool_calls"]:
+                    function = call.get("function", {})
+                    content.append(
+                        {
+                            "type": "tool_use",
+                            "id": call.get("id", ""),
+                            "name": function.get("name", ""),
+                            "input": tool_arguments(function.get("arguments")),
+                        }
+                    )
+                messages.append({"role": "assistant", "content": content})
+            else:
+                messages.append(
+                    {"role": role, "content": self._content(msg.get("content"))}
+                )
+
+        out: Dict[str, Any] = {
+            "model": self.model,
+            "messages": messages,
+            "max_tokens": body.get("max_completion_tokens")
+            or body.get("max_tokens")
+            or DEFAULT_ANTHROPIC_MAX_TOKENS,
+        }
+        if system:
+            out["system"] = "\n".join(system)
+        for key in ("temperature", "top_p", "stream"):
+            if key in body:
+                out[key] = body[key]
+        if stop := body.get("stop"):
+            out["stop_sequences"] = [stop] if isinstance(stop, str) else stop
+        if user := body.get("user"):
+            out["metadata"] = {"user_id": user}
+        if tools := body.get("tools"):
+            out["tools"] = [
+                {
+                    "name": tool["function"].get("name", ""),
+                    "description": tool["function"].get("description", ""),
+                    "input_schema": tool["function"].get(
+                        "parameters", {"type": "object", "properties": {}}
+                    ),
+                }
+                for tool in tools
+                if tool.get("type") == "function" and "function" in tool
+            ]
+        if (tool_choice := body.get("tool_choice")) is not None:
+            out["tool_choice"] = self._tool_choice(tool_choice)
+        return out
+
+    @staticmethod
+    def _is_tool_results(message: dict) -> bool:
+        content = message.get("content")
+        return (
+            message.get("role") == "user"
+            and isinstance(content, list)
+            and all(block.get("type") == "tool_result" for block in content)
+        )
+
+    @staticmethod
+    def _content(content: Any) -> Any:
+        if content is None or isinstance(content, str):
+            return content or ""
+        blocks: List[dict] = []
+        for part in content:
+            if part.get("type") == "text":
+                blocks.append({"type": "text", "text": part.get("text", "")})
+            elif part.get("type") == "image_url":
+                url = part.get("image_url", {}).get("url", "")
+                if match := _DATA_URL.match(url):
+                    source = {
+                        "type": "base64",
+                        "media_type": match.group("media_type"),
+                        "data": match.group("data"),
+                    }
+                else:
+                    source = {"type": "url", "url": url}
+                blocks.append({"type": "image", "source": source})
+        return blocks
+
+    @staticmethod
+    def _tool_choice(tool_choice: Any) -> dict:
+        if isinstance(tool_choice, dict):
+            return {
+                "type": "tool",
+                "name": tool_choice.get("function", {}).get("name", ""),
+            }
+        match tool_choice:
+            case "required":
+                return {"type": "any"}
+            case "none":
+                return {"type": "none"}
+            case _:
+                return {"type": "auto"}
+
+    def translate_response(self, body: dict) -> dict:
+        if body.get("type") == "error" or "error" in body:
+            error = body.get("error", {})
+            return openai_error(
+                error.get("message", ""), error.get("type", "api_error")
+            )
+
+        self.model = body.get("model") or self.model
+        text = ""
+        tool_calls: List[dict] = []
+        for block in body.get("content", []):
+            if block.get("type") == "text":
+                text += block.get("text", "")
+            elif block.get("type") == "tool_use":
+                tool_calls.append(
+                    {
+                        "id": block.get("id", ""),
+                        "type": "function",
+                        "function": {
+                            "name": block.get("name", ""),
+                            "arguments": json.dumps(block.get("input", {})),
+                        },
+                    }
+                )
+        tokens = body.get("usage", {})
+        return self.completion(
+            choices=[
+                {
+                    "index": 0,
+                    "message": assistant_message(text, tool_calls),
+                    "finish_reason": _ANTHROPIC_FINISH_REASONS.get(
+                        body.get("stop_reason", ""), "stop"
+                    ),
+                }
+            ],
+            usage=usage(tokens.get("input_tokens", 0), tokens.get("output_tokens", 0)),
+        )
+
+    def translate_event(self, data: dict) -> List[dict]:
+        match data.get("type"):
+            case "message_start":
+                message = data.get("message", {})
+                self.model = message.get("model") or self.model
+                self._prompt_tokens = message.get("usage", {}).get("input_tokens", 0)
+                return [self.chunk({"role": "assistant", "content": ""})]
+            case "content_block_start":
+                block = data.get("content_block", {})
+                if block.get("type") != "tool_use":
+                    return []
+                tool_index = len(self._tool_indexes)
+                self._tool_indexes[data.get("index", 0)] = tool_index
+                return [
+                    self.chunk(
+                        {
+                            "tool_calls": [
+                                {
+                                    "index": tool_index,
+                                    "id": block.get("id", ""),
+                                    "type": "function",
+                                    "function": {
+                                        "name": block.get("name", ""),
+                                        "arguments": "",
+                                    },
+                                }
+                            ]
+                        }
+                    )
+                ]
+            case "content_block_delta":
+                delta = data.get("delta", {})
+                if delta.get("type") == "text_delta":
+                    return [self.chunk({"content": delta.get("text", "")})]
+                if delta.get("type") == "input_json_delta":
+                    tool_index = self._tool_indexes.get(data.get("index", 0), 0)
+                    return [
+                        self.chunk(
+                            {
+                                "tool_calls": [
+                                    {
+                                        "index": tool_index,
+                                        "function": {
+                                            "arguments": delta.get("partial_json", "")
+                                        },
+                                    }
+                                ]
+                            }
+                        )
+                    ]
+                return []
+            case "message_delta":
+                stop_reason = data.get("delta", {}).get("stop_reason") or ""
+                completion_tokens = data.get("usage", {}).get("output_tokens", 0)
+                return [
+                    self.chunk(
+                        {},
+                        finish_reason=_ANTHROPIC_FINISH_REASONS.get(stop_reason, "stop"),
+                        usage=usage(self._prompt_tokens, completion_tokens),
+                    )
+                ]
+            case "error":
+                error = data.get("error", {})
+                return [
+                    openai_error(
+                        error.get("message", ""), error.get("type", "api_error")
+                    )
+                ]
+            case _:
+                # ping, content_block_stop and message_stop have no OpenAI equivalent
+                return []
+
+
+class GeminiTranslator(Translator):
+    """
+    GeminiTranslator translates to and from the Gemini generateContent API, which is
+    also used by the Google models of Vertex AI.
+    https://ai.google.dev/api/generate-content
+    """
+
+    def __init__(self):
+        super().__init__()
+        self._tool_calls = 0
+        self._started = False
+
+    def translate_request(self, body: dict) -> dict:
+        self.model = body.get("model", "")
+        system: List[dict] = []
+        contents: List[dict] = []
+        tool_names: Dict[str, str] = {}
+        for msg in body.get("messages", []):
+            role = msg.get("role")
+            if role in ("system", "developer"):
+                system.append({"text": text_content(msg.get("content"))})
+            elif role == "tool":
+                response = {
+                    "functionResponse": {
+                        "name": tool_names.get(msg.get("tool_call_id", ""), ""),
+                        "response": {"content": text_content(msg.get("content"))},
+                    }
+                }
+                # the results of parallel function calls go in a single content
+                if contents and all(
+                    "functionResponse" in part for part in contents[-1]["parts"]
+                ):
+                    contents[-1]["parts"].append(response)
+                else:
+                    contents.append({"role": "user", "parts": [response]})
+            elif role == "assistant":
+                parts: List[dict] = []
+                if text := text_content(msg.get("content")):
+                    parts.append({"text": text})
+                for call in msg.get("tool_calls") or []:
+                    function = call.get("function", {})
+                    tool_names[call.get("id", "")] = function.get("name", "")
+                    parts.append(
+                        {
+                            "functionCall": {
+                                "name": function.get("name", ""),
+                                "args": tool_arguments(function.get("arguments")),
+                            }
+                        }
+                    )
+                contents.append({"role": "model", "parts": parts})
+            else:
+                contents.append(
+                    {"role": "user", "parts": self._parts(msg.get("content"))}
+                )
+
+        out: Dict[str, Any] = {"contents": contents}
+        if system:
+            out["systemInstruction"] = {"parts": system}
+
+        generation_config: Dict[str, Any] = {}
+        for key, gemini_key in (
+            ("temperature", "temperature"),
+            ("top_p", "topP"),
+            ("n", "candidateCount"),
+        ):
+            if key in body:
+                generation_config[gemini_key] = body[key]
+        if max_tokens := body.get("max_completion_tokens") or body.get("max_tokens"):
+            generation_config["maxOutputTokens"] = max_tokens
+        if stop := body.get("stop"):
+            generation_config["stopSequences"] = [stop] if isinstance(stop, str) else stop
+        if body.get("response_format", {}).get("type") in ("json_object", "json_schema"):
+            generation_config["responseMimeType"] = "application/json"
+        if generation_config:
+            out["generationConfig"] = generation_config
+
+        if tools := body.get("tools"):
+            out["tools"] = [
+                {
+                    "functionDeclarations": [
+                        {
+                            key: value
+                            for key, value in (
+                                ("name", tool["function"].get("name", "")),
+                                ("description", tool["function"].get("description")),
+                                ("parameters", tool["function"].get("parameters")),
+                            )
+                            if value is not None
+                        }
+                        for tool in tools
+                        if tool.get("type") == "function" and "function" in tool
+                    ]
+                }
+            ]
+        if (tool_choice := body.get("tool_choice")) is not None:
+            out["toolConfig"] = {"functionCallingConfig": self._tool_choice(tool_choice)}
+        return out
+
+    @staticmethod
+    def _parts(content: Any) -> List[dict]:
+        if content is None or isinstance(content, str):
+            return [{"text": content or ""}]
+        parts: List[dict] = []
+        for part in content:
+            if part.get("type") == "text":
+                parts.append({"text": part.get("text", "")})
+            elif part.get("type") == "image_url":
+                url = part.get("image_url", {}).get("url", "")
+                if match := _DATA_URL.match(url):
+                    parts.append(
+                        {
+                            "inlineData": {
+                                "mimeType": match.group("media_type"),
+                                "data": match.group("data"),
+                            }
+                        }
+                    )
+                else:
+                    parts.append({"fileData": {"fileUri": url}})
+        return parts
+
+    @staticmethod
+    def _tool_choice(tool_choice: Any) -> dict:
+        if isinstance(tool_choice, dict):
+            return {
+                "mode": "ANY",
+                "allowedFunctionNames": [
+                    tool_choice.get("function", {}).get("name", "")
+                ],
+            }
+        match tool_choice:
+            case "required":
+                return {"mode": "ANY"}
+            case "none":
+                return {"mode": "NONE"}
+            case _:
+                return {"mode": "AUTO"}
+
+    def _tool_call(self, function_call: dict) -> dict:
+        call = {
+            "id": f"call_{self._tool_calls}",
+            "type": "function",
+            "function": {
+                "name": function_call.get("name", ""),
+                "arguments": json.dumps(function_call.get("args", {})),
+            },
+        }
+        self._tool_calls += 1
+        return call
+
+    @staticmethod
+    def _finish_reason(candidate: dict, has_tool_calls: bool) -> str | None:
+        finish_reason = candidate.get("finishReason")
+        if not finish_reason:
+            return None
+        if has_tool_calls:
+            return "tool_calls"
+        return _GEMINI_FINISH_REASONS.get(finish_reason, "stop")
+
+    @staticmethod
+    def _usage(body: dict) -> dict:
+        metadata = body.get("usageMetadata", {})
+        return usage(
+            metadata.get("promptTokenCount", 0),
+            metadata.get("candidatesTokenCount", 0),
+        )
+
+    def translate_response(self, body: dict) -> dict:
+        if "error" in body:
+            error = body.get("error", {})
+            return openai_error(
+                error.get("message", ""),
+                error.get("status", "api_error"),
+                error.get("code"),
+            )
+
+        self.model = body.get("modelVersion") or self.model
+        choices: List[dict] = []
+        for idx, candidate in enumerate(body.get("candidates", [])):
+            text = ""
+            tool_calls: List[dict] = []
+            for part in candidate.get("content", {}).get("parts", []):
+                if "text" in part:
+                    text += part["text"]
+                elif "functionCall" in part:
+                    tool_calls.append(self._tool_call(part["functionCall"]))
+            choices.append(
+                {
+                    "index": candidate.get("index", idx),
+                    "message": assistant_message(text, tool_calls),
+                    "finish_reason": self._finish_reason(candidate, bool(tool_calls))
+                    or "stop",
+                }
+            )
+        return self.completion(choices=choices, usage=self._usage(body))
+
+    def translate_event(self, data: dict) -> List[dict]:
+        if "error" in data:
+            return [self.translate_response(data)]
+
+        self.model = data.get("modelVersion") or self.model
+        chunks: List[dict] = []
+        for idx, candidate in enumerate(data.get("candidates", [])):
+            delta: Dict[str, Any] = {}
+            if not self._started:
+                delta["role"] = "assistant"
+            text = ""
+            tool_calls: List[dict] = []
+            for part in candidate.get("content", {}).get("parts", []):
+                if "text" in part:
+                    text += part["text"]
+                elif "functionCall" in part:
+                    # tool calls are indexed across the whole stream
+                    tool_index = self._tool_calls
+                    call = self._tool_call(part["functionCall"])
+                    call["index"] = tool_index
+                    tool_calls.append(call)
+            if text:
+                delta["content"] = text
+            if tool_calls:
+                delta["tool_calls"] = tool_calls
+            finish_reason = self._finish_reason(candidate, bool(tool_calls))
+            chunks.append(
+                self.chunk(
+                    delta,
+                    finish_reason=finish_reason,
+                    usage=self._usage(data) if finish_reason else None,
+                    index=candidate.get("index", idx),
+                )
+            )
+        self._started = True
+        return chunks
+
+
+def new_translator(llm_provider: str) -> Translator | None:
+    """
+    new_translator returns the translator from the OpenAI API format to the format of
+    the provider, or None if the provider already uses the OpenAI API format.
+    """
+    match llm_provider:
+        case provider if provider == ANTHROPIC_LLM_STR:
+            return AnthropicTranslator()
+        case provider if provider in (GEMINI_LLM_STR, VERTEX_AI_LLM_STR):
+            return GeminiTranslator()
+        case _:
+            return None
@@ -10,6 +10,8 @@ from presidio_analyzer.predefined_recognizers import (
     UsSsnRecognizer,
     CreditCardRecognizer,
     EmailRecognizer,
+    IbanRecognizer,
+    UsPassportRecognizer,
 )
 
 global_regex_flage = re.DOTALL | re.MULTILINE | re.IGNORECASE
@@ -30,6 +32,10 @@ def init_presidio_config(
                 recognizers.append(PhoneRecognizer())
             case prompt_guard.BuiltIn.EMAIL:
                 recognizers.append(EmailRecognizer())
+            case prompt_guard.BuiltIn.IBAN:
+                recognizers.append(IbanRecognizer())
+            case prompt_guard.BuiltIn.US_PASSPORT:
+                recognizers.append(UsPassportRecognizer())
     for idx, regex_match in enumerate(guardrails_regex.matches):
         compiled_re = re.compile(regex_match.pattern, global_regex_flage)
         pattern = Pattern(
@@ -1,7 +1,56 @@
+import hashlib
+import hmac
+from typing import Callable
+
+import regex as re
 from api.kgateway.policy.ai import prompt_guard
 from presidio_analyzer import EntityRecognizer
 from presidio_anonymizer.entities import RecognizerResult
 from presidio_anonymizer import AnonymizerEngine
+from util.proto import read_secret
+
+
+class MaskTokens:
+    """
+    MaskTokens keeps the tokens that replaced the matched data of a request with the
+    Tokenize mask strategy, so that the original data can be restored in the response.
+    """
+
+    __token_pattern = re.compile(r"<[A-Z_]+_\d+>")
+
+    def __init__(self):
+        self.originals: dict[str, str] = {}
+        """
+        originals maps each token to the data it replaced.
+        """
+        self.__tokens: dict[tuple[str, str], str] = {}
+        self.__counts: dict[str, int] = {}
+
+    def __bool__(self) -> bool:
+        return len(self.originals) > 0
+
+    def token(self, entity_type: str, value: str) -> str:
+        """
+        Returns the token of the value, the same value of an entity type is always
+        replaced with the same token so that the LLM can still refer to it.
+        """
+        if (token := self.__tokens.get((entity_type, value))) is not None:
+            return token
+        self.__counts[entity_type] = self.__counts.get(entity_type, 0) + 1
+        token = f"<{entity_type}_{self.__counts[entity_type]}>"
+        self.__tokens[(entity_type, value)] = token
+        self.originals[token] = value
+        return token
+
+    def restore(self, role: str, content: str) -> str:
+        """
+        Replaces the tokens in the content with the data they replaced.
+        """
+        if not self.originals:
+            return content
+        return self.__token_pattern.sub(
+            lambda m: self.originals.get(m.group(0), m.group(0)), content
+        )
 
 
 def regex_transform(
@@ -10,6 +59,8 @@ def regex_transform(
     rules: list[EntityRecognizer] | None,
     anon: AnonymizerEngine,
     action: prompt_guard.Action = prompt_guard.Action.MASK,
+    mask: prompt_guard.RegexMask | None = None,
+    tokens: MaskTokens | None = None,
 ) -> str:
     if rules:
         matrix = [
@@ -22,6 +73,25 @@ def regex_transform(
         if len(results) > 0 and action == prompt_guard.Action.REJECT:
             raise RegexRejection(" ".join([str(i) for i in results]))
 
+        if mask is not None:
+            match mask.strategy:
+                # the matches are redacted while the key is not mounted
+                case prompt_guard.MaskStrategy.HASH if (
+                    key := read_secret(mask.hash_key_secret_ref, "key")
+                ):
+                    return replace_matches(
+                        content,
+                        results,
+                        lambda _, value: hmac.new(
+                            key.encode("utf-8"), value.encode("utf-8"), hashlib.sha256
+                        ).hexdigest(),
+                    )
+                case prompt_guard.MaskStrategy.TOKENIZE if tokens is not None:
+                    return replace_matches(content, results, tokens.token)
+                case _ if mask.replacement is not None:
+                    replacement = mask.replacement
+                    return replace_matches(content, results, lambda *_: replacement)
+
         anonymized = anon.anonymize(
             text=content,
             analyzer_results=[
@@ -39,6 +109,25 @@ def regex_transform(
         return content
 
 
+def replace_matches(
+    content: str, results: list, replace: Callable[[str, str], str]
+) -> str:
+    """
+    Replaces each match in the content with the result of replace(entity_type, value).
+    Matches that overlap an earlier match are part of it and are not replaced again.
+    """
+    parts: list[str] = []
+    pos = 0
+    for result in sorted(results, key=lambda r: (r.start, -r.end)):
+        if result.start < pos:
+            continue
+        parts.append(content[pos : result.start])
+        parts.append(replace(result.entity_type, content[result.start : result.end]))
+        pos = result.end
+    parts.append(content[pos:])
+    return "".join(parts)
+
+
 class RegexRejection(Exception):
     """
     RegexRejection is an exception that is raised when the regex action is set to REJECT.
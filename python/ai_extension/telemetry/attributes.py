@@ -30,3 +30,7 @@ AI_MODERATION_MODEL: Final = "ai.moderation.model"
 
 AI_MODERATION_FLAGGED: Final = "ai.moderation.flagged"
 """A boolean value indicating whether the request was rejected by the moderation guardrails due to content moderation (true or false), serving as a direct measure of moderation effectiveness."""
+
+# Semantic cache attributes
+AI_SEMANTIC_CACHE_HIT: Final = "ai.semantic_cache.hit"
+"""A boolean value indicating whether the response was served from the semantic cache."""
@@ -56,7 +56,9 @@ class Config(BaseModel):
     https://github.com/kgateway-dev/kgateway/blob/75dca1e66e894325ee1b57db04c0455432228dcf/api/v1alpha1/gateway_parameters_types.go#L668
     """
 
-    custom_labels: list[CustomLabel] = Field(alias="customLabels")
+    custom_labels: list[CustomLabel] = Field(alias="customLabels", default=[])
+    client_header: str | None = Field(alias="clientHeader", default=None)
+    clients: list[str] = Field(alias="clients", default=[])
 
     @classmethod
     def from_file(cls, file_path: str):
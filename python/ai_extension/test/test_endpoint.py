@@ -0,0 +1,43 @@
+from ext_proc.endpoint import (
+    AUDIO_TRANSCRIPTION,
+    CHAT,
+    EMBEDDINGS,
+    IMAGE_GENERATION,
+    from_path,
+    multipart_form_field,
+    parse_endpoints,
+)
+
+
+def test_from_path():
+    assert from_path("/v1/chat/completions") == CHAT
+    assert from_path("/openai") == CHAT
+    assert from_path("/v1/embeddings") == EMBEDDINGS
+    assert from_path("/openai/v1/embeddings?user=me") == EMBEDDINGS
+    assert from_path("/v1/images/generations") == IMAGE_GENERATION
+    assert from_path("/v1/audio/transcriptions") == AUDIO_TRANSCRIPTION
+    assert from_path("/v1/embeddings/extra") == CHAT
+
+
+def test_parse_endpoints():
+    assert parse_endpoints("") == set()
+    assert parse_endpoints("embeddings,audio_transcription") == {
+        EMBEDDINGS,
+        AUDIO_TRANSCRIPTION,
+    }
+
+
+def test_multipart_form_field():
+    body = (
+        b"--boundary\r\n"
+        b'Content-Disposition: form-data; name="file"; filename="model.mp3"\r\n'
+        b"Content-Type: audio/mpeg\r\n\r\n"
+        b'name="model"\r\n\r\nnot-a-field\r\n'
+        b"--boundary\r\n"
+        b'Content-Disposition: form-data; name="model"\r\n\r\n'
+        b"gpt-4o-transcribe\r\n"
+        b"--boundary--\r\n"
+    )
+    assert multipart_form_field(body, "model") == "gpt-4o-transcribe"
+    assert multipart_form_field(body, "language") is None
+    assert multipart_form_field(b'{"model": "gpt-4o"}', "model") is None
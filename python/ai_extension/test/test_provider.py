@@ -1,7 +1,7 @@
 import copy
 import json
 
-from ext_proc.provider import Tokens, TokensDetails, Anthropic, Gemini, OpenAI
+from ext_proc.provider import Tokens, TokensDetails, Anthropic, Gemini, Ollama, OpenAI
 from guardrails import api as webhook_api
 from ext_proc.streamchunkdata import StreamChunkDataType
 from typing import Dict, Any
@@ -703,6 +703,55 @@ def test_openai_tokens():
     assert tokens.prompt_details.rejected_prediction == 0
 
 
+def test_openai_endpoint_tokens():
+    provider = OpenAI()
+    # embeddings only report prompt tokens
+    embeddings = {
+        "object": "list",
+        "data": [{"object": "embedding", "index": 0, "embedding": [0.1, 0.2]}],
+        "model": "text-embedding-3-small",
+        "usage": {"prompt_tokens": 8, "total_tokens": 8},
+    }
+    assert provider.tokens(embeddings) == Tokens(prompt=8)
+
+    images = {
+        "created": 1713833628,
+        "data": [{"b64_json": "..."}],
+        "usage": {
+            "total_tokens": 100,
+            "input_tokens": 50,
+            "output_tokens": 50,
+            "input_tokens_details": {"text_tokens": 10, "image_tokens": 40},
+        },
+    }
+    assert provider.tokens(images) == Tokens(
+        prompt=50,
+        completion=50,
+        prompt_details=TokensDetails(text=10, image=40),
+    )
+
+    transcription = {
+        "text": "Hello world",
+        "usage": {
+            "type": "tokens",
+            "input_tokens": 14,
+            "input_token_details": {"text_tokens": 0, "audio_tokens": 14},
+            "output_tokens": 45,
+            "total_tokens": 59,
+        },
+    }
+    assert provider.tokens(transcription) == Tokens(
+        prompt=14,
+        completion=45,
+        prompt_details=TokensDetails(audio=14),
+    )
+
+    # whisper reports the duration of the audio instead of tokens
+    assert provider.tokens(
+        {"text": "Hello", "usage": {"type": "duration", "seconds": 3}}
+    ) == Tokens()
+
+
 def test_openai_get_model_req():
     provider = OpenAI()
     headers_jsn = {}
@@ -939,3 +988,116 @@ def test_openai_update_stream_resp_usage_token():
     assert details["rejected_prediction_tokens"] == 2
     assert details["accepted_prediction_tokens"] == 3
     assert details["reasoning_tokens"] == 4
+
+
+def ollama_chat_resp() -> Dict[str, Any]:
+    return {
+        "model": "llama3.1",
+        "created_at": "2025-01-10T21:30:38.463Z",
+        "message": {"role": "assistant", "content": "The sky is blue."},
+        "done_reason": "stop",
+        "done": True,
+        "total_duration": 4883583458,
+        "prompt_eval_count": 26,
+        "eval_count": 8,
+    }
+
+
+def ollama_generate_req() -> Dict[str, Any]:
+    return {
+        "model": "llama3.1",
+        "system": "Be brief.",
+        "prompt": "Why is the sky blue?",
+    }
+
+
+def test_ollama_tokens():
+    provider = Ollama()
+    tokens = provider.tokens(ollama_chat_resp())
+    assert tokens.prompt == 26
+    assert tokens.completion == 8
+
+    # streaming chunks have no counts until they are done
+    assert provider.tokens({"message": {"content": "The"}, "done": False}) == Tokens()
+
+
+def test_ollama_is_streaming_req():
+    provider = Ollama()
+    assert provider.is_streaming_req(ollama_generate_req(), {}) is True
+    assert provider.is_streaming_req({"stream": False}, {}) is False
+
+
+def test_ollama_is_streaming_response():
+    provider = Ollama()
+    assert provider.is_streaming_response(True, None, "application/x-ndjson") is True
+    assert provider.is_streaming_response(False, None, "application/json") is False
+
+
+def test_ollama_iterate_str_req_messages():
+    provider = Ollama()
+    body = ollama_generate_req()
+    provider.iterate_str_req_messages(body, lambda role, content: role)
+    assert body["system"] == "system"
+    assert body["prompt"] == "user"
+
+    body = {"messages": [{"role": "user", "content": "Why is the sky blue?"}]}
+    provider.iterate_str_req_messages(body, lambda role, content: content.upper())
+    assert body["messages"][0]["content"] == "WHY IS THE SKY BLUE?"
+
+
+def test_ollama_iterate_str_resp_messages():
+    provider = Ollama()
+    body = ollama_chat_resp()
+    provider.iterate_str_resp_messages(body, lambda role, content: role)
+    assert body["message"]["content"] == "assistant"
+
+    body = {"response": "The sky is blue.", "done": True}
+    provider.iterate_str_resp_messages(body, lambda role, content: content.upper())
+    assert body["response"] == "THE SKY IS BLUE."
+
+
+def test_ollama_webhook_request_body():
+    provider = Ollama()
+    body = ollama_generate_req()
+    messages = provider.construct_request_webhook_request_body(body)
+    assert messages.messages == [
+        webhook_api.Message(role="user", content="Why is the sky blue?")
+    ]
+
+    messages.messages[0].content = "Why is the sea blue?"
+    provider.update_request_body_from_webhook(body, messages)
+    assert body["prompt"] == "Why is the sea blue?"
+
+
+def test_ollama_webhook_response_body():
+    provider = Ollama()
+    body = ollama_chat_resp()
+    choices = provider.construct_response_webhook_request_body(body)
+    assert len(choices.choices) == 1
+    assert choices.choices[0].message.content == "The sky is blue."
+
+    choices.choices[0].message.content = "The sea is blue."
+    provider.update_response_body_from_webhook(body, choices)
+    assert body["message"]["content"] == "The sea is blue."
+
+
+def test_ollama_stream_resp_chunks():
+    provider = Ollama()
+    chunk = {"model": "llama3.1", "response": "The", "done": False}
+    assert provider.extract_contents_from_resp_chunk(chunk) == [b"The"]
+    assert provider.get_stream_resp_chunk_type(chunk) == StreamChunkDataType.NORMAL_TEXT
+
+    provider.update_stream_resp_contents(chunk, 0, b"A")
+    assert chunk["response"] == "A"
+
+    last_chunk = {"model": "llama3.1", "response": "", "done": True, "eval_count": 2}
+    assert provider.extract_contents_from_resp_chunk(last_chunk) is None
+    assert (
+        provider.get_stream_resp_chunk_type(last_chunk)
+        == StreamChunkDataType.FINISH_NO_CONTENT
+    )
+
+    provider.update_stream_resp_usage_token(last_chunk, Tokens(prompt=3, completion=4))
+    assert last_chunk["prompt_eval_count"] == 3
+    assert last_chunk["eval_count"] == 4
+
@@ -0,0 +1,55 @@
+import pytest
+
+from api.kgateway.policy.ai.token_ratelimit import (
+    TokenBucket,
+    local_from_json,
+    parse_duration,
+)
+from ext_proc.ratelimit import TokenRateLimiter
+
+
+class FakeClock:
+    def __init__(self):
+        self.now = 0.0
+
+    def __call__(self) -> float:
+        return self.now
+
+
+def test_parse_duration():
+    assert parse_duration("1m0s") == 60.0
+    assert parse_duration("1h30m") == 5400.0
+    assert parse_duration("500ms") == 0.5
+    with pytest.raises(ValueError):
+        parse_duration("1 minute")
+
+
+def test_local_from_json():
+    config = local_from_json(
+        '{"tokenBucket":{"maxTokens":100,"fillInterval":"1s"},"header":"X-User-Id"}'
+    )
+    assert config.token_bucket == TokenBucket(
+        max_tokens=100, fill_interval=1.0, tokens_per_fill=1
+    )
+    assert config.header == "x-user-id"
+
+
+def test_token_rate_limiter():
+    clock = FakeClock()
+    limiter = TokenRateLimiter(clock=clock)
+    bucket = TokenBucket(max_tokens=100, fill_interval=1.0, tokens_per_fill=50)
+
+    # prompt and completion tokens exhaust the budget
+    assert limiter.admit("policy", "alice", bucket, 60)
+    limiter.debit("policy", "alice", bucket, 60)
+    assert not limiter.admit("policy", "alice", bucket, 1)
+
+    # each descriptor value has its own budget
+    assert limiter.admit("policy", "bob", bucket, 10)
+
+    # the bucket is refilled every fill interval, up to max tokens
+    clock.now = 1.0
+    assert limiter.admit("policy", "alice", bucket, 10)
+    clock.now = 10.0
+    assert limiter.admit("policy", "alice", bucket, 100)
+    assert not limiter.admit("policy", "alice", bucket, 1)
@@ -0,0 +1,114 @@
+import hashlib
+import hmac
+import json
+
+from api.kgateway.policy.ai import prompt_guard
+from guardrails.presidio import init_presidio_config
+from guardrails.regex import MaskTokens, regex_transform
+from presidio_anonymizer import AnonymizerEngine
+from util import env
+
+EMAIL = "jane@example.com"
+CONTENT = f"Contact {EMAIL} or jane@example.com, not bob@example.com."
+
+
+def email_rules():
+    return init_presidio_config(
+        prompt_guard.Regex(builtins=[prompt_guard.BuiltIn.EMAIL])
+    )
+
+
+def test_regex_mask_from_json():
+    regex = prompt_guard.Regex.from_json(
+        json.loads(
+            '{"builtins": ["IBAN", "US_PASSPORT"], "mask": {"strategy": "Tokenize"}}'
+        )
+    )
+    assert regex.builtins == [
+        prompt_guard.BuiltIn.IBAN,
+        prompt_guard.BuiltIn.US_PASSPORT,
+    ]
+    assert regex.mask == prompt_guard.RegexMask(
+        strategy=prompt_guard.MaskStrategy.TOKENIZE
+    )
+
+    regex = prompt_guard.Regex.from_json({})
+    assert regex.mask == prompt_guard.RegexMask(
+        strategy=prompt_guard.MaskStrategy.REDACT
+    )
+
+
+def test_regex_transform_redact():
+    anon = AnonymizerEngine()
+    assert (
+        regex_transform("user", CONTENT, email_rules(), anon)
+        == "Contact <EMAIL_ADDRESS> or <EMAIL_ADDRESS>, not <EMAIL_ADDRESS>."
+    )
+    assert (
+        regex_transform(
+            "user",
+            CONTENT,
+            email_rules(),
+            anon,
+            mask=prompt_guard.RegexMask(replacement="[email]"),
+        )
+        == "Contact [email] or [email], not [email]."
+    )
+
+
+def test_regex_transform_hash(tmp_path, monkeypatch):
+    monkeypatch.setattr(env, "secrets_dir", str(tmp_path))
+    (tmp_path / "hash-key").mkdir()
+    (tmp_path / "hash-key" / "key").write_text("s3cr3t")
+    mask = prompt_guard.RegexMask(
+        strategy=prompt_guard.MaskStrategy.HASH, hash_key_secret_ref="hash-key"
+    )
+
+    digest = hmac.new(b"s3cr3t", EMAIL.encode("utf-8"), hashlib.sha256).hexdigest()
+    masked = regex_transform(
+        "user", CONTENT, email_rules(), AnonymizerEngine(), mask=mask
+    )
+    assert masked.startswith(f"Contact {digest} or {digest}, not ")
+    assert "bob@example.com" not in masked
+
+    # the matches are redacted while the key is not mounted
+    mask.hash_key_secret_ref = "missing"
+    assert (
+        regex_transform("user", CONTENT, email_rules(), AnonymizerEngine(), mask=mask)
+        == "Contact <EMAIL_ADDRESS> or <EMAIL_ADDRESS>, not <EMAIL_ADDRESS>."
+    )
+
+
+def test_regex_transform_tokenize():
+    tokens = MaskTokens()
+    masked = regex_transform(
+        "user",
+        CONTENT,
+        email_rules(),
+        AnonymizerEngine(),
+        mask=prompt_guard.RegexMask(strategy=prompt_guard.MaskStrategy.TOKENIZE),
+        tokens=tokens,
+    )
+    # the same value is always replaced with the same token
+    assert (
+        masked
+        == "Contact <EMAIL_ADDRESS_1> or <EMAIL_ADDRESS_1>, not <EMAIL_ADDRESS_2>."
+    )
+    assert tokens.restore("assistant", masked) == CONTENT
+    # tokens that were not issued for the request are left as is
+    assert tokens.restore("assistant", "<EMAIL_ADDRESS_3>") == "<EMAIL_ADDRESS_3>"
+
+
+def test_mask_tokens():
+    tokens = MaskTokens()
+    assert not tokens
+    assert tokens.restore("assistant", "<US_SSN_1>") == "<US_SSN_1>"
+    assert tokens.token("US_SSN", "078-05-1120") == "<US_SSN_1>"
+    assert tokens.token("IBAN_CODE", "DE89370400440532013000") == "<IBAN_CODE_1>"
+    assert tokens.token("US_SSN", "219-09-9999") == "<US_SSN_2>"
+    assert tokens.token("US_SSN", "078-05-1120") == "<US_SSN_1>"
+    assert tokens
+    assert (
+        tokens.restore("assistant", "SSN <US_SSN_2>, IBAN <IBAN_CODE_1>")
+        == "SSN 219-09-9999, IBAN DE89370400440532013000"
+    )
@@ -0,0 +1,89 @@
+from api.kgateway.policy.ai.authtoken import (
+    LocalObjectReference,
+    SingleAuthToken,
+    SingleAuthTokenKind,
+)
+from api.kgateway.policy.ai.semantic_cache import Embedding, from_json
+from ext_proc.semantic_cache import InMemoryVectorStore, VectorStores
+
+
+class FakeClock:
+    def __init__(self):
+        self.now = 0.0
+
+    def __call__(self) -> float:
+        return self.now
+
+
+def test_from_json():
+    config = from_json(
+        """{
+            "embedding": {
+                "provider": "openai",
+                "authToken": {"kind": "SecretRef", "secretRef": {"name": "openai"}},
+                "model": "m"
+            },
+            "maxEntries": 10,
+            "similarityThreshold": "0.9",
+            "ttl": "1h0m0s"
+        }"""
+    )
+    assert config.embedding == Embedding(
+        provider="openai",
+        auth_token=SingleAuthToken(
+            kind=SingleAuthTokenKind.SECRET_REF,
+            secret_ref=LocalObjectReference(name="openai"),
+        ),
+        model="m",
+    )
+    assert config.max_entries == 10
+    assert config.similarity_threshold == 0.9
+    assert config.ttl == 3600.0
+
+
+def test_in_memory_vector_store_search():
+    store = InMemoryVectorStore(max_entries=10)
+    store.add("gpt-4o", [1.0, 0.0], b"east")
+    store.add("gpt-4o", [0.0, 1.0], b"north")
+
+    assert store.search("gpt-4o", [0.99, 0.1], 0.95) == b"east"
+    assert store.search("gpt-4o", [0.1, 0.99], 0.95) == b"north"
+    # not similar enough to any entry
+    assert store.search("gpt-4o", [1.0, 1.0], 0.95) is None
+    # entries of other namespaces never match
+    assert store.search("gpt-4o-mini", [1.0, 0.0], 0.95) is None
+
+
+def test_in_memory_vector_store_eviction():
+    clock = FakeClock()
+    store = InMemoryVectorStore(max_entries=2, ttl=60, clock=clock)
+    store.add("m", [1.0, 0.0], b"first")
+    clock.now = 30
+    store.add("m", [0.0, 1.0], b"second")
+    clock.now = 45
+    store.add("m", [-1.0, 0.0], b"third")
+
+    # the oldest entry is evicted once the store is full
+    assert store.search("m", [1.0, 0.0], 0.95) is None
+    assert store.search("m", [0.0, 1.0], 0.95) == b"second"
+
+    # entries expire after the ttl
+    clock.now = 91
+    assert store.search("m", [0.0, 1.0], 0.95) is None
+    assert store.search("m", [-1.0, 0.0], 0.95) == b"third"
+
+
+def test_vector_stores_eviction():
+    config = from_json(
+        """{"embedding": {"provider": "openai", "authToken": {"kind": "Passthrough"}}}"""
+    )
+    stores = VectorStores(max_stores=2)
+    first = stores.get_or_create("first", config)
+    stores.get_or_create("second", config)
+    # using the first store makes the second one the least recently used
+    assert stores.get_or_create("first", config) is first
+    stores.get_or_create("third", config)
+
+    assert stores.get("first") is first
+    assert stores.get("second") is None
+    assert stores.get("third") is not None
@@ -3,7 +3,7 @@ import unittest
 
 from util import sse
 from ext_proc.streamchunkdata import StreamChunkDataType
-from ext_proc.provider import Anthropic, Gemini, OpenAI
+from ext_proc.provider import Anthropic, Gemini, Ollama, OpenAI
 
 
 def openai_sse_data() -> bytes:
@@ -26,6 +26,10 @@ def anthropic_sse_data() -> bytes:
     return b'event: content_block_delta\ndata: {"type": "content_block_delta", "index": 0, "delta": {"type": "text_delta", "text": "Hello"}}\n\n'
 
 
+def ollama_ndjson_data() -> bytes:
+    return b'{"model":"llama3.1","created_at":"2025-01-10T21:30:38.463Z","message":{"role":"assistant","content":"The"},"done":false}\n{"model":"llama3.1","created_at":"2025-01-10T21:30:38.512Z","message":{"role":"assistant","content":""},"done_reason":"stop","done":true,"prompt_eval_count":26,"eval_count":1}\n'
+
+
 class SSETestCase(unittest.TestCase):
     def test_replace_json_data(self):
         # nothing should change on non-sse data
@@ -50,6 +54,10 @@ class SSETestCase(unittest.TestCase):
             == b'event: content_block_delta\ndata: {"foo": "bar", "test": 123}\n\n'
         )
 
+        # sample ollama ndjson data
+        output = sse.replace_json_data(ollama_ndjson_data().split(b"\n")[0] + b"\n", jsn)
+        assert output == b'{"foo": "bar", "test": 123}\n'
+
         # no json in data field
         data = b"data: junk\n\n"
         with self.assertRaises(expected_exception=sse.SSEParsingException) as context:
@@ -115,3 +123,25 @@ class SSETestCase(unittest.TestCase):
         assert len(chunks) == 1
         assert chunks[0].type == StreamChunkDataType.FINISH
         assert leftover == b""
+
+    def test_parse_ndjson_messages(self):
+        # completed Ollama data with 2 chunks, split within the second chunk
+        data = ollama_ndjson_data()
+        part1 = data[0 : len(data) - 20]
+        part2 = data[len(data) - 20 :]
+        chunks, leftover = sse.parse_sse_messages(
+            llm_provider=Ollama(), data=part1, prev_leftover=b""
+        )
+        assert len(chunks) == 1
+        assert chunks[0].type == StreamChunkDataType.NORMAL_TEXT
+        assert chunks[0].get_contents() == [b"The"]
+        assert leftover.startswith(b'{"model"')
+
+        chunks, leftover = sse.parse_sse_messages(
+            llm_provider=Ollama(), data=part2, prev_leftover=leftover
+        )
+        assert len(chunks) == 1
+        assert chunks[0].type == StreamChunkDataType.FINISH_NO_CONTENT
+        assert chunks[0].json_data["eval_count"] == 1
+        assert leftover == b""
+
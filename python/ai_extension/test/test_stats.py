@@ -1,4 +1,6 @@
-from telemetry.stats import CustomLabel
+from telemetry.stats import CustomLabel, Config as StatsConfig
+from ext_proc.stream import Handler as StreamHandler
+from api.envoy.service.ext_proc.v3 import external_processor_pb2
 from google.protobuf import struct_pb2 as struct_pb2
 from google.protobuf.json_format import ParseDict
 
@@ -41,3 +43,60 @@ class TestStats:
         proto_struct = struct_pb2.Struct()
         ParseDict(struct, proto_struct)
         assert custom_label.get_field(proto_struct) == "test"
+
+
+class TestUsage:
+    def test_client_header(self):
+        config = StatsConfig(clientHeader="X-Client-Id", clients=["team-a"])
+        headers = external_processor_pb2.HttpHeaders()
+        headers.headers.headers.add(key="x-client-id", raw_value=b"team-a")
+
+        handler = StreamHandler.from_metadata({"x-llm-provider": "openai"})
+        handler.set_client(config.client_header, config.clients, headers)
+        assert handler.client == "team-a"
+
+        handler = StreamHandler.from_metadata({"x-llm-provider": "openai"})
+        handler.set_client(None, config.clients, headers)
+        assert handler.client == "unknown"
+
+        # values other than the configured clients are not used as labels
+        headers = external_processor_pb2.HttpHeaders()
+        headers.headers.headers.add(key="x-client-id", raw_value=b"team-b")
+        handler = StreamHandler.from_metadata({"x-llm-provider": "openai"})
+        handler.set_client(config.client_header, config.clients, headers)
+        assert handler.client == "other"
+
+    def test_streaming_usage_metadata(self):
+        now = [10.0]
+        handler = StreamHandler.from_metadata(
+            {"x-llm-provider": "openai", "x-route-name": "chat"}
+        )
+        handler.clock = lambda: now[0]
+        handler.request_start = 10.0
+        handler.resp.is_streaming = True
+
+        now[0] = 10.25
+        handler.record_response_chunk()
+        now[0] = 12.0
+        handler.record_response_chunk()
+
+        assert handler.time_to_first_token() == 0.25
+        assert handler.streaming_duration() == 1.75
+        fields = handler.build_metadata().fields["ai.kgateway.io"].struct_value.fields
+        assert fields["route"].string_value == "chat"
+        assert fields["client"].string_value == "unknown"
+        assert fields["time_to_first_token_ms"].number_value == 250
+        assert fields["streaming_duration_ms"].number_value == 1750
+
+    def test_non_streaming_usage_metadata(self):
+        handler = StreamHandler.from_metadata({"x-llm-provider": "openai"})
+        handler.clock = lambda: 11.0
+        handler.request_start = 10.0
+        handler.record_response_chunk()
+
+        assert handler.time_to_first_token() == 1.0
+        assert handler.streaming_duration() is None
+        fields = handler.build_metadata().fields["ai.kgateway.io"].struct_value.fields
+        assert fields["route"].string_value == "unknown"
+        assert fields["time_to_first_token_ms"].number_value == 1000
+        assert "streaming_duration_ms" not in fields
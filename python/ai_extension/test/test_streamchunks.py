@@ -1,11 +1,16 @@
+import asyncio
 import json
 import unittest
 
+from api.envoy.service.ext_proc.v3 import external_processor_pb2
+from api.kgateway.policy.ai import prompt_guard
 from ext_proc.streamchunks import (
     find_max_min_end_index,
+    stream_error_event,
     StreamChunks,
     StreamChunksContent,
     split_chunk_content_by_boundary_indicator,
+    STREAM_TERMINATED_MESSAGE,
 )
 from ext_proc.streamchunkdata import StreamChunkData, StreamChunkDataType
 from typing import List
@@ -16,9 +21,23 @@ from test.test_data.sample_chunk_data import (
     multichoices_test_chunk_data,
 )
 from copy import deepcopy
+from guardrails.presidio import init_presidio_config
+from guardrails.regex import MaskTokens
+from opentelemetry import trace
+from presidio_anonymizer import AnonymizerEngine
 from util.sse import parse_sse_messages
 
 
+def openai_chunk(content: str) -> bytes:
+    data = {
+        "id": "chatcmpl-1",
+        "object": "chat.completion.chunk",
+        "model": "gpt-4o-mini",
+        "choices": [{"index": 0, "delta": {"content": content}, "finish_reason": None}],
+    }
+    return b"data: " + json.dumps(data).encode("utf-8") + b"\n\n"
+
+
 class TestStreamChunks(unittest.TestCase):
     def test_find_segment_boundary(self):
         chunks = StreamChunks()
@@ -577,3 +596,80 @@ class TestStreamChunks(unittest.TestCase):
         )
         assert usages.prompt == 23
         assert usages.completion == 408
+
+    def test_buffer_regex_reject_terminates_stream(self):
+        chunks = StreamChunks()
+        regex = init_presidio_config(
+            prompt_guard.Regex(
+                matches=[prompt_guard.RegexMatch(pattern="secret", name="SECRET")]
+            )
+        )
+        streaming = prompt_guard.StreamingPromptguard(
+            window_size=10, action=prompt_guard.Action.REJECT
+        )
+
+        def buffer(body: bytes) -> bytes | None:
+            return asyncio.run(
+                chunks.buffer(
+                    llm_provider=OpenAI(),
+                    resp_webhook=None,
+                    resp_regex=regex,
+                    anonymizer_engine=AnonymizerEngine(),
+                    resp_headers={},
+                    resp_body=external_processor_pb2.HttpBody(
+                        body=body, end_of_stream=True
+                    ),
+                    parent_span=trace.NonRecordingSpan(
+                        trace.SpanContext(0, 0, False)
+                    ),
+                    resp_streaming=streaming,
+                )
+            )
+
+        data = buffer(openai_chunk("The secret ") + openai_chunk("is out."))
+        assert data == stream_error_event(STREAM_TERMINATED_MESSAGE)
+        assert chunks.is_terminated
+        event = json.loads(data.split(b"data: ")[1])
+        assert event["error"]["type"] == "content_filter"
+
+        # the rest of the response is dropped
+        assert buffer(openai_chunk("More content.")) == b""
+
+    def test_buffer_restores_mask_tokens(self):
+        chunks = StreamChunks()
+        mask_tokens = MaskTokens()
+        token = mask_tokens.token("EMAIL_ADDRESS", "jane@example.com")
+
+        data = asyncio.run(
+            chunks.buffer(
+                llm_provider=OpenAI(),
+                resp_webhook=None,
+                resp_regex=None,
+                anonymizer_engine=AnonymizerEngine(),
+                resp_headers={},
+                resp_body=external_processor_pb2.HttpBody(
+                    body=openai_chunk("I emailed ")
+                    + openai_chunk(token)
+                    + openai_chunk(" for you."),
+                    end_of_stream=True,
+                ),
+                parent_span=trace.NonRecordingSpan(trace.SpanContext(0, 0, False)),
+                resp_streaming=prompt_guard.StreamingPromptguard(window_size=10),
+                mask_tokens=mask_tokens,
+            )
+        )
+        messages, _ = parse_sse_messages(OpenAI(), data, b"")
+        assert b"".join(m.get_content(0) for m in messages) == (
+            b"I emailed jane@example.com for you."
+        )
+
+    def test_streaming_promptguard_from_json(self):
+        resp = prompt_guard.resp_from_json(
+            json.dumps({"streaming": {"windowSize": 200, "action": "REJECT"}})
+        )
+        assert resp.streaming.window_size == 200
+        assert resp.streaming.action == prompt_guard.Action.REJECT
+
+        resp = prompt_guard.resp_from_json(json.dumps({}))
+        assert resp.streaming.window_size == prompt_guard.DEFAULT_STREAMING_WINDOW_SIZE
+        assert resp.streaming.action == prompt_guard.Action.MASK
@@ -0,0 +1,318 @@
+import json
+
+from ext_proc.translation import (
+    AnthropicTranslator,
+    GeminiTranslator,
+    new_translator,
+)
+
+
+def sse(*events: dict, delimiter: bytes = b"\n\n") -> bytes:
+    return b"".join(b"data: " + json.dumps(e).encode("utf-8") + delimiter for e in events)
+
+
+def parse_sse(data: bytes) -> list:
+    chunks = []
+    for event in data.split(b"\n\n"):
+        if not event:
+            continue
+        payload = event.removeprefix(b"data: ")
+        chunks.append(payload if payload == b"[DONE]" else json.loads(payload))
+    return chunks
+
+
+openai_request = {
+    "model": "claude-3-5-sonnet",
+    "messages": [
+        {"role": "system", "content": "You are a weather bot."},
+        {"role": "user", "content": "Weather in Paris and Rome?"},
+        {
+            "role": "assistant",
+            "content": None,
+            "tool_calls": [
+                {
+                    "id": "call_1",
+                    "type": "function",
+                    "function": {"name": "weather", "arguments": '{"city": "Paris"}'},
+                },
+                {
+                    "id": "call_2",
+                    "type": "function",
+                    "function": {"name": "weather", "arguments": '{"city": "Rome"}'},
+                },
+            ],
+        },
+        {"role": "tool", "tool_call_id": "call_1", "content": "sunny"},
+        {"role": "tool", "tool_call_id": "call_2", "content": "rainy"},
+    ],
+    "tools": [
+        {
+            "type": "function",
+            "function": {
+                "name": "weather",
+                "description": "Get the weather",
+                "parameters": {
+                    "type": "object",
+                    "properties": {"city": {"type": "string"}},
+                },
+            },
+        }
+    ],
+    "tool_choice": "required",
+    "temperature": 0.2,
+    "stop": "END",
+    "stream": True,
+}
+
+
+def test_new_translator():
+    assert isinstance(new_translator("anthropic"), AnthropicTranslator)
+    assert isinstance(new_translator("gemini"), GeminiTranslator)
+    assert isinstance(new_translator("vertex-ai"), GeminiTranslator)
+    assert new_translator("openai") is None
+
+
+class TestAnthropic:
+    def test_request(self):
+        body = AnthropicTranslator().translate_request(openai_request)
+        assert body["system"] == "You are a weather bot."
+        assert body["max_tokens"] == 4096
+        assert body["stop_sequences"] == ["END"]
+        assert body["temperature"] == 0.2
+        assert body["stream"] is True
+        assert body["tool_choice"] == {"type": "any"}
+        assert body["tools"] == [
+            {
+                "name": "weather",
+                "description": "Get the weather",
+                "input_schema": {
+                    "type": "object",
+                    "properties": {"city": {"type": "string"}},
+                },
+            }
+        ]
+        assert body["messages"] == [
+            {"role": "user", "content": "Weather in Paris and Rome?"},
+            {
+                "role": "assistant",
+                "content": [
+                    {
+                        "type": "tool_use",
+                        "id": "call_1",
+                        "name": "weather",
+                        "input": {"city": "Paris"},
+                    },
+                    {
+                        "type": "tool_use",
+                        "id": "call_2",
+                        "name": "weather",
+                        "input": {"city": "Rome"},
+                    },
+                ],
+            },
+            {
+                "role": "user",
+                "content": [
+                    {"type": "tool_result", "tool_use_id": "call_1", "content": "sunny"},
+                    {"type": "tool_result", "tool_use_id": "call_2", "content": "rainy"},
+                ],
+            },
+        ]
+
+    def test_response(self):
+        translator = AnthropicTranslator()
+        resp = translator.translate_response(
+            {
+                "id": "msg_1",
+                "type": "message",
+                "role": "assistant",
+                "model": "claude-3-5-sonnet-20241022",
+                "content": [
+                    {"type": "text", "text": "Let me check."},
+                    {
+                        "type": "tool_use",
+                        "id": "toolu_1",
+                        "name": "weather",
+                        "input": {"city": "Paris"},
+                    },
+                ],
+                "stop_reason": "tool_use",
+                "usage": {"input_tokens": 10, "output_tokens": 5},
+            }
+        )
+        assert resp["object"] == "chat.completion"
+        assert resp["model"] == "claude-3-5-sonnet-20241022"
+        choice = resp["choices"][0]
+        assert choice["finish_reason"] == "tool_calls"
+        assert choice["message"]["content"] == "Let me check."
+        assert choice["message"]["tool_calls"] == [
+            {
+                "id": "toolu_1",
+                "type": "function",
+                "function": {"name": "weather", "arguments": '{"city": "Paris"}'},
+            }
+        ]
+        assert resp["usage"] == {
+            "prompt_tokens": 10,
+            "completion_tokens": 5,
+            "total_tokens": 15,
+        }
+
+    def test_error_response(self):
+        resp = AnthropicTranslator().translate_response(
+            {
+                "type": "error",
+                "error": {"type": "overloaded_error", "message": "Overloaded"},
+            }
+        )
+        assert resp["error"]["type"] == "overloaded_error"
+        assert resp["error"]["message"] == "Overloaded"
+
+    def test_stream(self):
+        translator = AnthropicTranslator()
+        stream = sse(
+            {
+                "type": "message_start",
+                "message": {"model": "claude-3-5-sonnet", "usage": {"input_tokens": 7}},
+            },
+            {"type": "content_block_start", "index": 0, "content_block": {"type": "text"}},
+            {
+                "type": "content_block_delta",
+                "index": 0,
+                "delta": {"type": "text_delta", "text": "Hi"},
+            },
+            {
+                "type": "content_block_start",
+                "index": 1,
+                "content_block": {"type": "tool_use", "id": "toolu_1", "name": "weather"},
+            },
+            {
+                "type": "content_block_delta",
+                "index": 1,
+                "delta": {"type": "input_json_delta", "partial_json": '{"city":'},
+            },
+            {"type": "content_block_stop", "index": 1},
+            {
+                "type": "message_delta",
+                "delta": {"stop_reason": "tool_use"},
+                "usage": {"output_tokens": 3},
+            },
+            {"type": "message_stop"},
+        )
+        # events split across response body chunks are translated once complete
+        out = translator.translate_stream(stream[:50], False)
+        out += translator.translate_stream(stream[50:], True)
+
+        chunks = parse_sse(out)
+        assert chunks[-1] == b"[DONE]"
+        deltas = [chunk["choices"][0]["delta"] for chunk in chunks[:-1]]
+        assert deltas == [
+            {"role": "assistant", "content": ""},
+            {"content": "Hi"},
+            {
+                "tool_calls": [
+                    {
+                        "index": 0,
+                        "id": "toolu_1",
+                        "type": "function",
+                        "function": {"name": "weather", "arguments": ""},
+                    }
+                ]
+            },
+            {"tool_calls": [{"index": 0, "function": {"arguments": '{"city":'}}]},
+            {},
+        ]
+        assert chunks[-2]["choices"][0]["finish_reason"] == "tool_calls"
+        assert chunks[-2]["usage"]["total_tokens"] == 10
+
+
+class TestGemini:
+    def test_request(self):
+        body = GeminiTranslator().translate_request(openai_request)
+        assert body["systemInstruction"] == {"parts": [{"text": "You are a weather bot."}]}
+        assert body["generationConfig"] == {"temperature": 0.2, "stopSequences": ["END"]}
+        assert body["toolConfig"] == {"functionCallingConfig": {"mode": "ANY"}}
+        assert body["tools"][0]["functionDeclarations"][0]["name"] == "weather"
+        assert body["contents"] == [
+            {"role": "user", "parts": [{"text": "Weather in Paris and Rome?"}]},
+            {
+                "role": "model",
+                "parts": [
+                    {"functionCall": {"name": "weather", "args": {"city": "Paris"}}},
+                    {"functionCall": {"name": "weather", "args": {"city": "Rome"}}},
+                ],
+            },
+            {
+                "role": "user",
+                "parts": [
+                    {
+                        "functionResponse": {
+                            "name": "weather",
+                            "response": {"content": "sunny"},
+                        }
+                    },
+                    {
+                        "functionResponse": {
+                            "name": "weather",
+                            "response": {"content": "rainy"},
+                        }
+                    },
+                ],
+            },
+        ]
+
+    def test_response(self):
+        resp = GeminiTranslator().translate_response(
+            {
+                "candidates": [
+                    {
+                        "content": {"role": "model", "parts": [{"text": "Sunny."}]},
+                        "finishReason": "STOP",
+                    }
+                ],
+                "usageMetadata": {
+                    "promptTokenCount": 4,
+                    "candidatesTokenCount": 2,
+                    "totalTokenCount": 6,
+                },
+                "modelVersion": "gemini-1.5-flash",
+            }
+        )
+        assert resp["model"] == "gemini-1.5-flash"
+        assert resp["choices"][0]["message"] == {"role": "assistant", "content": "Sunny."}
+        assert resp["choices"][0]["finish_reason"] == "stop"
+        assert resp["usage"]["total_tokens"] == 6
+
+    def test_stream(self):
+        translator = GeminiTranslator()
+        stream = sse(
+            {"candidates": [{"content": {"parts": [{"text": "Sun"}]}}]},
+            {
+                "candidates": [
+                    {
+                        "content": {
+                            "parts": [
+                                {"functionCall": {"name": "weather", "args": {"city": "Rome"}}}
+                            ]
+                        },
+                        "finishReason": "STOP",
+                    }
+                ],
+                "usageMetadata": {"promptTokenCount": 4, "candidatesTokenCount": 2},
+            },
+            delimiter=b"\r\n\r\n",
+        )
+        chunks = parse_sse(translator.translate_stream(stream, True))
+        assert chunks[-1] == b"[DONE]"
+        assert chunks[0]["choices"][0]["delta"] == {"role": "assistant", "content": "Sun"}
+        last = chunks[1]["choices"][0]
+        assert last["finish_reason"] == "tool_calls"
+        assert last["delta"]["tool_calls"] == [
+            {
+                "id": "call_0",
+                "type": "function",
+                "function": {"name": "weather", "arguments": '{"city": "Rome"}'},
+                "index": 0,
+            }
+        ]
+        assert chunks[1]["usage"]["prompt_tokens"] == 4
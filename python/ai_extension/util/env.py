@@ -3,3 +3,6 @@ import os
 open_ai_token_env = os.getenv("OPENAI_API_KEY", "")
 
 azure_open_ai_token_env = os.getenv("AZURE_OPENAI_API_KEY", "")
+
+# the Secrets referenced by the policies are mounted in this directory, in a directory per Secret
+secrets_dir = os.getenv("AI_SECRETS_DIR", "/var/run/secrets/kgateway/ai")
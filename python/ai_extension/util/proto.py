@@ -1,5 +1,7 @@
 import gzip
+import os
 from api.kgateway.policy.ai import authtoken
+from util import env
 from api.envoy.config.core.v3 import base_pb2 as base_pb2
 from api.envoy.service.ext_proc.v3 import external_processor_pb2
 from api.envoy.type.v3 import http_status_pb2
@@ -19,6 +21,8 @@ def get_auth_token(
     elif auth.kind == authtoken.SingleAuthTokenKind.PASSTHROUGH:
         # Get from headers
         value = get_http_header(headers, "Authorization").removeprefix("Bearer ")
+    elif auth.kind == authtoken.SingleAuthTokenKind.SECRET_REF:
+        value = read_secret_token(auth.secret_ref.name if auth.secret_ref else None)
     else:
         raise ValueError(f"Unknown auth token source {auth}")
     if value == "":
@@ -26,6 +30,28 @@ def get_auth_token(
     return value
 
 
+def read_secret_token(name: str | None) -> str:
+    """
+    Read the token of the Secret mounted in the secrets directory, or return an empty
+    string if it is not mounted.
+    """
+    return read_secret(name, "Authorization").strip().removeprefix("Bearer ")
+
+
+def read_secret(name: str | None, key: str) -> str:
+    """
+    Read a key of the Secret mounted in the secrets directory, or return an empty
+    string if it is not mounted.
+    """
+    if not name:
+        return ""
+    try:
+        with open(os.path.join(env.secrets_dir, name, key)) as f:
+            return f.read()
+    except FileNotFoundError:
+        return ""
+
+
 def get_http_header(headers: base_pb2.HeaderMap, header_name: str) -> str:
     lowered_header = header_name.lower()
     for i in range(headers.headers.__len__()):
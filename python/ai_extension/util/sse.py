@@ -11,6 +11,8 @@ SSE_DELIMITER: Final[bytes] = b"\n\n"
 SSE_DELIMITER_GEMINI: Final[bytes] = b"\r\n\r\n"
 SSE_DATA_FIELD_NAME: Final[bytes] = b"data:"
 SSE_DATA_DONE: Final[bytes] = b"[DONE]"
+# Ollama streams newline delimited json objects instead of SSE messages
+NDJSON_DELIMITER: Final[bytes] = b"\n"
 
 
 class SSEParsingException(Exception):
@@ -23,6 +25,7 @@ def replace_json_data(raw_data: bytes, json_data: Dict[str, Any]) -> bytes:
     """
     This function works on a single sse message only. So, raw_data should only contains one `data:` field.
     It find where the json object within raw_data and replace it with the string dump of json_data.
+    A newline delimited json message is replaced as a whole.
     If there is no data field, it will just return raw_data as is.
 
     Throws SSEParsingException if no json object in data field
@@ -30,7 +33,7 @@ def replace_json_data(raw_data: bytes, json_data: Dict[str, Any]) -> bytes:
     new_raw_data = bytearray()
     lines = raw_data.splitlines(keepends=True)
     for line in lines:
-        if not line.startswith(SSE_DATA_FIELD_NAME):
+        if not line.startswith(SSE_DATA_FIELD_NAME) and not line.startswith(b"{"):
             new_raw_data.extend(line)
             continue
 
@@ -67,6 +70,8 @@ def parse_sse_messages(
     chunks: List[StreamChunkData] = []
 
     sse_delimiter = llm_provider.get_sse_delimiter()
+    if sse_delimiter == NDJSON_DELIMITER:
+        return parse_ndjson_messages(llm_provider, data, prev_leftover)
     sse_delimiter_len = len(sse_delimiter)
 
     # content response message from LLM can contain "\n" (2 characters), when it's stored into byte.
@@ -167,3 +172,54 @@ def parse_sse_messages(
         start_pos = sse_end_pos
 
     return chunks, empty_leftover
+
+
+def parse_ndjson_messages(
+    llm_provider: Provider, data: bytes, prev_leftover: bytes
+) -> Tuple[List[StreamChunkData], bytes]:
+    """
+    parse_ndjson_messages is parse_sse_messages for newline delimited json streaming responses.
+    Each line is a single message and its json data.
+    """
+    chunks: List[StreamChunkData] = []
+
+    if len(prev_leftover) > 0:
+        data = prev_leftover + data
+
+    start_pos = 0
+    while start_pos < len(data):
+        end_pos = data.find(NDJSON_DELIMITER, start_pos)
+        if end_pos < 0:
+            logger.debug(
+                f"cannot find json message delimiter! saving data to leftover: {data[start_pos:]}"
+            )
+            return chunks, data[start_pos:]
+
+        end_pos += len(NDJSON_DELIMITER)
+        line = data[start_pos:end_pos]
+        start_pos = end_pos
+
+        json_data = None
+        contents = None
+        type: StreamChunkDataType = StreamChunkDataType.INVALID
+        if line.strip():
+            try:
+                json_data = json.loads(line.decode("utf-8"))
+            except json.JSONDecodeError as e:
+                logger.error(
+                    f"JSON decoding error occurred while parsing json message: {e} data:\n{line}"
+                )
+            else:
+                contents = llm_provider.extract_contents_from_resp_chunk(json_data)
+                type = llm_provider.get_stream_resp_chunk_type(json_data)
+
+        chunks.append(
+            StreamChunkData(
+                raw_data=line,
+                json_data=json_data,
+                contents=contents,
+                type=type,
+            )
+        )
+
+    return chunks, bytes()
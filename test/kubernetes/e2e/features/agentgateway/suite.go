@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/suite"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -66,3 +67,38 @@ func (s *testingSuite) TestAgentGatewayDeployment() {
 		},
 	)
 }
+
+// TestA2ACatalogAgentCard fetches the agent card of an agent listed by an A2A catalog through the
+// route of the catalog to the agent, and checks that the card advertises the URL of that route
+// rather than the address of the agent.
+func (s *testingSuite) TestA2ACatalogAgentCard() {
+	s.TestInstallation.Assertions.AssertEventualCurlResponse(
+		s.Ctx,
+		defaults.CurlPodExecOpt,
+		[]curl.Option{
+			curl.WithHost(kubeutils.ServiceFQDN(gatewayService.ObjectMeta)),
+			curl.WithHostHeader("a2a.example.com"),
+			curl.WithPath("/agents"),
+			curl.WithPort(8080),
+		},
+		&matchers.HttpResponse{
+			StatusCode: http.StatusOK,
+			Body:       gomega.ContainSubstring(`"agentCard":"/agents/default/a2a-agent/.well-known/agent.json"`),
+		},
+	)
+
+	s.TestInstallation.Assertions.AssertEventualCurlResponse(
+		s.Ctx,
+		defaults.CurlPodExecOpt,
+		[]curl.Option{
+			curl.WithHost(kubeutils.ServiceFQDN(gatewayService.ObjectMeta)),
+			curl.WithHostHeader("a2a.example.com"),
+			curl.WithPath("/agents/default/a2a-agent/.well-known/agent.json"),
+			curl.WithPort(8080),
+		},
+		&matchers.HttpResponse{
+			StatusCode: http.StatusOK,
+			Body:       gomega.MatchRegexp(`"url":\s*"[^"]*/agents/default/a2a-agent"`),
+		},
+	)
+}
@@ -32,10 +32,19 @@ var (
 	}
 	gatewayService = &corev1.Service{ObjectMeta: gatewayObjectMeta}
 
+	// A2A agent listed by an A2A catalog
+	a2aAgentManifest   = filepath.Join(fsutils.MustGetThisDir(), "testdata", "a2a-backend.yaml")
+	a2aCatalogManifest = filepath.Join(fsutils.MustGetThisDir(), "testdata", "a2a-catalog.yaml")
+	a2aAgentDeployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "a2a-agent", Namespace: "default"}}
+
 	testCases = map[string]base.TestCase{
 		"TestAgentGatewayDeployment": {
 			Manifests: []string{defaults.HttpbinManifest, defaults.CurlPodManifest, deployAgentGatewayManifest},
 			Resources: []client.Object{proxyService, proxyDeployment, defaults.CurlPod},
 		},
+		"TestA2ACatalogAgentCard": {
+			Manifests: []string{defaults.CurlPodManifest, deployAgentGatewayManifest, a2aAgentManifest, a2aCatalogManifest},
+			Resources: []client.Object{proxyService, proxyDeployment, defaults.CurlPod, a2aAgentDeployment},
+		},
 	}
 )
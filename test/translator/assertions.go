@@ -140,3 +140,57 @@ func AssertListenerSetCondition(
 	a.Equalf(c.Reason, acceptedCondition.Reason, "Accepted condition Reason mismatch for ListenerSet %v", ls)
 	a.Equalf(c.Message, acceptedCondition.Message, "Accepted condition Message mismatch for ListenerSet %v", ls)
 }
+
+// AssertGatewayCondition is a helper function to verify Gateway status conditions
+func AssertGatewayCondition(
+	t *testing.T,
+	reportsMap reports.ReportMap,
+	gw gwv1.Gateway,
+	c metav1.Condition,
+) {
+	t.Helper()
+	a := assert.New(t)
+
+	status := reportsMap.BuildGWStatus(context.Background(), gw, nil)
+	if !a.NotNilf(status, "status missing for Gateway %v", gw) {
+		return
+	}
+
+	condition := meta.FindStatusCondition(status.Conditions, c.Type)
+	if !a.NotNilf(condition, "%s condition missing for Gateway %v", c.Type, gw) {
+		return
+	}
+	a.Equalf(c.Status, condition.Status, "%s condition Status mismatch for Gateway %v", c.Type, gw)
+	a.Equalf(c.Reason, condition.Reason, "%s condition Reason mismatch for Gateway %v", c.Type, gw)
+}
+
+// AssertListenerCondition is a helper function to verify the conditions of a Gateway listener
+func AssertListenerCondition(
+	t *testing.T,
+	reportsMap reports.ReportMap,
+	gw gwv1.Gateway,
+	listenerName gwv1.SectionName,
+	c metav1.Condition,
+) {
+	t.Helper()
+	a := assert.New(t)
+
+	status := reportsMap.BuildGWStatus(context.Background(), gw, nil)
+	if !a.NotNilf(status, "status missing for Gateway %v", gw) {
+		return
+	}
+
+	for _, l := range status.Listeners {
+		if l.Name != listenerName {
+			continue
+		}
+		condition := meta.FindStatusCondition(l.Conditions, c.Type)
+		if !a.NotNilf(condition, "%s condition missing for listener %s of Gateway %v", c.Type, listenerName, gw) {
+			return
+		}
+		a.Equalf(c.Status, condition.Status, "%s condition Status mismatch for listener %s of Gateway %v", c.Type, listenerName, gw)
+		a.Equalf(c.Reason, condition.Reason, "%s condition Reason mismatch for listener %s of Gateway %v", c.Type, listenerName, gw)
+		return
+	}
+	a.Failf("listener status missing", "listener %s missing in the status of Gateway %v", listenerName, gw)
+}